@@ -24,7 +24,10 @@ func main() {
 	}
 
 	// Initialize the HTTP Gin router and pass the GORM DB
-	r := http.NewRouter(cfg, db)
+	r, err := http.NewRouter(cfg, db)
+	if err != nil {
+		log.Fatalf("Failed to build router: %v", err)
+	}
 
 	// Determine the port
 	port := os.Getenv("PORT")
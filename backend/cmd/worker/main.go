@@ -1,3 +1,112 @@
-package worker
+package main
 
-// Async worker entry point
\ No newline at end of file
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/config"
+	jobsapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/application"
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage/provider"
+	"github.com/google/uuid"
+)
+
+func main() {
+	// -retry-dead requeues a dead-lettered job and exits
+	retryDead := flag.String("retry-dead", "", "requeue the dead-lettered job with this ID and exit")
+	flag.Parse()
+
+	// Load environment
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// Connect to database
+	db, err := postgres.NewDB(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to DB: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Failed to get sql.DB: %v", err)
+	}
+
+	if *retryDead != "" {
+		jobID, err := uuid.Parse(*retryDead)
+		if err != nil {
+			log.Fatalf("Invalid job ID %q: %v", *retryDead, err)
+		}
+		if err := jobsapp.NewEnqueuer(sqlDB).RetryDead(context.Background(), jobID); err != nil {
+			log.Fatalf("%v", err)
+		}
+		log.Printf("Job %s requeued", jobID)
+		return
+	}
+
+	// Stop claiming jobs on SIGINT/SIGTERM and let in-flight jobs drain
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	worker := jobsapp.NewWorker(sqlDB, jobsapp.Options{
+		Concurrency:       cfg.WorkerConcurrency,
+		TenantConcurrency: cfg.WorkerTenantConcurrency,
+		PollInterval:      cfg.WorkerPollInterval,
+		JobTimeout:        cfg.WorkerJobTimeout,
+		ShutdownTimeout:   cfg.WorkerShutdownTimeout,
+	})
+
+	// Blob storage shared with the API
+	store, err := provider.New(cfg)
+	if err != nil {
+		log.Fatalf("Failed to configure storage: %v", err)
+	}
+
+	// Job handlers
+	photos := tenantapp.NewPhotoProcessor(sqlDB, store, tenantapp.VariantOptions{
+		Widths:        cfg.ImageVariantWidths,
+		ThumbnailSize: cfg.ImageThumbnailSize,
+	})
+	jobsapp.Handle(worker, tenant.JobPhotoMetadata, photos.ExtractMetadata)
+	jobsapp.Handle(worker, tenant.JobPhotoWatermark, photos.Watermark)
+	jobsapp.Handle(worker, tenant.JobPhotoVariants, photos.GenerateVariants)
+	jobsapp.Handle(worker, tenant.JobRewatermarkTenant, photos.RewatermarkTenant)
+
+	uploads := tenantapp.NewUploadService(sqlDB, store)
+	jobsapp.Handle(worker, tenant.JobExpireUploads, uploads.ExpireUploads)
+	direct := tenantapp.NewDirectUploadService(sqlDB, store)
+	jobsapp.Handle(worker, tenant.JobExpireReservations, direct.ExpireReservations)
+	trash := tenantapp.NewTrashService(sqlDB, store)
+	jobsapp.Handle(worker, tenant.JobPurgeTrash, trash.Purge)
+	archives := tenantapp.NewArchiveService(sqlDB, store)
+	jobsapp.Handle(worker, tenant.JobBuildArchive, archives.Build)
+	jobsapp.Handle(worker, tenant.JobPurgeArchives, archives.PurgeExpired)
+
+	listings := tenantapp.NewListingService(sqlDB)
+	jobsapp.Handle(worker, tenant.JobRunListingSchedules, listings.RunSchedules)
+	jobsapp.Handle(worker, tenant.JobListingEvent, tenantapp.NewListingEventHandler(sqlDB).Handle)
+
+	shares := sharingapp.NewShareService(sqlDB)
+	jobsapp.Handle(worker, sharing.JobExpireSessions, shares.ExpireSessions)
+
+	// Periodic jobs
+	worker.Schedule(tenant.JobExpireUploads, time.Hour)
+	worker.Schedule(tenant.JobExpireReservations, 15*time.Minute)
+	worker.Schedule(tenant.JobPurgeTrash, time.Hour)
+	worker.Schedule(tenant.JobPurgeArchives, time.Hour)
+	worker.Schedule(tenant.JobRunListingSchedules, time.Minute)
+	worker.Schedule(sharing.JobExpireSessions, time.Hour)
+
+	log.Println("Worker started")
+	worker.Run(ctx)
+	log.Println("Worker stopped")
+}
@@ -5,7 +5,7 @@ go 1.25.5
 // gin web framework
 require (
 	github.com/mattn/go-isatty v0.0.20 // indirect
-	golang.org/x/crypto v0.46.0 // indirect
+	golang.org/x/crypto v0.46.0
 	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/sync v0.19.0 // indirect
 	golang.org/x/sys v0.39.0 // indirect
@@ -18,7 +18,7 @@ require (
 require (
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
-	github.com/jackc/pgx/v5 v5.7.6 // indirect
+	github.com/jackc/pgx/v5 v5.7.6
 )
 
 // sqlc
@@ -76,9 +76,14 @@ require github.com/air-verse/air v1.63.4
 // go-swagger tool
 require github.com/go-swagger/go-swagger v0.33.1
 
+// image decoding and resampling
+require golang.org/x/image v0.30.0
+
 require (
 	github.com/gin-gonic/gin v1.11.0
+	github.com/golang-jwt/jwt/v5 v5.3.1
 	github.com/joho/godotenv v1.5.1
+	github.com/sqlc-dev/pqtype v0.3.0
 	github.com/swaggo/files v1.0.1
 	github.com/swaggo/gin-swagger v1.6.1
 	github.com/swaggo/swag v1.16.6
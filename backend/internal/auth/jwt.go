@@ -1,3 +1,244 @@
 package auth
 
-// TODO: implement JWT helper functions here
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Errors returned by the token service.
+var (
+	ErrInvalidToken       = errors.New("invalid token")
+	ErrExpiredToken       = errors.New("token has expired")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrSessionRevoked     = errors.New("session has been revoked")
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+)
+
+// refreshTokenBytes is the amount of entropy in an opaque refresh token.
+const refreshTokenBytes = 32
+
+// Claims are the JWT claims carried by an access token.
+type Claims struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	SessionID uuid.UUID `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+// TokenPair is a signed access token together with its opaque refresh token.
+type TokenPair struct {
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+	TokenType             string    `json:"token_type"`
+}
+
+// Session is a persisted refresh token. Only the hash of the token is stored.
+// Every session descending from the same login shares a FamilyID. Role is not
+// stored; it is read from the user's current tenant membership on lookup.
+type Session struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TenantID  uuid.UUID
+	FamilyID  uuid.UUID
+	TokenHash string
+	Role      string
+	ExpiresAt time.Time
+	RotatedAt *time.Time
+	RevokedAt *time.Time
+}
+
+// SessionStore persists refresh token sessions (backed by auth_sessions).
+type SessionStore interface {
+	// CreateSession stores a new session.
+	CreateSession(ctx context.Context, s Session) (Session, error)
+	// GetSessionByTokenHash returns ErrSessionNotFound when no session matches.
+	GetSessionByTokenHash(ctx context.Context, tokenHash string) (Session, error)
+	// MarkSessionRotated reports false when the session was already rotated or revoked.
+	MarkSessionRotated(ctx context.Context, id uuid.UUID) (bool, error)
+	// RevokeSessionFamily revokes every session sharing familyID.
+	RevokeSessionFamily(ctx context.Context, familyID uuid.UUID) error
+}
+
+// TokenService issues access tokens and rotates refresh tokens.
+type TokenService struct {
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	sessions   SessionStore
+	now        func() time.Time
+}
+
+// NewTokenService creates a TokenService using the JWT settings from cfg.
+func NewTokenService(cfg *config.Config, sessions SessionStore) *TokenService {
+	return &TokenService{
+		secret:     []byte(cfg.JWTSecret),
+		accessTTL:  cfg.JWTDuration,
+		refreshTTL: cfg.RefreshTokenDuration,
+		sessions:   sessions,
+		now:        time.Now,
+	}
+}
+
+// Issue starts a new session family for the user and returns its first token pair.
+func (s *TokenService) Issue(ctx context.Context, tenantID, userID uuid.UUID, role string) (TokenPair, error) {
+	return s.issue(ctx, tenantID, userID, role, uuid.New())
+}
+
+// Refresh exchanges a refresh token for a new token pair. The presented token
+// is rotated and cannot be used again; replaying it revokes the whole family.
+func (s *TokenService) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
+	session, err := s.sessions.GetSessionByTokenHash(ctx, HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return TokenPair{}, ErrInvalidToken
+		}
+		return TokenPair{}, fmt.Errorf("failed to load session: %w", err)
+	}
+
+	if session.RevokedAt != nil {
+		return TokenPair{}, ErrSessionRevoked
+	}
+
+	// A rotated token being presented again means it leaked: kill the family
+	if session.RotatedAt != nil {
+		if err := s.sessions.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+			return TokenPair{}, fmt.Errorf("failed to revoke session family: %w", err)
+		}
+		return TokenPair{}, ErrRefreshTokenReused
+	}
+
+	if !s.now().Before(session.ExpiresAt) {
+		return TokenPair{}, ErrExpiredToken
+	}
+
+	// Claim the session; losing the race to a concurrent refresh counts as reuse
+	rotated, err := s.sessions.MarkSessionRotated(ctx, session.ID)
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("failed to rotate session: %w", err)
+	}
+	if !rotated {
+		if err := s.sessions.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+			return TokenPair{}, fmt.Errorf("failed to revoke session family: %w", err)
+		}
+		return TokenPair{}, ErrRefreshTokenReused
+	}
+
+	return s.issue(ctx, session.TenantID, session.UserID, session.Role, session.FamilyID)
+}
+
+// Revoke ends the session family the refresh token belongs to (logout).
+func (s *TokenService) Revoke(ctx context.Context, refreshToken string) (Session, error) {
+	session, err := s.sessions.GetSessionByTokenHash(ctx, HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return Session{}, ErrInvalidToken
+		}
+		return Session{}, fmt.Errorf("failed to load session: %w", err)
+	}
+
+	if err := s.sessions.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+		return Session{}, fmt.Errorf("failed to revoke session family: %w", err)
+	}
+	return session, nil
+}
+
+// ParseAccessToken verifies the signature and expiry of an access token and returns its claims.
+func (s *TokenService) ParseAccessToken(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return s.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	if claims.TenantID == uuid.Nil || claims.UserID == uuid.Nil {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// issue signs an access token and persists a fresh refresh token in familyID.
+func (s *TokenService) issue(ctx context.Context, tenantID, userID uuid.UUID, role string, familyID uuid.UUID) (TokenPair, error) {
+	now := s.now()
+
+	refreshToken, err := generateOpaqueToken()
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	session, err := s.sessions.CreateSession(ctx, Session{
+		UserID:    userID,
+		TenantID:  tenantID,
+		FamilyID:  familyID,
+		TokenHash: HashToken(refreshToken),
+		Role:      role,
+		ExpiresAt: now.Add(s.refreshTTL),
+	})
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	accessExpiresAt := now.Add(s.accessTTL)
+	claims := Claims{
+		TenantID:  tenantID,
+		UserID:    userID,
+		Role:      role,
+		SessionID: familyID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(accessExpiresAt),
+		},
+	}
+
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: session.ExpiresAt,
+		TokenType:             "Bearer",
+	}, nil
+}
+
+// HashToken returns the hex encoded SHA-256 of an opaque token, as stored in the database.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateOpaqueToken returns a URL-safe random token.
+func generateOpaqueToken() (string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,308 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// memorySessions is an in-memory SessionStore with the semantics of the
+// auth_sessions queries.
+type memorySessions struct {
+	mu     sync.Mutex
+	byHash map[string]*Session
+	now    func() time.Time
+	// beforeRotate, when set, runs before MarkSessionRotated claims the
+	// session, to simulate a concurrent refresh winning the race.
+	beforeRotate func(id uuid.UUID)
+}
+
+func newMemorySessions(now func() time.Time) *memorySessions {
+	return &memorySessions{byHash: map[string]*Session{}, now: now}
+}
+
+func (m *memorySessions) CreateSession(_ context.Context, s Session) (Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s.ID = uuid.New()
+	m.byHash[s.TokenHash] = &s
+	return s, nil
+}
+
+func (m *memorySessions) GetSessionByTokenHash(_ context.Context, tokenHash string) (Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.byHash[tokenHash]
+	if !ok {
+		return Session{}, ErrSessionNotFound
+	}
+	return *s, nil
+}
+
+func (m *memorySessions) MarkSessionRotated(_ context.Context, id uuid.UUID) (bool, error) {
+	if m.beforeRotate != nil {
+		m.beforeRotate(id)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rotate(id), nil
+}
+
+func (m *memorySessions) rotate(id uuid.UUID) bool {
+	for _, s := range m.byHash {
+		if s.ID != id {
+			continue
+		}
+		if s.RotatedAt != nil || s.RevokedAt != nil {
+			return false
+		}
+		now := m.now()
+		s.RotatedAt = &now
+		return true
+	}
+	return false
+}
+
+func (m *memorySessions) RevokeSessionFamily(_ context.Context, familyID uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for _, s := range m.byHash {
+		if s.FamilyID == familyID && s.RevokedAt == nil {
+			s.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// familyRevoked reports whether every session of familyID is revoked.
+func (m *memorySessions) familyRevoked(familyID uuid.UUID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	found := false
+	for _, s := range m.byHash {
+		if s.FamilyID != familyID {
+			continue
+		}
+		found = true
+		if s.RevokedAt == nil {
+			return false
+		}
+	}
+	return found
+}
+
+type tokenFixture struct {
+	svc      *TokenService
+	sessions *memorySessions
+	now      time.Time
+	tenantID uuid.UUID
+	userID   uuid.UUID
+}
+
+func newTokenFixture() *tokenFixture {
+	f := &tokenFixture{
+		now:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		tenantID: uuid.New(),
+		userID:   uuid.New(),
+	}
+	clock := func() time.Time { return f.now }
+	f.sessions = newMemorySessions(clock)
+	f.svc = &TokenService{
+		secret:     []byte("test-secret"),
+		accessTTL:  15 * time.Minute,
+		refreshTTL: 24 * time.Hour,
+		sessions:   f.sessions,
+		now:        clock,
+	}
+	return f
+}
+
+func (f *tokenFixture) issue(t *testing.T) TokenPair {
+	t.Helper()
+	pair, err := f.svc.Issue(context.Background(), f.tenantID, f.userID, "member")
+	if err != nil {
+		t.Fatalf("Issue: %v", err)
+	}
+	return pair
+}
+
+func (f *tokenFixture) family(t *testing.T, refreshToken string) uuid.UUID {
+	t.Helper()
+	s, err := f.sessions.GetSessionByTokenHash(context.Background(), HashToken(refreshToken))
+	if err != nil {
+		t.Fatalf("session of refresh token: %v", err)
+	}
+	return s.FamilyID
+}
+
+func TestRefreshRotates(t *testing.T) {
+	f := newTokenFixture()
+	first := f.issue(t)
+
+	second, err := f.svc.Refresh(context.Background(), first.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("Refresh returned the presented refresh token")
+	}
+	if f.family(t, second.RefreshToken) != f.family(t, first.RefreshToken) {
+		t.Error("rotated token left the session family")
+	}
+
+	claims, err := f.svc.ParseAccessToken(second.AccessToken)
+	if err != nil {
+		t.Fatalf("ParseAccessToken: %v", err)
+	}
+	if claims.TenantID != f.tenantID || claims.UserID != f.userID || claims.SessionID != f.family(t, first.RefreshToken) {
+		t.Errorf("claims = %+v", claims)
+	}
+
+	// The rotated token keeps working down the chain
+	if _, err := f.svc.Refresh(context.Background(), second.RefreshToken); err != nil {
+		t.Fatalf("second Refresh: %v", err)
+	}
+}
+
+func TestRefreshRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup returns the refresh token to present
+		setup      func(t *testing.T, f *tokenFixture) string
+		wantErr    error
+		wantRevoke bool
+	}{
+		{
+			name:    "unknown token",
+			setup:   func(*testing.T, *tokenFixture) string { return "not-a-token" },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "reused token",
+			setup: func(t *testing.T, f *tokenFixture) string {
+				pair := f.issue(t)
+				if _, err := f.svc.Refresh(context.Background(), pair.RefreshToken); err != nil {
+					t.Fatalf("Refresh: %v", err)
+				}
+				return pair.RefreshToken
+			},
+			wantErr:    ErrRefreshTokenReused,
+			wantRevoke: true,
+		},
+		{
+			name: "lost rotation race",
+			setup: func(t *testing.T, f *tokenFixture) string {
+				pair := f.issue(t)
+				f.sessions.beforeRotate = func(id uuid.UUID) {
+					f.sessions.mu.Lock()
+					defer f.sessions.mu.Unlock()
+					f.sessions.rotate(id)
+				}
+				return pair.RefreshToken
+			},
+			wantErr:    ErrRefreshTokenReused,
+			wantRevoke: true,
+		},
+		{
+			name: "revoked family",
+			setup: func(t *testing.T, f *tokenFixture) string {
+				pair := f.issue(t)
+				if _, err := f.svc.Revoke(context.Background(), pair.RefreshToken); err != nil {
+					t.Fatalf("Revoke: %v", err)
+				}
+				return pair.RefreshToken
+			},
+			wantErr:    ErrSessionRevoked,
+			wantRevoke: true,
+		},
+		{
+			name: "expired token",
+			setup: func(t *testing.T, f *tokenFixture) string {
+				pair := f.issue(t)
+				f.now = f.now.Add(f.svc.refreshTTL)
+				return pair.RefreshToken
+			},
+			wantErr: ErrExpiredToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTokenFixture()
+			token := tt.setup(t, f)
+
+			_, err := f.svc.Refresh(context.Background(), token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Refresh err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantRevoke && !f.sessions.familyRevoked(f.family(t, token)) {
+				t.Error("session family was not revoked")
+			}
+		})
+	}
+}
+
+func TestRefreshReuseRevokesDescendants(t *testing.T) {
+	f := newTokenFixture()
+	first := f.issue(t)
+	second, err := f.svc.Refresh(context.Background(), first.RefreshToken)
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+
+	// Replaying the first token also kills the token it was rotated into
+	if _, err := f.svc.Refresh(context.Background(), first.RefreshToken); !errors.Is(err, ErrRefreshTokenReused) {
+		t.Fatalf("replay err = %v, want ErrRefreshTokenReused", err)
+	}
+	if _, err := f.svc.Refresh(context.Background(), second.RefreshToken); !errors.Is(err, ErrSessionRevoked) {
+		t.Fatalf("descendant err = %v, want ErrSessionRevoked", err)
+	}
+
+	// Other logins of the same user are untouched
+	other := f.issue(t)
+	if _, err := f.svc.Refresh(context.Background(), other.RefreshToken); err != nil {
+		t.Fatalf("other family Refresh: %v", err)
+	}
+}
+
+func TestParseAccessToken(t *testing.T) {
+	f := newTokenFixture()
+	pair := f.issue(t)
+
+	tests := []struct {
+		name    string
+		token   string
+		advance time.Duration
+		secret  string
+		wantErr error
+	}{
+		{name: "valid", token: pair.AccessToken},
+		{name: "expired", token: pair.AccessToken, advance: f.svc.accessTTL, wantErr: ErrExpiredToken},
+		{name: "wrong secret", token: pair.AccessToken, secret: "other-secret", wantErr: ErrInvalidToken},
+		{name: "garbage", token: "not.a.jwt", wantErr: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := *f.svc
+			now := f.now.Add(tt.advance)
+			svc.now = func() time.Time { return now }
+			if tt.secret != "" {
+				svc.secret = []byte(tt.secret)
+			}
+
+			_, err := svc.ParseAccessToken(tt.token)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("ParseAccessToken: %v", err)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAccessToken err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrTenantMismatch is returned when a request targets a tenant other than the caller's.
+var ErrTenantMismatch = errors.New("tenant does not match the authenticated principal")
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	Roles     []string
+}
+
+// HasRole reports whether the principal holds role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// EnsureTenant returns ErrTenantMismatch unless tenantID is the principal's tenant.
+// Use it before running any query scoped by a caller-supplied tenant_id.
+func (p *Principal) EnsureTenant(tenantID uuid.UUID) error {
+	if tenantID != p.TenantID {
+		return ErrTenantMismatch
+	}
+	return nil
+}
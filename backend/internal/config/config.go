@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -19,6 +21,33 @@ type Config struct {
 
 	JWTSecret   string
 	JWTDuration time.Duration
+
+	RefreshTokenDuration time.Duration
+
+	CORSAllowedOrigins []string
+
+	TrustedProxies []string
+
+	StorageBackend    string
+	StorageLocalDir   string
+	StoragePublicURL  string
+	StorageSigningKey string
+
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	S3PathStyle bool
+
+	WorkerConcurrency       int
+	WorkerTenantConcurrency int
+	WorkerPollInterval      time.Duration
+	WorkerJobTimeout        time.Duration
+	WorkerShutdownTimeout   time.Duration
+
+	ImageVariantWidths []int
+	ImageThumbnailSize int
 }
 
 // LoadEnvVar loads an environment variable by name, and returns an error if it is missing.
@@ -30,6 +59,81 @@ func LoadEnvVar(key string) (string, error) {
 	return value, nil
 }
 
+// LoadOptionalDuration parses an optional duration environment variable, returning fallback when it is unset.
+func LoadOptionalDuration(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value: %v", key, err)
+	}
+	return duration, nil
+}
+
+// LoadOptionalInt parses an optional non-negative integer environment variable, returning fallback when it is unset.
+func LoadOptionalInt(key string, fallback int) (int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		return 0, fmt.Errorf("invalid %s value: %q", key, value)
+	}
+	return parsed, nil
+}
+
+// LoadOptionalIntList parses an optional comma-separated list of positive integers, returning fallback when it is unset.
+func LoadOptionalIntList(key string, fallback []int) ([]int, error) {
+	values := LoadOptionalList(key)
+	if len(values) == 0 {
+		return fallback, nil
+	}
+	parsed := make([]int, 0, len(values))
+	for _, value := range values {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid %s value: %q", key, value)
+		}
+		parsed = append(parsed, n)
+	}
+	return parsed, nil
+}
+
+// LoadOptionalString returns an optional environment variable, or fallback when it is unset.
+func LoadOptionalString(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// LoadOptionalBool parses an optional boolean environment variable, returning fallback when it is unset.
+func LoadOptionalBool(key string, fallback bool) (bool, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s value: %v", key, err)
+	}
+	return parsed, nil
+}
+
+// LoadOptionalList parses an optional comma-separated environment variable, returning nil when it is unset.
+func LoadOptionalList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
 // LoadConfig loads the configuration from environment variables (with fallback to .env file).
 func LoadConfig() (*Config, error) {
 	if err := godotenv.Load(); err != nil {
@@ -63,6 +167,50 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("invalid JWT_EXPIRES_IN value: %v", err)
 	}
 
+	// Refresh tokens outlive access tokens; default to 30 days
+	refreshDuration, err := LoadOptionalDuration("JWT_REFRESH_EXPIRES_IN", 30*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	// MinIO and most self-hosted S3 services need path-style addressing
+	s3PathStyle, err := LoadOptionalBool("S3_PATH_STYLE", true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Background worker tuning; zero tenant concurrency disables the per-tenant cap
+	workerConcurrency, err := LoadOptionalInt("WORKER_CONCURRENCY", 4)
+	if err != nil {
+		return nil, err
+	}
+	workerTenantConcurrency, err := LoadOptionalInt("WORKER_TENANT_CONCURRENCY", 2)
+	if err != nil {
+		return nil, err
+	}
+	workerPollInterval, err := LoadOptionalDuration("WORKER_POLL_INTERVAL", time.Second)
+	if err != nil {
+		return nil, err
+	}
+	workerJobTimeout, err := LoadOptionalDuration("WORKER_JOB_TIMEOUT", 5*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	workerShutdownTimeout, err := LoadOptionalDuration("WORKER_SHUTDOWN_TIMEOUT", 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	// Photo renditions rendered by the worker after each upload
+	imageVariantWidths, err := LoadOptionalIntList("IMAGE_VARIANT_WIDTHS", []int{320, 800, 1600})
+	if err != nil {
+		return nil, err
+	}
+	imageThumbnailSize, err := LoadOptionalInt("IMAGE_THUMBNAIL_SIZE", 256)
+	if err != nil {
+		return nil, err
+	}
+
 	// Populate the Config struct
 	cfg := &Config{
 		DBHost:     *envVars["DB_HOST"],
@@ -73,6 +221,38 @@ func LoadConfig() (*Config, error) {
 		DBSSLMode:  *envVars["DB_SSLMODE"],
 		JWTSecret:  *envVars["JWT_SECRET"],
 		JWTDuration: duration,
+
+		RefreshTokenDuration: refreshDuration,
+
+		// Browser origins allowed to call the API; empty allows any origin
+		CORSAllowedOrigins: LoadOptionalList("CORS_ALLOWED_ORIGINS"),
+
+		// Proxies whose X-Forwarded-For is believed; empty trusts none, so
+		// client IPs are the connecting addresses
+		TrustedProxies: LoadOptionalList("TRUSTED_PROXIES"),
+
+		// Blob storage: "local" keeps objects under StorageLocalDir and serves
+		// presigned URLs from StoragePublicURL; "s3" uses the S3_* settings
+		StorageBackend:    LoadOptionalString("STORAGE_BACKEND", "local"),
+		StorageLocalDir:   LoadOptionalString("STORAGE_LOCAL_DIR", "./data/uploads"),
+		StoragePublicURL:  LoadOptionalString("STORAGE_PUBLIC_URL", "/storage"),
+		StorageSigningKey: LoadOptionalString("STORAGE_SIGNING_KEY", *envVars["JWT_SECRET"]),
+
+		S3Endpoint:  os.Getenv("S3_ENDPOINT"),
+		S3Region:    LoadOptionalString("S3_REGION", "us-east-1"),
+		S3Bucket:    os.Getenv("S3_BUCKET"),
+		S3AccessKey: os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey: os.Getenv("S3_SECRET_KEY"),
+		S3PathStyle: s3PathStyle,
+
+		WorkerConcurrency:       workerConcurrency,
+		WorkerTenantConcurrency: workerTenantConcurrency,
+		WorkerPollInterval:      workerPollInterval,
+		WorkerJobTimeout:        workerJobTimeout,
+		WorkerShutdownTimeout:   workerShutdownTimeout,
+
+		ImageVariantWidths: imageVariantWidths,
+		ImageThumbnailSize: imageThumbnailSize,
 	}
 
 	return cfg, nil
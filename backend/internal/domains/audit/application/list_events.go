@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// EventReader lists recorded audit events.
+type EventReader struct {
+	repo *infrastructure.AuditRepository
+}
+
+// NewEventReader creates an EventReader.
+func NewEventReader(db sqlc.DBTX) *EventReader {
+	return &EventReader{repo: infrastructure.NewAuditRepository(db)}
+}
+
+// List returns a page of the tenant's audit entries, newest first.
+func (r *EventReader) List(ctx context.Context, tenantID uuid.UUID, filter domain.Filter, limit, offset int32) ([]domain.Entry, error) {
+	entries, err := r.repo.List(ctx, tenantID, filter, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+	return entries, nil
+}
@@ -1 +1,29 @@
-package application
\ No newline at end of file
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository/sqlc"
+)
+
+// EventLogger records audit events.
+type EventLogger struct {
+	repo *infrastructure.AuditRepository
+}
+
+// NewEventLogger creates an EventLogger. Pass a transaction to make the audit
+// entry part of the same unit of work as the change it describes.
+func NewEventLogger(db sqlc.DBTX) *EventLogger {
+	return &EventLogger{repo: infrastructure.NewAuditRepository(db)}
+}
+
+// Log records a single audit event.
+func (l *EventLogger) Log(ctx context.Context, e domain.Event) error {
+	if err := l.repo.Insert(ctx, e); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
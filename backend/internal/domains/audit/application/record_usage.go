@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// UsageRecorder updates the per-user usage statistics.
+type UsageRecorder struct {
+	repo *infrastructure.UsageRepository
+}
+
+// NewUsageRecorder creates a UsageRecorder. Pass a transaction to count the
+// usage in the same unit of work as the upload.
+func NewUsageRecorder(db sqlc.DBTX) *UsageRecorder {
+	return &UsageRecorder{repo: infrastructure.NewUsageRepository(db)}
+}
+
+// RecordUpload counts one upload of size bytes by the user.
+func (r *UsageRecorder) RecordUpload(ctx context.Context, tenantID, userID uuid.UUID, size int64) error {
+	if err := r.repo.RecordUpload(ctx, tenantID, userID, size); err != nil {
+		return fmt.Errorf("failed to record usage: %w", err)
+	}
+	return nil
+}
+
+// RecordPurge takes a purged upload by the user off its statistics, along
+// with the bytes the purge freed.
+func (r *UsageRecorder) RecordPurge(ctx context.Context, tenantID, userID uuid.UUID, freed int64) error {
+	if err := r.repo.ReleaseUpload(ctx, tenantID, userID, freed); err != nil {
+		return fmt.Errorf("failed to record usage: %w", err)
+	}
+	return nil
+}
@@ -1 +1,60 @@
-package domain
\ No newline at end of file
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Actions allowed by audit_logs_action_check.
+const (
+	ActionCreate    = "create"
+	ActionUpdate    = "update"
+	ActionDelete    = "delete"
+	ActionLogin     = "login"
+	ActionLogout    = "logout"
+	ActionPublish   = "publish"
+	ActionUnpublish = "unpublish"
+	ActionShare     = "share"
+	ActionOther     = "other"
+)
+
+// Entity types allowed by audit_logs_entity_type_check.
+const (
+	EntityUser         = "user"
+	EntityListing      = "listing"
+	EntityFile         = "file"
+	EntitySubscription = "subscription"
+	EntityPlan         = "plan"
+	EntityNotification = "notification"
+	EntityTenant       = "tenant"
+)
+
+// Event is a single audit log entry. PerformedBy is uuid.Nil for system actions.
+type Event struct {
+	TenantID    uuid.UUID
+	PerformedBy uuid.UUID
+	EntityID    uuid.UUID
+	EntityType  string
+	Action      string
+	Data        map[string]any
+}
+
+// Entry is an audit event as stored, with its ID and timestamp.
+type Entry struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	PerformedBy *uuid.UUID
+	EntityID    uuid.UUID
+	EntityType  string
+	Action      string
+	Data        json.RawMessage
+	PerformedAt time.Time
+}
+
+// Filter narrows an audit log listing. Zero values match everything.
+type Filter struct {
+	EntityType string
+	EntityID   uuid.UUID
+}
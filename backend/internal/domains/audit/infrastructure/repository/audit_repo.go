@@ -1 +1,80 @@
-package infrastructure
\ No newline at end of file
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// AuditRepository persists audit events in audit_logs.
+type AuditRepository struct {
+	q *sqlc.Queries
+}
+
+// NewAuditRepository creates an AuditRepository using the given connection or transaction.
+func NewAuditRepository(db sqlc.DBTX) *AuditRepository {
+	return &AuditRepository{q: sqlc.New(db)}
+}
+
+// Insert writes the event to audit_logs.
+func (r *AuditRepository) Insert(ctx context.Context, e domain.Event) error {
+	var data pqtype.NullRawMessage
+	if e.Data != nil {
+		raw, err := json.Marshal(e.Data)
+		if err != nil {
+			return fmt.Errorf("failed to encode audit data: %w", err)
+		}
+		data = pqtype.NullRawMessage{RawMessage: raw, Valid: true}
+	}
+
+	_, err := r.q.InsertAuditLog(ctx, sqlc.InsertAuditLogParams{
+		TenantID:    e.TenantID,
+		PerformedBy: uuid.NullUUID{UUID: e.PerformedBy, Valid: e.PerformedBy != uuid.Nil},
+		EntityID:    e.EntityID,
+		EntityType:  e.EntityType,
+		Action:      e.Action,
+		ChangedData: data,
+	})
+	return err
+}
+
+// List returns a page of the tenant's audit entries matching filter, newest first.
+func (r *AuditRepository) List(ctx context.Context, tenantID uuid.UUID, filter domain.Filter, limit, offset int32) ([]domain.Entry, error) {
+	rows, err := r.q.ListAuditLogs(ctx, sqlc.ListAuditLogsParams{
+		TenantID:   tenantID,
+		EntityType: sql.NullString{String: filter.EntityType, Valid: filter.EntityType != ""},
+		EntityID:   uuid.NullUUID{UUID: filter.EntityID, Valid: filter.EntityID != uuid.Nil},
+		PageLimit:  limit,
+		PageOffset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.Entry, 0, len(rows))
+	for _, row := range rows {
+		entry := domain.Entry{
+			ID:          row.ID,
+			TenantID:    tenantID,
+			EntityID:    row.EntityID,
+			EntityType:  row.EntityType,
+			Action:      row.Action,
+			PerformedAt: row.PerformedAt,
+		}
+		if row.PerformedBy.Valid {
+			performedBy := row.PerformedBy.UUID
+			entry.PerformedBy = &performedBy
+		}
+		if row.ChangedData.Valid {
+			entry.Data = row.ChangedData.RawMessage
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
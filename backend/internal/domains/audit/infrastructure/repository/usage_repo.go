@@ -0,0 +1,37 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// UsageRepository maintains the per-user counters in usage_stats.
+type UsageRepository struct {
+	q *sqlc.Queries
+}
+
+// NewUsageRepository creates a UsageRepository using the given connection or transaction.
+func NewUsageRepository(db sqlc.DBTX) *UsageRepository {
+	return &UsageRepository{q: sqlc.New(db)}
+}
+
+// RecordUpload counts one upload of size bytes for the user, creating the row on first use.
+func (r *UsageRepository) RecordUpload(ctx context.Context, tenantID, userID uuid.UUID, size int64) error {
+	return r.q.RecordUserUpload(ctx, sqlc.RecordUserUploadParams{
+		TenantID:              tenantID,
+		UserID:                userID,
+		TotalStorageUsedBytes: size,
+	})
+}
+
+// ReleaseUpload takes one purged upload off the user's counters, together
+// with the freed bytes. Counters never drop below zero.
+func (r *UsageRepository) ReleaseUpload(ctx context.Context, tenantID, userID uuid.UUID, freed int64) error {
+	return r.q.ReleaseUserUpload(ctx, sqlc.ReleaseUserUploadParams{
+		Size:     freed,
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+}
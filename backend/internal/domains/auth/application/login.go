@@ -1 +1,226 @@
-package application
\ No newline at end of file
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	authrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+// dummyPasswordHash is compared against when the user does not exist so that
+// unknown accounts take as long to reject as wrong passwords.
+const dummyPasswordHash = "$2a$12$FtmlVBoEY4K.cA87BPogsOOWBlwwSd4gnarKhctarnK11knHJjzAu"
+
+// LoginInput identifies the user by email or username within a tenant.
+type LoginInput struct {
+	TenantID   uuid.UUID
+	Identifier string
+	Password   string
+	IP         string
+	UserAgent  string
+}
+
+// LoginResult is returned after a successful login.
+type LoginResult struct {
+	UserID   uuid.UUID
+	TenantID uuid.UUID
+	Username string
+	Email    string
+	Role     string
+	Tokens   auth.TokenPair
+}
+
+// loginUsers finds the account a login identifier names.
+type loginUsers interface {
+	GetForLogin(ctx context.Context, tenantID uuid.UUID, identifier string) (*domain.User, string, error)
+}
+
+// loginThrottles keeps the failed attempts and locks of login subjects.
+type loginThrottles interface {
+	Get(ctx context.Context, scope, subject string) (*domain.LoginThrottle, error)
+	RecordFailure(ctx context.Context, scope, subject string, windowStart, resetBefore time.Time) (*domain.LoginThrottle, error)
+	Lock(ctx context.Context, scope, subject string, until time.Time) error
+	Reset(ctx context.Context, scope, subject string) error
+}
+
+// eventLogger writes audit events.
+type eventLogger interface {
+	Log(ctx context.Context, e audit.Event) error
+}
+
+// LoginService authenticates users and manages their sessions.
+type LoginService struct {
+	users      loginUsers
+	throttles  loginThrottles
+	audit      eventLogger
+	tokens     *auth.TokenService
+	userPolicy domain.LockoutPolicy
+	ipPolicy   domain.LockoutPolicy
+	now        func() time.Time
+}
+
+// NewLoginService creates a LoginService with the default lockout policies.
+func NewLoginService(db *sql.DB, tokens *auth.TokenService) *LoginService {
+	return &LoginService{
+		users:      authrepo.NewUserRepository(db),
+		throttles:  authrepo.NewThrottleRepository(db),
+		audit:      auditapp.NewEventLogger(db),
+		tokens:     tokens,
+		userPolicy: domain.DefaultUserLockout,
+		ipPolicy:   domain.DefaultIPLockout,
+		now:        time.Now,
+	}
+}
+
+// Login verifies the credentials and starts a new session. Repeated failures
+// lock the identifier and the source IP with a progressively longer lock;
+// a locked login returns *domain.LockedError. Identifiers without an
+// account are counted and locked the same way, so neither the error nor
+// the lock tells whether an account exists.
+func (s *LoginService) Login(ctx context.Context, in LoginInput) (*LoginResult, error) {
+	// Refuse early if the source IP or the identifier is locked
+	if in.IP != "" {
+		if err := s.checkLocked(ctx, domain.ThrottleScopeIP, in.IP); err != nil {
+			return nil, err
+		}
+	}
+	userSubject := domain.LoginSubject(in.TenantID, in.Identifier)
+	if err := s.checkLocked(ctx, domain.ThrottleScopeUser, userSubject); err != nil {
+		return nil, err
+	}
+
+	user, role, err := s.users.GetForLogin(ctx, in.TenantID, in.Identifier)
+	if err != nil {
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			return nil, fmt.Errorf("failed to load user: %w", err)
+		}
+
+		// Keep timing consistent with a wrong password
+		_, _ = auth.CheckPassword(dummyPasswordHash, in.Password)
+		if err := s.recordFailures(ctx, userSubject, in.IP); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	ok, err := auth.CheckPassword(user.PasswordHash, in.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := s.recordFailures(ctx, userSubject, in.IP); err != nil {
+			return nil, err
+		}
+		s.logAudit(ctx, user.TenantID, user.ID, audit.ActionLogin, in.IP, in.UserAgent, false)
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	// Successful login clears the identifier's failure history
+	if err := s.throttles.Reset(ctx, domain.ThrottleScopeUser, userSubject); err != nil {
+		return nil, fmt.Errorf("failed to reset login throttle: %w", err)
+	}
+
+	tokens, err := s.tokens.Issue(ctx, user.TenantID, user.ID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logAudit(ctx, user.TenantID, user.ID, audit.ActionLogin, in.IP, in.UserAgent, true)
+
+	return &LoginResult{
+		UserID:   user.ID,
+		TenantID: user.TenantID,
+		Username: user.Username,
+		Email:    user.Email,
+		Role:     role,
+		Tokens:   tokens,
+	}, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair. Reusing a rotated
+// token revokes the whole session family.
+func (s *LoginService) Refresh(ctx context.Context, refreshToken string) (auth.TokenPair, error) {
+	return s.tokens.Refresh(ctx, refreshToken)
+}
+
+// Logout revokes the session family the refresh token belongs to.
+func (s *LoginService) Logout(ctx context.Context, refreshToken, ip, userAgent string) error {
+	session, err := s.tokens.Revoke(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	s.logAudit(ctx, session.TenantID, session.UserID, audit.ActionLogout, ip, userAgent, true)
+	return nil
+}
+
+// checkLocked returns a *domain.LockedError if the subject is currently locked.
+func (s *LoginService) checkLocked(ctx context.Context, scope, subject string) error {
+	throttle, err := s.throttles.Get(ctx, scope, subject)
+	if err != nil {
+		return fmt.Errorf("failed to load login throttle: %w", err)
+	}
+	if throttle.IsLocked(s.now()) {
+		return &domain.LockedError{Scope: scope, Until: *throttle.LockedUntil}
+	}
+	return nil
+}
+
+// recordFailures counts a failed login against the identifier's subject and
+// the source IP.
+func (s *LoginService) recordFailures(ctx context.Context, userSubject, ip string) error {
+	if err := s.recordFailure(ctx, domain.ThrottleScopeUser, userSubject, s.userPolicy); err != nil {
+		return err
+	}
+	return s.recordFailure(ctx, domain.ThrottleScopeIP, ip, s.ipPolicy)
+}
+
+// recordFailure counts a failed attempt and locks the subject once the policy threshold is reached.
+func (s *LoginService) recordFailure(ctx context.Context, scope, subject string, policy domain.LockoutPolicy) error {
+	if subject == "" {
+		return nil
+	}
+
+	now := s.now()
+	throttle, err := s.throttles.RecordFailure(ctx, scope, subject, now.Add(-policy.Window), now.Add(-policy.ResetAfter))
+	if err != nil {
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	if policy.ShouldLock(throttle.FailedAttempts) {
+		until := now.Add(policy.LockDuration(throttle.LockoutCount))
+		if err := s.throttles.Lock(ctx, scope, subject, until); err != nil {
+			return fmt.Errorf("failed to lock login: %w", err)
+		}
+	}
+	return nil
+}
+
+// logAudit writes a login/logout audit entry. Audit failures are logged but
+// never block authentication.
+func (s *LoginService) logAudit(ctx context.Context, tenantID, userID uuid.UUID, action, ip, userAgent string, success bool) {
+	err := s.audit.Log(ctx, audit.Event{
+		TenantID:    tenantID,
+		PerformedBy: userID,
+		EntityID:    userID,
+		EntityType:  audit.EntityUser,
+		Action:      action,
+		Data: map[string]any{
+			"ip":         ip,
+			"user_agent": userAgent,
+			"success":    success,
+		},
+	})
+	if err != nil {
+		log.Printf("audit %s for user %s: %v", action, userID, err)
+	}
+}
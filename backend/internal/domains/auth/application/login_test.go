@@ -0,0 +1,350 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/config"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testPassword = "correct horse battery"
+
+// testUserPolicy and testIPPolicy lock sooner than the defaults so the
+// tests need few bcrypt comparisons.
+var (
+	testUserPolicy = domain.LockoutPolicy{
+		MaxAttempts: 3,
+		Window:      15 * time.Minute,
+		BaseLockout: time.Minute,
+		MaxLockout:  time.Hour,
+		ResetAfter:  24 * time.Hour,
+	}
+	testIPPolicy = domain.LockoutPolicy{
+		MaxAttempts: 4,
+		Window:      15 * time.Minute,
+		BaseLockout: 5 * time.Minute,
+		MaxLockout:  time.Hour,
+		ResetAfter:  24 * time.Hour,
+	}
+)
+
+type fakeUsers struct {
+	byIdentifier map[string]*domain.User
+}
+
+func (f *fakeUsers) GetForLogin(_ context.Context, tenantID uuid.UUID, identifier string) (*domain.User, string, error) {
+	u, ok := f.byIdentifier[identifier]
+	if !ok || u.TenantID != tenantID {
+		return nil, "", domain.ErrUserNotFound
+	}
+	return u, "member", nil
+}
+
+// fakeThrottles mirrors the login_throttles queries in memory.
+type fakeThrottles struct {
+	now  func() time.Time
+	rows map[[2]string]*fakeThrottle
+}
+
+type fakeThrottle struct {
+	domain.LoginThrottle
+	lastFailedAt time.Time
+}
+
+func newFakeThrottles(now func() time.Time) *fakeThrottles {
+	return &fakeThrottles{now: now, rows: map[[2]string]*fakeThrottle{}}
+}
+
+func (f *fakeThrottles) Get(_ context.Context, scope, subject string) (*domain.LoginThrottle, error) {
+	if row, ok := f.rows[[2]string{scope, subject}]; ok {
+		t := row.LoginThrottle
+		return &t, nil
+	}
+	return &domain.LoginThrottle{Scope: scope, Subject: subject}, nil
+}
+
+func (f *fakeThrottles) RecordFailure(_ context.Context, scope, subject string, windowStart, resetBefore time.Time) (*domain.LoginThrottle, error) {
+	key := [2]string{scope, subject}
+	row, ok := f.rows[key]
+	switch {
+	case !ok:
+		row = &fakeThrottle{LoginThrottle: domain.LoginThrottle{Scope: scope, Subject: subject, FailedAttempts: 1}}
+		f.rows[key] = row
+	case row.lastFailedAt.Before(windowStart):
+		row.FailedAttempts = 1
+	default:
+		row.FailedAttempts++
+	}
+	if ok && row.lastFailedAt.Before(resetBefore) {
+		row.LockoutCount = 0
+	}
+	row.lastFailedAt = f.now()
+	t := row.LoginThrottle
+	return &t, nil
+}
+
+func (f *fakeThrottles) Lock(_ context.Context, scope, subject string, until time.Time) error {
+	if row, ok := f.rows[[2]string{scope, subject}]; ok {
+		row.LockedUntil = &until
+		row.LockoutCount++
+		row.FailedAttempts = 0
+	}
+	return nil
+}
+
+func (f *fakeThrottles) Reset(_ context.Context, scope, subject string) error {
+	delete(f.rows, [2]string{scope, subject})
+	return nil
+}
+
+type fakeEvents struct {
+	events []audit.Event
+}
+
+func (f *fakeEvents) Log(_ context.Context, e audit.Event) error {
+	f.events = append(f.events, e)
+	return nil
+}
+
+// fakeSessions is an in-memory auth.SessionStore.
+type fakeSessions struct {
+	byHash map[string]*auth.Session
+}
+
+func newFakeSessions() *fakeSessions {
+	return &fakeSessions{byHash: map[string]*auth.Session{}}
+}
+
+func (f *fakeSessions) CreateSession(_ context.Context, s auth.Session) (auth.Session, error) {
+	s.ID = uuid.New()
+	f.byHash[s.TokenHash] = &s
+	return s, nil
+}
+
+func (f *fakeSessions) GetSessionByTokenHash(_ context.Context, tokenHash string) (auth.Session, error) {
+	s, ok := f.byHash[tokenHash]
+	if !ok {
+		return auth.Session{}, auth.ErrSessionNotFound
+	}
+	return *s, nil
+}
+
+func (f *fakeSessions) MarkSessionRotated(_ context.Context, id uuid.UUID) (bool, error) {
+	for _, s := range f.byHash {
+		if s.ID == id {
+			if s.RotatedAt != nil || s.RevokedAt != nil {
+				return false, nil
+			}
+			now := time.Now()
+			s.RotatedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeSessions) RevokeSessionFamily(_ context.Context, familyID uuid.UUID) error {
+	now := time.Now()
+	for _, s := range f.byHash {
+		if s.FamilyID == familyID && s.RevokedAt == nil {
+			s.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+type loginFixture struct {
+	svc       *LoginService
+	throttles *fakeThrottles
+	events    *fakeEvents
+	tenantID  uuid.UUID
+	user      *domain.User
+	now       time.Time
+}
+
+func newLoginFixture(t *testing.T) *loginFixture {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &loginFixture{
+		tenantID: uuid.New(),
+		now:      time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		events:   &fakeEvents{},
+	}
+	f.user = &domain.User{
+		ID:           uuid.New(),
+		TenantID:     f.tenantID,
+		Username:     "alice",
+		Email:        "alice@example.com",
+		PasswordHash: string(hash),
+	}
+	clock := func() time.Time { return f.now }
+	f.throttles = newFakeThrottles(clock)
+	tokens := auth.NewTokenService(&config.Config{
+		JWTSecret:            "test-secret",
+		JWTDuration:          time.Minute,
+		RefreshTokenDuration: time.Hour,
+	}, newFakeSessions())
+	f.svc = &LoginService{
+		users: &fakeUsers{byIdentifier: map[string]*domain.User{
+			f.user.Username: f.user,
+			f.user.Email:    f.user,
+		}},
+		throttles:  f.throttles,
+		audit:      f.events,
+		tokens:     tokens,
+		userPolicy: testUserPolicy,
+		ipPolicy:   testIPPolicy,
+		now:        clock,
+	}
+	return f
+}
+
+func (f *loginFixture) login(identifier, password, ip string) (*LoginResult, error) {
+	return f.svc.Login(context.Background(), LoginInput{
+		TenantID:   f.tenantID,
+		Identifier: identifier,
+		Password:   password,
+		IP:         ip,
+	})
+}
+
+func TestLoginLocksIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		// password is tried once the identifier is locked and once again
+		// after the lock has passed
+		password    string
+		wantAfterOK bool
+	}{
+		{name: "existing account", identifier: "alice", password: testPassword, wantAfterOK: true},
+		{name: "existing account by email", identifier: "alice@example.com", password: testPassword, wantAfterOK: true},
+		{name: "unknown identifier", identifier: "mallory", password: testPassword},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLoginFixture(t)
+
+			for i := int32(0); i < testUserPolicy.MaxAttempts; i++ {
+				// A fresh IP per attempt keeps the IP scope out of the way
+				_, err := f.login(tt.identifier, "wrong password", uuid.NewString())
+				if !errors.Is(err, domain.ErrInvalidCredentials) {
+					t.Fatalf("attempt %d: err = %v, want ErrInvalidCredentials", i+1, err)
+				}
+			}
+
+			// Locked whether or not an account exists, even with the
+			// right password
+			_, err := f.login(tt.identifier, tt.password, uuid.NewString())
+			var locked *domain.LockedError
+			if !errors.As(err, &locked) {
+				t.Fatalf("err = %v, want *LockedError", err)
+			}
+			if locked.Scope != domain.ThrottleScopeUser {
+				t.Errorf("locked scope = %q, want %q", locked.Scope, domain.ThrottleScopeUser)
+			}
+			if want := f.now.Add(testUserPolicy.BaseLockout); !locked.Until.Equal(want) {
+				t.Errorf("locked until %s, want %s", locked.Until, want)
+			}
+
+			f.now = f.now.Add(testUserPolicy.BaseLockout)
+			res, err := f.login(tt.identifier, tt.password, uuid.NewString())
+			if tt.wantAfterOK {
+				if err != nil {
+					t.Fatalf("login after lock: %v", err)
+				}
+				if res.UserID != f.user.ID || res.Tokens.RefreshToken == "" {
+					t.Errorf("login after lock returned %+v", res)
+				}
+			} else if !errors.Is(err, domain.ErrInvalidCredentials) {
+				t.Fatalf("login after lock: err = %v, want ErrInvalidCredentials", err)
+			}
+		})
+	}
+}
+
+func TestLoginLockoutDoublesAndResetsOnSuccess(t *testing.T) {
+	f := newLoginFixture(t)
+	subject := domain.LoginSubject(f.tenantID, "alice")
+
+	fail := func() {
+		t.Helper()
+		for i := int32(0); i < testUserPolicy.MaxAttempts; i++ {
+			if _, err := f.login("alice", "wrong password", ""); !errors.Is(err, domain.ErrInvalidCredentials) {
+				t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+			}
+		}
+	}
+
+	for _, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute} {
+		fail()
+		throttle, _ := f.throttles.Get(context.Background(), domain.ThrottleScopeUser, subject)
+		if !throttle.IsLocked(f.now) {
+			t.Fatalf("not locked after %d failures", testUserPolicy.MaxAttempts)
+		}
+		if got := throttle.LockedUntil.Sub(f.now); got != want {
+			t.Errorf("lock lasts %s, want %s", got, want)
+		}
+		f.now = *throttle.LockedUntil
+	}
+
+	if _, err := f.login("alice", testPassword, ""); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	throttle, _ := f.throttles.Get(context.Background(), domain.ThrottleScopeUser, subject)
+	if throttle.FailedAttempts != 0 || throttle.LockoutCount != 0 || throttle.LockedUntil != nil {
+		t.Errorf("throttle after success = %+v, want cleared", throttle)
+	}
+}
+
+func TestLoginLocksIP(t *testing.T) {
+	f := newLoginFixture(t)
+	const ip = "203.0.113.9"
+
+	// Spread over identifiers so only the IP reaches its threshold
+	identifiers := []string{"alice", "alice@example.com", "alice", "alice@example.com"}
+	for i, identifier := range identifiers {
+		if _, err := f.login(identifier, "wrong password", ip); !errors.Is(err, domain.ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: err = %v, want ErrInvalidCredentials", i+1, err)
+		}
+	}
+
+	_, err := f.login("alice", testPassword, ip)
+	var locked *domain.LockedError
+	if !errors.As(err, &locked) || locked.Scope != domain.ThrottleScopeIP {
+		t.Fatalf("err = %v, want IP *LockedError", err)
+	}
+
+	if _, err := f.login("alice", testPassword, "198.51.100.1"); err != nil {
+		t.Fatalf("login from another IP: %v", err)
+	}
+}
+
+func TestLoginAuditsWrongPasswordOnly(t *testing.T) {
+	f := newLoginFixture(t)
+
+	if _, err := f.login("mallory", "wrong password", ""); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+	if len(f.events.events) != 0 {
+		t.Fatalf("unknown identifier wrote %d audit events", len(f.events.events))
+	}
+
+	if _, err := f.login("alice", "wrong password", ""); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+	if len(f.events.events) != 1 || f.events.events[0].Data["success"] != false {
+		t.Fatalf("audit events = %+v, want one failed login", f.events.events)
+	}
+}
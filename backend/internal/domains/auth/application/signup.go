@@ -1 +1,150 @@
-package application
\ No newline at end of file
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	authrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository"
+	subscription "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	subscriptionrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/infrastructure/repository"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/google/uuid"
+)
+
+// ErrFreePlanMissing is returned when no free plan has been seeded.
+var ErrFreePlanMissing = errors.New("free plan is not configured")
+
+// duplicateFields maps unique constraints from the 0003 and 0004 migrations to the signup field they guard.
+var duplicateFields = map[string]string{
+	"tenants_name_key":         "tenant_name",
+	"uq_tenant_users_username": "username",
+	"uq_tenant_users_email":    "email",
+}
+
+// SignupInput is the data needed to onboard a new tenant and its first user.
+type SignupInput struct {
+	TenantName string
+	Username   string
+	Email      string
+	Password   string
+}
+
+// SignupResult identifies the rows created by a successful signup.
+type SignupResult struct {
+	TenantID       uuid.UUID
+	UserID         uuid.UUID
+	SubscriptionID uuid.UUID
+	Role           string
+}
+
+// SignupService provisions a tenant together with its first admin user.
+type SignupService struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// NewSignupService creates a SignupService.
+func NewSignupService(db *sql.DB) *SignupService {
+	return &SignupService{db: db, now: time.Now}
+}
+
+// Signup creates the tenant, its admin user, membership, default settings,
+// storage usage counter and free-plan subscription in a single transaction.
+// Duplicate tenant names, usernames or emails are reported as *domain.DuplicateError.
+func (s *SignupService) Signup(ctx context.Context, in SignupInput) (*SignupResult, error) {
+	// Validate input before touching the database
+	newTenant, err := tenant.NewTenant(in.TenantName)
+	if err != nil {
+		return nil, err
+	}
+	newUser, err := domain.NewUser(uuid.Nil, in.Username, in.Email, in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	newUser.PasswordHash, err = auth.HashPassword(in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SignupResult
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		tenants := tenantrepo.NewTenantRepository(tx)
+		users := authrepo.NewUserRepository(tx)
+		subscriptions := subscriptionrepo.NewSubscriptionRepository(tx)
+
+		createdTenant, err := tenants.Create(ctx, newTenant)
+		if err != nil {
+			return mapDuplicate(err, newTenant.Name, newUser)
+		}
+
+		newUser.TenantID = createdTenant.ID
+		createdUser, err := users.Create(ctx, newUser)
+		if err != nil {
+			return mapDuplicate(err, newTenant.Name, newUser)
+		}
+
+		if err := tenants.AddMember(ctx, createdTenant.ID, createdUser.ID, domain.RoleTenantAdmin); err != nil {
+			return fmt.Errorf("failed to add tenant admin: %w", err)
+		}
+		if err := tenants.CreateDefaultSettings(ctx, createdTenant.ID); err != nil {
+			return fmt.Errorf("failed to create tenant settings: %w", err)
+		}
+		if err := tenants.CreateStorageUsage(ctx, createdTenant.ID); err != nil {
+			return fmt.Errorf("failed to create tenant storage usage: %w", err)
+		}
+
+		plan, err := subscriptions.GetPlanByType(ctx, subscription.PlanFree, subscription.BillingMonthly)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrFreePlanMissing
+			}
+			return fmt.Errorf("failed to load free plan: %w", err)
+		}
+
+		sub, err := subscriptions.Create(ctx, subscription.NewFreeSubscription(createdTenant.ID, plan.ID, s.now()))
+		if err != nil {
+			return fmt.Errorf("failed to create subscription: %w", err)
+		}
+
+		result = SignupResult{
+			TenantID:       createdTenant.ID,
+			UserID:         createdUser.ID,
+			SubscriptionID: sub.ID,
+			Role:           domain.RoleTenantAdmin,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// mapDuplicate converts a unique violation into a *domain.DuplicateError for the offending field.
+func mapDuplicate(err error, tenantName string, u *domain.User) error {
+	constraint, ok := postgres.UniqueViolation(err)
+	if !ok {
+		return fmt.Errorf("failed to create signup records: %w", err)
+	}
+
+	field, known := duplicateFields[constraint]
+	if !known {
+		return fmt.Errorf("failed to create signup records: %w", err)
+	}
+
+	values := map[string]string{
+		"tenant_name": tenantName,
+		"username":    u.Username,
+		"email":       u.Email,
+	}
+	return &domain.DuplicateError{Field: field, Value: values[field]}
+}
@@ -0,0 +1,128 @@
+package domain
+
+import "errors"
+
+// Roles catalogued in the roles table and assigned through tenant_users.role.
+const (
+	RoleSuperAdmin   = "super_admin"
+	RoleTenantAdmin  = "tenant_admin"
+	RoleTenantEditor = "tenant_editor"
+	RoleViewer       = "viewer"
+)
+
+// ErrInvalidRole is returned for a role name outside the catalog.
+var ErrInvalidRole = errors.New("role must be one of super_admin, tenant_admin, tenant_editor or viewer")
+
+// Permission is an action a principal may perform, written as resource:action.
+type Permission string
+
+// Permissions checked by the API.
+const (
+	PermTenantRead   Permission = "tenant:read"
+	PermTenantManage Permission = "tenant:manage"
+
+	PermUserRead   Permission = "user:read"
+	PermUserManage Permission = "user:manage"
+
+	PermListingRead    Permission = "listing:read"
+	PermListingCreate  Permission = "listing:create"
+	PermListingUpdate  Permission = "listing:update"
+	PermListingDelete  Permission = "listing:delete"
+	PermListingPublish Permission = "listing:publish"
+
+	PermPhotoRead   Permission = "photo:read"
+	PermPhotoUpload Permission = "photo:upload"
+	PermPhotoDelete Permission = "photo:delete"
+
+	PermShareRead   Permission = "share:read"
+	PermShareCreate Permission = "share:create"
+	PermShareRevoke Permission = "share:revoke"
+
+	PermBillingRead   Permission = "billing:read"
+	PermBillingManage Permission = "billing:manage"
+
+	PermNotificationRead Permission = "notification:read"
+	PermAuditRead        Permission = "audit:read"
+)
+
+// Policy maps each role to the permissions it grants.
+type Policy map[string][]Permission
+
+var viewerPermissions = []Permission{
+	PermTenantRead,
+	PermListingRead,
+	PermPhotoRead,
+	PermShareRead,
+	PermNotificationRead,
+}
+
+var editorPermissions = append(append([]Permission{}, viewerPermissions...),
+	PermUserRead,
+	PermListingCreate,
+	PermListingUpdate,
+	PermListingDelete,
+	PermListingPublish,
+	PermPhotoUpload,
+	PermPhotoDelete,
+	PermShareCreate,
+	PermShareRevoke,
+)
+
+var adminPermissions = append(append([]Permission{}, editorPermissions...),
+	PermTenantManage,
+	PermUserManage,
+	PermBillingRead,
+	PermBillingManage,
+	PermAuditRead,
+)
+
+// DefaultPolicy is the platform's role to permission mapping. super_admin is
+// handled separately and is granted every permission.
+var DefaultPolicy = Policy{
+	RoleTenantAdmin:  adminPermissions,
+	RoleTenantEditor: editorPermissions,
+	RoleViewer:       viewerPermissions,
+}
+
+// ValidateRole returns ErrInvalidRole unless role is in the catalog.
+func ValidateRole(role string) error {
+	switch role {
+	case RoleSuperAdmin, RoleTenantAdmin, RoleTenantEditor, RoleViewer:
+		return nil
+	}
+	return ErrInvalidRole
+}
+
+// Allows reports whether any of roles grants perm.
+func (p Policy) Allows(roles []string, perm Permission) bool {
+	for _, role := range roles {
+		if role == RoleSuperAdmin {
+			return true
+		}
+		for _, granted := range p[role] {
+			if granted == perm {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Permissions returns the distinct permissions granted by roles.
+func (p Policy) Permissions(roles []string) []Permission {
+	seen := make(map[Permission]bool)
+	var perms []Permission
+	for _, role := range roles {
+		granted := p[role]
+		if role == RoleSuperAdmin {
+			granted = adminPermissions
+		}
+		for _, perm := range granted {
+			if !seen[perm] {
+				seen[perm] = true
+				perms = append(perms, perm)
+			}
+		}
+	}
+	return perms
+}
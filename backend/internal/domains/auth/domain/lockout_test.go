@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestLockoutPolicyShouldLock(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy LockoutPolicy
+		failed int32
+		want   bool
+	}{
+		{name: "user below threshold", policy: DefaultUserLockout, failed: 4, want: false},
+		{name: "user at threshold", policy: DefaultUserLockout, failed: 5, want: true},
+		{name: "ip below threshold", policy: DefaultIPLockout, failed: 19, want: false},
+		{name: "ip at threshold", policy: DefaultIPLockout, failed: 20, want: true},
+		{name: "share link at threshold", policy: DefaultShareLinkLockout, failed: 5, want: true},
+		{name: "past threshold", policy: DefaultUserLockout, failed: 9, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.ShouldLock(tt.failed); got != tt.want {
+				t.Errorf("ShouldLock(%d) = %v, want %v", tt.failed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockoutPolicyLockDuration(t *testing.T) {
+	policy := LockoutPolicy{BaseLockout: time.Minute, MaxLockout: 10 * time.Minute}
+
+	tests := []struct {
+		previous int32
+		want     time.Duration
+	}{
+		{previous: 0, want: time.Minute},
+		{previous: 1, want: 2 * time.Minute},
+		{previous: 3, want: 8 * time.Minute},
+		{previous: 4, want: 10 * time.Minute},
+		{previous: 100, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := policy.LockDuration(tt.previous); got != tt.want {
+			t.Errorf("LockDuration(%d) = %s, want %s", tt.previous, got, tt.want)
+		}
+	}
+}
+
+func TestLoginSubject(t *testing.T) {
+	tenant, other := uuid.New(), uuid.New()
+
+	if LoginSubject(tenant, " Alice@Example.com ") != LoginSubject(tenant, "alice@example.com") {
+		t.Error("LoginSubject depends on case or surrounding space")
+	}
+	if LoginSubject(tenant, "alice") == LoginSubject(other, "alice") {
+		t.Error("LoginSubject is shared across tenants")
+	}
+	if LoginSubject(tenant, "alice") == LoginSubject(tenant, "alice@example.com") {
+		t.Error("LoginSubject conflates different identifiers")
+	}
+}
@@ -1 +1,103 @@
-package domain
\ No newline at end of file
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Password length bounds. bcrypt ignores everything after 72 bytes.
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+var (
+	// usernamePattern mirrors chk_users_username_format and chk_users_username_length.
+	usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_]{3,50}$`)
+
+	// emailPattern mirrors chk_users_email_format.
+	emailPattern = regexp.MustCompile(`(?i)^[A-Z0-9._%+-]+@[A-Z0-9.-]+\.[A-Z]{2,}$`)
+)
+
+// Lookup and authentication errors.
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Validation errors for user fields.
+var (
+	ErrInvalidUsername = errors.New("username must be 3-50 letters, digits or underscores")
+	ErrInvalidEmail    = errors.New("email address is invalid")
+	ErrInvalidPassword = fmt.Errorf("password must be between %d and %d characters", MinPasswordLength, MaxPasswordLength)
+)
+
+// User is an account that belongs to exactly one tenant.
+type User struct {
+	ID           uuid.UUID
+	TenantID     uuid.UUID
+	Username     string
+	Email        string
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewUser validates the user's fields and returns a user ready to be persisted.
+// The password is validated here but hashed by the caller.
+func NewUser(tenantID uuid.UUID, username, email, password string) (*User, error) {
+	username = strings.TrimSpace(username)
+	email = NormalizeEmail(email)
+
+	if !usernamePattern.MatchString(username) {
+		return nil, ErrInvalidUsername
+	}
+	if err := ValidateEmail(email); err != nil {
+		return nil, err
+	}
+	if err := ValidatePassword(password); err != nil {
+		return nil, err
+	}
+
+	return &User{
+		TenantID: tenantID,
+		Username: username,
+		Email:    email,
+	}, nil
+}
+
+// ValidateEmail checks a normalized email address against chk_users_email_format.
+func ValidateEmail(email string) error {
+	if len(email) > 100 || !emailPattern.MatchString(email) {
+		return ErrInvalidEmail
+	}
+	return nil
+}
+
+// ValidatePassword checks the password length policy.
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength || len(password) > MaxPasswordLength {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+// NormalizeEmail trims and lower-cases an email address so lookups are case-insensitive.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// DuplicateError reports that a uniquely constrained field is already taken.
+type DuplicateError struct {
+	Field string
+	Value string
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%s %q is already taken", e.Field, e.Value)
+}
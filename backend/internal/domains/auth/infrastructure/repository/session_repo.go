@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// SessionRepository implements auth.SessionStore on top of the auth_sessions table.
+type SessionRepository struct {
+	q *sqlc.Queries
+}
+
+// NewSessionRepository creates a SessionRepository using the given connection or transaction.
+func NewSessionRepository(db sqlc.DBTX) *SessionRepository {
+	return &SessionRepository{q: sqlc.New(db)}
+}
+
+// CreateSession stores a new refresh token session.
+func (r *SessionRepository) CreateSession(ctx context.Context, s auth.Session) (auth.Session, error) {
+	row, err := r.q.CreateAuthSession(ctx, sqlc.CreateAuthSessionParams{
+		UserID:       s.UserID,
+		TenantID:     s.TenantID,
+		FamilyID:     s.FamilyID,
+		RefreshToken: s.TokenHash,
+		ExpiresAt:    s.ExpiresAt,
+	})
+	if err != nil {
+		return auth.Session{}, err
+	}
+
+	return auth.Session{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		TenantID:  row.TenantID,
+		FamilyID:  row.FamilyID,
+		TokenHash: row.RefreshToken,
+		Role:      s.Role,
+		ExpiresAt: row.ExpiresAt,
+		RotatedAt: nullTimePtr(row.RotatedAt),
+		RevokedAt: nullTimePtr(row.RevokedAt),
+	}, nil
+}
+
+// GetSessionByTokenHash looks up a session by the hash of its refresh token.
+func (r *SessionRepository) GetSessionByTokenHash(ctx context.Context, tokenHash string) (auth.Session, error) {
+	row, err := r.q.GetAuthSessionByToken(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return auth.Session{}, auth.ErrSessionNotFound
+		}
+		return auth.Session{}, err
+	}
+
+	return auth.Session{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		TenantID:  row.TenantID,
+		FamilyID:  row.FamilyID,
+		TokenHash: row.RefreshToken,
+		Role:      row.Role,
+		ExpiresAt: row.ExpiresAt,
+		RotatedAt: nullTimePtr(row.RotatedAt),
+		RevokedAt: nullTimePtr(row.RevokedAt),
+	}, nil
+}
+
+// MarkSessionRotated flags a session as used. It reports false if another
+// request already rotated or revoked it.
+func (r *SessionRepository) MarkSessionRotated(ctx context.Context, id uuid.UUID) (bool, error) {
+	if _, err := r.q.MarkAuthSessionRotated(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// RevokeSessionFamily revokes every session that shares familyID.
+func (r *SessionRepository) RevokeSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	return r.q.RevokeAuthSessionFamily(ctx, familyID)
+}
+
+// nullTimePtr converts a sql.NullTime into an optional time.
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -43,26 +43,43 @@ func (q *Queries) CountUsersByCreationDate(ctx context.Context, arg CountUsersBy
 }
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (email, password_hash, created_at)
-VALUES ($1, $2, NOW())
-RETURNING id, email, created_at
+INSERT INTO users (tenant_id, username, email, password_hash, created_at)
+VALUES ($1, $2, $3, $4, NOW())
+RETURNING id, tenant_id, username, email, created_at, updated_at
 `
 
 type CreateUserParams struct {
-	Email        string `json:"email"`
-	PasswordHash string `json:"password_hash"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+	Username     string    `json:"username"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"password_hash"`
 }
 
 type CreateUserRow struct {
 	ID        uuid.UUID `json:"id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	Username  string    `json:"username"`
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error) {
-	row := q.queryRow(ctx, q.createUserStmt, createUser, arg.Email, arg.PasswordHash)
+	row := q.queryRow(ctx, q.createUserStmt, createUser,
+		arg.TenantID,
+		arg.Username,
+		arg.Email,
+		arg.PasswordHash,
+	)
 	var i CreateUserRow
-	err := row.Scan(&i.ID, &i.Email, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Username,
+		&i.Email,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
 
@@ -149,6 +166,46 @@ func (q *Queries) GetUserCreationDate(ctx context.Context, id uuid.UUID) (time.T
 	return created_at, err
 }
 
+const getUserForLogin = `-- name: GetUserForLogin :one
+SELECT u.id, u.tenant_id, u.username, u.email, u.password_hash, u.created_at, u.updated_at, tu.role
+FROM users u
+JOIN tenant_users tu ON tu.tenant_id = u.tenant_id AND tu.user_id = u.id
+WHERE u.tenant_id = $1
+  AND (lower(u.email) = lower($2) OR u.username = $2)
+`
+
+type GetUserForLoginParams struct {
+	TenantID   uuid.UUID `json:"tenant_id"`
+	Identifier string    `json:"identifier"`
+}
+
+type GetUserForLoginRow struct {
+	ID           uuid.UUID `json:"id"`
+	TenantID     uuid.UUID `json:"tenant_id"`
+	Username     string    `json:"username"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"password_hash"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Role         string    `json:"role"`
+}
+
+func (q *Queries) GetUserForLogin(ctx context.Context, arg GetUserForLoginParams) (GetUserForLoginRow, error) {
+	row := q.queryRow(ctx, q.getUserForLoginStmt, getUserForLogin, arg.TenantID, arg.Identifier)
+	var i GetUserForLoginRow
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Username,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+	)
+	return i, err
+}
+
 const getUserPasswordHash = `-- name: GetUserPasswordHash :one
 SELECT password_hash
 FROM users
@@ -354,52 +411,52 @@ func (q *Queries) UpdateUserEmail(ctx context.Context, arg UpdateUserEmailParams
 	return i, err
 }
 
-const updateUserPassword = `-- name: UpdateUserPassword :one
+const updateUserPasswordByEmail = `-- name: UpdateUserPasswordByEmail :one
 UPDATE users
 SET password_hash = $2
-WHERE id = $1
+WHERE email = $1
 RETURNING id, email, created_at
 `
 
-type UpdateUserPasswordParams struct {
-	ID           uuid.UUID `json:"id"`
-	PasswordHash string    `json:"password_hash"`
+type UpdateUserPasswordByEmailParams struct {
+	Email        string `json:"email"`
+	PasswordHash string `json:"password_hash"`
 }
 
-type UpdateUserPasswordRow struct {
+type UpdateUserPasswordByEmailRow struct {
 	ID        uuid.UUID `json:"id"`
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
-func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (UpdateUserPasswordRow, error) {
-	row := q.queryRow(ctx, q.updateUserPasswordStmt, updateUserPassword, arg.ID, arg.PasswordHash)
-	var i UpdateUserPasswordRow
+func (q *Queries) UpdateUserPasswordByEmail(ctx context.Context, arg UpdateUserPasswordByEmailParams) (UpdateUserPasswordByEmailRow, error) {
+	row := q.queryRow(ctx, q.updateUserPasswordByEmailStmt, updateUserPasswordByEmail, arg.Email, arg.PasswordHash)
+	var i UpdateUserPasswordByEmailRow
 	err := row.Scan(&i.ID, &i.Email, &i.CreatedAt)
 	return i, err
 }
 
-const updateUserPasswordByEmail = `-- name: UpdateUserPasswordByEmail :one
+const updateUserPasswordById = `-- name: UpdateUserPasswordById :one
 UPDATE users
 SET password_hash = $2
-WHERE email = $1
+WHERE id = $1
 RETURNING id, email, created_at
 `
 
-type UpdateUserPasswordByEmailParams struct {
-	Email        string `json:"email"`
-	PasswordHash string `json:"password_hash"`
+type UpdateUserPasswordByIdParams struct {
+	ID           uuid.UUID `json:"id"`
+	PasswordHash string    `json:"password_hash"`
 }
 
-type UpdateUserPasswordByEmailRow struct {
+type UpdateUserPasswordByIdRow struct {
 	ID        uuid.UUID `json:"id"`
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
-func (q *Queries) UpdateUserPasswordByEmail(ctx context.Context, arg UpdateUserPasswordByEmailParams) (UpdateUserPasswordByEmailRow, error) {
-	row := q.queryRow(ctx, q.updateUserPasswordByEmailStmt, updateUserPasswordByEmail, arg.Email, arg.PasswordHash)
-	var i UpdateUserPasswordByEmailRow
+func (q *Queries) UpdateUserPasswordById(ctx context.Context, arg UpdateUserPasswordByIdParams) (UpdateUserPasswordByIdRow, error) {
+	row := q.queryRow(ctx, q.updateUserPasswordByIdStmt, updateUserPasswordById, arg.ID, arg.PasswordHash)
+	var i UpdateUserPasswordByIdRow
 	err := row.Scan(&i.ID, &i.Email, &i.CreatedAt)
 	return i, err
 }
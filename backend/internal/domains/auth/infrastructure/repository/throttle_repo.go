@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository/sqlc"
+)
+
+// ThrottleRepository persists failed login counters in login_throttles.
+type ThrottleRepository struct {
+	q *sqlc.Queries
+}
+
+// NewThrottleRepository creates a ThrottleRepository using the given connection or transaction.
+func NewThrottleRepository(db sqlc.DBTX) *ThrottleRepository {
+	return &ThrottleRepository{q: sqlc.New(db)}
+}
+
+// Get returns the throttle state for the subject, or an empty state if none is recorded.
+func (r *ThrottleRepository) Get(ctx context.Context, scope, subject string) (*domain.LoginThrottle, error) {
+	row, err := r.q.GetLoginThrottle(ctx, sqlc.GetLoginThrottleParams{Scope: scope, Subject: subject})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &domain.LoginThrottle{Scope: scope, Subject: subject}, nil
+		}
+		return nil, err
+	}
+	return &domain.LoginThrottle{
+		Scope:          row.Scope,
+		Subject:        row.Subject,
+		FailedAttempts: row.FailedAttempts,
+		LockoutCount:   row.LockoutCount,
+		LockedUntil:    nullTimePtr(row.LockedUntil),
+	}, nil
+}
+
+// RecordFailure counts a failed attempt. Failures before windowStart are
+// forgotten, and lockouts are forgotten after a quiet period ending at resetBefore.
+func (r *ThrottleRepository) RecordFailure(ctx context.Context, scope, subject string, windowStart, resetBefore time.Time) (*domain.LoginThrottle, error) {
+	row, err := r.q.RecordLoginFailure(ctx, sqlc.RecordLoginFailureParams{
+		Scope:       scope,
+		Subject:     subject,
+		WindowStart: windowStart,
+		ResetBefore: resetBefore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.LoginThrottle{
+		Scope:          row.Scope,
+		Subject:        row.Subject,
+		FailedAttempts: row.FailedAttempts,
+		LockoutCount:   row.LockoutCount,
+		LockedUntil:    nullTimePtr(row.LockedUntil),
+	}, nil
+}
+
+// Lock locks the subject until the given time and bumps its lockout count.
+func (r *ThrottleRepository) Lock(ctx context.Context, scope, subject string, until time.Time) error {
+	_, err := r.q.LockLoginThrottle(ctx, sqlc.LockLoginThrottleParams{
+		Scope:       scope,
+		Subject:     subject,
+		LockedUntil: sql.NullTime{Time: until, Valid: true},
+	})
+	return err
+}
+
+// Reset clears the throttle state after a successful login.
+func (r *ThrottleRepository) Reset(ctx context.Context, scope, subject string) error {
+	return r.q.ResetLoginThrottle(ctx, sqlc.ResetLoginThrottleParams{Scope: scope, Subject: subject})
+}
@@ -1 +1,69 @@
-package repository
\ No newline at end of file
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// UserRepository persists users.
+type UserRepository struct {
+	q *sqlc.Queries
+}
+
+// NewUserRepository creates a UserRepository using the given connection or transaction.
+func NewUserRepository(db sqlc.DBTX) *UserRepository {
+	return &UserRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the user and returns it with its generated ID and timestamps.
+func (r *UserRepository) Create(ctx context.Context, u *domain.User) (*domain.User, error) {
+	row, err := r.q.CreateUser(ctx, sqlc.CreateUserParams{
+		TenantID:     u.TenantID,
+		Username:     u.Username,
+		Email:        u.Email,
+		PasswordHash: u.PasswordHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.User{
+		ID:           row.ID,
+		TenantID:     row.TenantID,
+		Username:     row.Username,
+		Email:        row.Email,
+		PasswordHash: u.PasswordHash,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}, nil
+}
+
+// GetForLogin finds a tenant's user by email or username and returns it with
+// the user's membership role.
+func (r *UserRepository) GetForLogin(ctx context.Context, tenantID uuid.UUID, identifier string) (*domain.User, string, error) {
+	row, err := r.q.GetUserForLogin(ctx, sqlc.GetUserForLoginParams{
+		TenantID:   tenantID,
+		Identifier: identifier,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", domain.ErrUserNotFound
+		}
+		return nil, "", err
+	}
+
+	return &domain.User{
+		ID:           row.ID,
+		TenantID:     row.TenantID,
+		Username:     row.Username,
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}, row.Role, nil
+}
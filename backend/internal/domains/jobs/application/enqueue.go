@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// Enqueuer adds jobs to the background queue.
+type Enqueuer struct {
+	repo *infrastructure.JobRepository
+}
+
+// NewEnqueuer creates an Enqueuer. Pass a transaction to enqueue the job in
+// the same unit of work as the change that needs it, so neither is lost.
+func NewEnqueuer(db sqlc.DBTX) *Enqueuer {
+	return &Enqueuer{repo: infrastructure.NewJobRepository(db)}
+}
+
+// Enqueue queues a job of jobType with payload for the tenant.
+func (e *Enqueuer) Enqueue(ctx context.Context, tenantID uuid.UUID, jobType string, payload any) error {
+	job, err := domain.NewJob(tenantID, jobType, payload)
+	if err != nil {
+		return err
+	}
+	return e.EnqueueJob(ctx, job)
+}
+
+// EnqueueJob queues a prepared job, e.g. one with a UniqueKey or delayed RunAt.
+func (e *Enqueuer) EnqueueJob(ctx context.Context, job *domain.Job) error {
+	if err := e.repo.Enqueue(ctx, job); err != nil {
+		return fmt.Errorf("failed to enqueue %s job: %w", job.Type, err)
+	}
+	return nil
+}
+
+// RetryDead requeues a dead-lettered job.
+func (e *Enqueuer) RetryDead(ctx context.Context, jobID uuid.UUID) error {
+	if err := e.repo.RetryDead(ctx, jobID); err != nil {
+		return fmt.Errorf("failed to retry job %s: %w", jobID, err)
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/google/uuid"
+)
+
+// Defaults for zero Options fields.
+const (
+	defaultConcurrency     = 4
+	defaultPollInterval    = time.Second
+	defaultJobTimeout      = 5 * time.Minute
+	defaultShutdownTimeout = 30 * time.Second
+)
+
+const (
+	// leaseGrace is added to the job timeout before a running job is treated
+	// as abandoned by a crashed worker and put back in the queue.
+	leaseGrace = 5 * time.Minute
+
+	maintenanceInterval = time.Minute
+	succeededRetention  = 7 * 24 * time.Hour
+
+	// settleTimeout bounds recording a job's outcome, which happens on a
+	// fresh context so results are kept even while shutting down.
+	settleTimeout = 10 * time.Second
+)
+
+// HandlerFunc runs one job. Returning an error retries the job with backoff
+// unless the error is wrapped with domain.Permanent or attempts are used up.
+// Handlers must stop promptly when ctx is cancelled.
+type HandlerFunc func(ctx context.Context, job *domain.Job) error
+
+// Options tunes a Worker.
+type Options struct {
+	// Concurrency is the number of jobs run at once by this process.
+	Concurrency int
+	// TenantConcurrency caps running jobs per tenant across all workers; zero means no cap.
+	TenantConcurrency int
+	// PollInterval is how long an idle worker waits before checking the queue again.
+	PollInterval time.Duration
+	// JobTimeout bounds a single attempt.
+	JobTimeout time.Duration
+	// ShutdownTimeout is how long in-flight jobs may finish after shutdown starts.
+	ShutdownTimeout time.Duration
+}
+
+// jobQueue claims jobs and records their outcomes.
+type jobQueue interface {
+	Claim(ctx context.Context, workerID string, types []string, tenantLimit int32) (*domain.Job, error)
+	Complete(ctx context.Context, id uuid.UUID, workerID string) error
+	Retry(ctx context.Context, id uuid.UUID, workerID string, runAt time.Time, lastError string) error
+	Release(ctx context.Context, id uuid.UUID, workerID string) error
+	Bury(ctx context.Context, id uuid.UUID, workerID string, lastError string) error
+}
+
+// txJobQueue claims each job in its own transaction, which holds the job's
+// row lock and the tenant's claim lock until the claim is recorded.
+type txJobQueue struct {
+	*infrastructure.JobRepository
+	db *sql.DB
+}
+
+func (q txJobQueue) Claim(ctx context.Context, workerID string, types []string, tenantLimit int32) (*domain.Job, error) {
+	var job *domain.Job
+	err := postgres.WithTx(ctx, q.db, func(tx *sql.Tx) error {
+		var err error
+		job, err = infrastructure.NewJobRepository(tx).Claim(ctx, workerID, types, tenantLimit)
+		return err
+	})
+	return job, err
+}
+
+// Worker claims jobs from the queue and dispatches them to registered handlers.
+type Worker struct {
+	db       *sql.DB
+	queue    jobQueue
+	id       string
+	opts     Options
+	handlers map[string]HandlerFunc
+	// schedules maps job types queued periodically to their interval.
+	schedules map[string]time.Duration
+}
+
+// NewWorker creates a Worker with no handlers registered.
+func NewWorker(db *sql.DB, opts Options) *Worker {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultConcurrency
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.JobTimeout <= 0 {
+		opts.JobTimeout = defaultJobTimeout
+	}
+	if opts.ShutdownTimeout <= 0 {
+		opts.ShutdownTimeout = defaultShutdownTimeout
+	}
+
+	hostname, _ := os.Hostname()
+	return &Worker{
+		db:        db,
+		queue:     txJobQueue{JobRepository: infrastructure.NewJobRepository(db), db: db},
+		id:        fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8]),
+		opts:      opts,
+		handlers:  make(map[string]HandlerFunc),
+		schedules: make(map[string]time.Duration),
+	}
+}
+
+// Register installs the handler for jobType. It panics on an invalid or
+// duplicate type, since either is a wiring mistake.
+func (w *Worker) Register(jobType string, handler HandlerFunc) {
+	if err := domain.ValidateJobType(jobType); err != nil {
+		panic(fmt.Sprintf("jobs: %v: %q", err, jobType))
+	}
+	if _, exists := w.handlers[jobType]; exists {
+		panic(fmt.Sprintf("jobs: handler already registered for %q", jobType))
+	}
+	w.handlers[jobType] = handler
+}
+
+// Handle registers a handler that receives the job payload decoded as T.
+// Payloads that do not decode are dead-lettered without retrying.
+func Handle[T any](w *Worker, jobType string, fn func(ctx context.Context, job *domain.Job, payload T) error) {
+	w.Register(jobType, func(ctx context.Context, job *domain.Job) error {
+		var payload T
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return domain.Permanent(fmt.Errorf("failed to decode %s payload: %w", jobType, err))
+		}
+		return fn(ctx, job, payload)
+	})
+}
+
+// Schedule queues a system job of jobType with an empty payload once every
+// interval, aligned to multiples of interval. The job's unique key keeps
+// every worker from queuing its own copy. Register the handler separately.
+func (w *Worker) Schedule(jobType string, interval time.Duration) {
+	if err := domain.ValidateJobType(jobType); err != nil {
+		panic(fmt.Sprintf("jobs: %v: %q", err, jobType))
+	}
+	if interval <= 0 {
+		panic(fmt.Sprintf("jobs: non-positive interval for %q", jobType))
+	}
+	w.schedules[jobType] = interval
+}
+
+// Run processes jobs until ctx is cancelled, then stops claiming and waits up
+// to ShutdownTimeout for in-flight jobs. Jobs still running after that are
+// cancelled and returned to the queue.
+func (w *Worker) Run(ctx context.Context) {
+	types := make([]string, 0, len(w.handlers))
+	for jobType := range w.handlers {
+		types = append(types, jobType)
+	}
+	slices.Sort(types)
+	if len(types) == 0 {
+		log.Printf("worker %s: no job handlers registered", w.id)
+	}
+	log.Printf("worker %s: running %d slots for %v", w.id, w.opts.Concurrency, types)
+
+	jobCtx, cancelJobs := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelJobs()
+	go func() {
+		<-ctx.Done()
+		select {
+		case <-time.After(w.opts.ShutdownTimeout):
+			cancelJobs()
+		case <-jobCtx.Done():
+		}
+	}()
+
+	var wg sync.WaitGroup
+	if len(types) > 0 {
+		for range w.opts.Concurrency {
+			wg.Go(func() { w.loop(ctx, jobCtx, types) })
+		}
+	}
+	wg.Go(func() { w.maintain(ctx) })
+	wg.Wait()
+}
+
+// loop claims and runs jobs one at a time until ctx is cancelled.
+func (w *Worker) loop(ctx, jobCtx context.Context, types []string) {
+	for ctx.Err() == nil {
+		job, err := w.claim(ctx, types)
+		if err != nil {
+			if !errors.Is(err, domain.ErrNoJobs) && ctx.Err() == nil {
+				log.Printf("worker %s: failed to claim job: %v", w.id, err)
+			}
+			sleep(ctx, w.opts.PollInterval)
+			continue
+		}
+		w.process(jobCtx, job)
+	}
+}
+
+func (w *Worker) claim(ctx context.Context, types []string) (*domain.Job, error) {
+	// Zero leaves tenants uncapped and claims without a tenant lock
+	var tenantLimit int32
+	if w.opts.TenantConcurrency > 0 {
+		tenantLimit = int32(min(w.opts.TenantConcurrency, math.MaxInt32))
+	}
+
+	return w.queue.Claim(ctx, w.id, types, tenantLimit)
+}
+
+// process runs the job and records the outcome: success, retry with
+// backoff, dead-letter, or release when interrupted by shutdown.
+func (w *Worker) process(ctx context.Context, job *domain.Job) {
+	start := time.Now()
+	runCtx, cancel := context.WithTimeout(ctx, w.opts.JobTimeout)
+	err := w.invoke(runCtx, job)
+	cancel()
+
+	settleCtx, cancelSettle := context.WithTimeout(context.Background(), settleTimeout)
+	defer cancelSettle()
+
+	var settleErr error
+	switch {
+	case err == nil:
+		settleErr = w.queue.Complete(settleCtx, job.ID, w.id)
+	case ctx.Err() != nil:
+		log.Printf("worker %s: job %s (%s) interrupted by shutdown: %v", w.id, job.ID, job.Type, err)
+		settleErr = w.queue.Release(settleCtx, job.ID, w.id)
+	case domain.IsPermanent(err) || !job.CanRetry():
+		log.Printf("worker %s: job %s (%s) dead-lettered after %d attempts: %v", w.id, job.ID, job.Type, job.Attempts, err)
+		settleErr = w.queue.Bury(settleCtx, job.ID, w.id, err.Error())
+	default:
+		delay := domain.RetryDelay(job.Attempts)
+		log.Printf("worker %s: job %s (%s) failed on attempt %d/%d, retrying in %s: %v",
+			w.id, job.ID, job.Type, job.Attempts, job.MaxAttempts, delay.Round(time.Second), err)
+		settleErr = w.queue.Retry(settleCtx, job.ID, w.id, time.Now().Add(delay), err.Error())
+	}
+	if settleErr != nil {
+		log.Printf("worker %s: failed to record outcome of job %s (%s) after %s: %v",
+			w.id, job.ID, job.Type, time.Since(start).Round(time.Millisecond), settleErr)
+	}
+}
+
+// invoke runs the job's handler, turning a panic into a retryable error.
+func (w *Worker) invoke(ctx context.Context, job *domain.Job) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("handler panic: %v", recovered)
+		}
+	}()
+	return w.handlers[job.Type](ctx, job)
+}
+
+// maintain periodically recovers jobs abandoned by crashed workers, deletes
+// old succeeded jobs and queues the next run of scheduled jobs. Every worker
+// does this; all three are idempotent.
+func (w *Worker) maintain(ctx context.Context) {
+	repo := infrastructure.NewJobRepository(w.db)
+	for {
+		now := time.Now()
+		if n, err := repo.RequeueStale(ctx, now.Add(-(w.opts.JobTimeout + leaseGrace))); err != nil {
+			if ctx.Err() == nil {
+				log.Printf("worker %s: failed to recover stale jobs: %v", w.id, err)
+			}
+		} else if n > 0 {
+			log.Printf("worker %s: recovered %d stale jobs", w.id, n)
+		}
+		if _, err := repo.PurgeSucceeded(ctx, now.Add(-succeededRetention)); err != nil && ctx.Err() == nil {
+			log.Printf("worker %s: failed to purge finished jobs: %v", w.id, err)
+		}
+		w.enqueueScheduled(ctx, now)
+
+		if !sleep(ctx, maintenanceInterval) {
+			return
+		}
+	}
+}
+
+// enqueueScheduled queues the next run of each scheduled job type unless one
+// is already waiting.
+func (w *Worker) enqueueScheduled(ctx context.Context, now time.Time) {
+	enqueuer := NewEnqueuer(w.db)
+	for jobType, interval := range w.schedules {
+		job, err := domain.NewJob(uuid.Nil, jobType, struct{}{})
+		if err != nil {
+			log.Printf("worker %s: failed to schedule %s: %v", w.id, jobType, err)
+			continue
+		}
+		job.UniqueKey = "schedule:" + jobType
+		job.RunAt = now.Truncate(interval).Add(interval)
+		if err := enqueuer.EnqueueJob(ctx, job); err != nil && ctx.Err() == nil {
+			log.Printf("worker %s: %v", w.id, err)
+		}
+	}
+}
+
+// sleep waits for d, returning false if ctx is cancelled first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
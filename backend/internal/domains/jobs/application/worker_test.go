@@ -0,0 +1,167 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	"github.com/google/uuid"
+)
+
+// outcome is what the worker recorded for a job.
+type outcome struct {
+	state     string
+	runAt     time.Time
+	lastError string
+}
+
+type fakeQueue struct {
+	claimLimit int32
+	outcomes   map[uuid.UUID]outcome
+}
+
+func newFakeQueue() *fakeQueue {
+	return &fakeQueue{outcomes: make(map[uuid.UUID]outcome)}
+}
+
+func (q *fakeQueue) Claim(_ context.Context, _ string, _ []string, tenantLimit int32) (*domain.Job, error) {
+	q.claimLimit = tenantLimit
+	return nil, domain.ErrNoJobs
+}
+
+func (q *fakeQueue) Complete(_ context.Context, id uuid.UUID, _ string) error {
+	q.outcomes[id] = outcome{state: domain.StatusSucceeded}
+	return nil
+}
+
+func (q *fakeQueue) Retry(_ context.Context, id uuid.UUID, _ string, runAt time.Time, lastError string) error {
+	q.outcomes[id] = outcome{state: domain.StatusQueued, runAt: runAt, lastError: lastError}
+	return nil
+}
+
+func (q *fakeQueue) Release(_ context.Context, id uuid.UUID, _ string) error {
+	q.outcomes[id] = outcome{state: "released"}
+	return nil
+}
+
+func (q *fakeQueue) Bury(_ context.Context, id uuid.UUID, _ string, lastError string) error {
+	q.outcomes[id] = outcome{state: domain.StatusDead, lastError: lastError}
+	return nil
+}
+
+func newTestWorker(opts Options) (*Worker, *fakeQueue) {
+	w := NewWorker(nil, opts)
+	queue := newFakeQueue()
+	w.queue = queue
+	return w, queue
+}
+
+func TestWorkerProcess(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name      string
+		attempts  int
+		handler   HandlerFunc
+		cancel    bool
+		wantState string
+		wantError string
+	}{
+		{
+			name:      "success",
+			attempts:  1,
+			handler:   func(context.Context, *domain.Job) error { return nil },
+			wantState: domain.StatusSucceeded,
+		},
+		{
+			name:      "failure with attempts left",
+			attempts:  2,
+			handler:   func(context.Context, *domain.Job) error { return errFailed },
+			wantState: domain.StatusQueued,
+			wantError: "failed",
+		},
+		{
+			name:      "panic with attempts left",
+			attempts:  1,
+			handler:   func(context.Context, *domain.Job) error { panic("boom") },
+			wantState: domain.StatusQueued,
+			wantError: "handler panic: boom",
+		},
+		{
+			name:      "failure on last attempt",
+			attempts:  domain.DefaultMaxAttempts,
+			handler:   func(context.Context, *domain.Job) error { return errFailed },
+			wantState: domain.StatusDead,
+			wantError: "failed",
+		},
+		{
+			name:      "permanent failure",
+			attempts:  1,
+			handler:   func(context.Context, *domain.Job) error { return domain.Permanent(errFailed) },
+			wantState: domain.StatusDead,
+			wantError: "failed",
+		},
+		{
+			name:      "interrupted by shutdown",
+			attempts:  domain.DefaultMaxAttempts,
+			handler:   func(ctx context.Context, _ *domain.Job) error { return ctx.Err() },
+			cancel:    true,
+			wantState: "released",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, queue := newTestWorker(Options{})
+			w.Register("test", tt.handler)
+			job := &domain.Job{ID: uuid.New(), Type: "test", Attempts: tt.attempts, MaxAttempts: domain.DefaultMaxAttempts}
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.cancel {
+				cancel()
+			}
+			defer cancel()
+
+			start := time.Now()
+			w.process(ctx, job)
+			end := time.Now()
+
+			got, ok := queue.outcomes[job.ID]
+			if !ok {
+				t.Fatal("no outcome recorded")
+			}
+			if got.state != tt.wantState || got.lastError != tt.wantError {
+				t.Errorf("outcome = %s %q, want %s %q", got.state, got.lastError, tt.wantState, tt.wantError)
+			}
+			if got.state == domain.StatusQueued {
+				// Retries back off by RetryDelay of the attempt just made.
+				delay := 10 * time.Second << (tt.attempts - 1)
+				if got.runAt.Before(start.Add(delay/2)) || got.runAt.After(end.Add(delay)) {
+					t.Errorf("retry at %s after start, want between %s and %s",
+						got.runAt.Sub(start), delay/2, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestWorkerClaimTenantLimit(t *testing.T) {
+	tests := []struct {
+		concurrency int
+		want        int32
+	}{
+		{concurrency: 0, want: 0},
+		{concurrency: -1, want: 0},
+		{concurrency: 3, want: 3},
+		{concurrency: math.MaxInt, want: math.MaxInt32},
+	}
+	for _, tt := range tests {
+		w, queue := newTestWorker(Options{TenantConcurrency: tt.concurrency})
+		if _, err := w.claim(context.Background(), []string{"test"}); !errors.Is(err, domain.ErrNoJobs) {
+			t.Fatalf("claim error = %v, want ErrNoJobs", err)
+		}
+		if queue.claimLimit != tt.want {
+			t.Errorf("TenantConcurrency %d claimed with limit %d, want %d", tt.concurrency, queue.claimLimit, tt.want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Job statuses allowed by jobs_status_check.
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusDead      = "dead"
+)
+
+// DefaultMaxAttempts is how many times a job runs before it is dead-lettered.
+const DefaultMaxAttempts = 5
+
+// Retry backoff bounds. The delay doubles with every failed attempt.
+const (
+	baseRetryDelay = 10 * time.Second
+	maxRetryDelay  = time.Hour
+)
+
+// Job errors.
+var (
+	ErrInvalidJobType = errors.New("job type must be non-empty and contain no commas")
+	ErrJobNotFound    = errors.New("job not found")
+	ErrNoJobs         = errors.New("no jobs ready to run")
+	ErrLeaseLost      = errors.New("job is no longer held by this worker")
+)
+
+// Job is a unit of background work. TenantID is uuid.Nil for system jobs,
+// which are exempt from per-tenant concurrency caps.
+type Job struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	Type        string
+	Payload     json.RawMessage
+	UniqueKey   string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	CreatedAt   time.Time
+}
+
+// NewJob returns a job of jobType carrying payload encoded as JSON, due now.
+func NewJob(tenantID uuid.UUID, jobType string, payload any) (*Job, error) {
+	if err := ValidateJobType(jobType); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", jobType, err)
+	}
+	return &Job{
+		TenantID:    tenantID,
+		Type:        jobType,
+		Payload:     data,
+		MaxAttempts: DefaultMaxAttempts,
+	}, nil
+}
+
+// ValidateJobType returns ErrInvalidJobType for types that cannot be claimed.
+// Workers pass their registered types as a comma-separated list.
+func ValidateJobType(jobType string) error {
+	if jobType == "" || strings.Contains(jobType, ",") {
+		return ErrInvalidJobType
+	}
+	return nil
+}
+
+// CanRetry reports whether the job has attempts left.
+func (j *Job) CanRetry() bool {
+	return j.Attempts < j.MaxAttempts
+}
+
+// RetryDelay returns how long to wait before running a job again after its
+// attempts-th failure: exponential backoff with jitter so failing jobs do not
+// retry in lockstep.
+func RetryDelay(attempts int) time.Duration {
+	delay := maxRetryDelay
+	if attempts < 20 {
+		delay = min(baseRetryDelay<<max(attempts-1, 0), maxRetryDelay)
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// permanentError marks a failure that retrying cannot fix.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps err so the worker dead-letters the job instead of retrying it.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was wrapped with Permanent.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
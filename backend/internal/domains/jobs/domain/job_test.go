@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 10 * time.Second},
+		{attempts: 1, want: 10 * time.Second},
+		{attempts: 2, want: 20 * time.Second},
+		{attempts: 5, want: 160 * time.Second},
+		{attempts: 9, want: 2560 * time.Second},
+		{attempts: 10, want: time.Hour},
+		{attempts: 20, want: time.Hour},
+		{attempts: 1000, want: time.Hour},
+	}
+	for _, tt := range tests {
+		// The jitter picks from the upper half of the delay.
+		for range 100 {
+			got := RetryDelay(tt.attempts)
+			if got < tt.want/2 || got > tt.want {
+				t.Fatalf("RetryDelay(%d) = %s, want between %s and %s", tt.attempts, got, tt.want/2, tt.want)
+			}
+		}
+	}
+}
+
+func TestJobCanRetry(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     bool
+	}{
+		{attempts: 1, want: true},
+		{attempts: DefaultMaxAttempts - 1, want: true},
+		{attempts: DefaultMaxAttempts, want: false},
+		{attempts: DefaultMaxAttempts + 1, want: false},
+	}
+	for _, tt := range tests {
+		job := &Job{Attempts: tt.attempts, MaxAttempts: DefaultMaxAttempts}
+		if got := job.CanRetry(); got != tt.want {
+			t.Errorf("CanRetry() with %d attempts = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// JobRepository persists the background job queue.
+type JobRepository struct {
+	q *sqlc.Queries
+}
+
+// NewJobRepository creates a JobRepository using the given connection or transaction.
+func NewJobRepository(db sqlc.DBTX) *JobRepository {
+	return &JobRepository{q: sqlc.New(db)}
+}
+
+// Enqueue stores a queued job. A job whose UniqueKey matches one that is
+// still queued is collapsed into it and job.ID is left unset.
+func (r *JobRepository) Enqueue(ctx context.Context, job *domain.Job) error {
+	runAt := job.RunAt
+	if runAt.IsZero() {
+		runAt = time.Now()
+	}
+	id, err := r.q.EnqueueJob(ctx, sqlc.EnqueueJobParams{
+		TenantID:    uuid.NullUUID{UUID: job.TenantID, Valid: job.TenantID != uuid.Nil},
+		Type:        job.Type,
+		Payload:     job.Payload,
+		UniqueKey:   nullString(job.UniqueKey),
+		MaxAttempts: int32(job.MaxAttempts),
+		RunAt:       runAt,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	job.ID = id
+	job.RunAt = runAt
+	return nil
+}
+
+// Claim marks the oldest due job of one of types as running on workerID,
+// skipping tenants that already run tenantLimit jobs; zero means no cap.
+// It must run inside a transaction; it returns domain.ErrNoJobs when nothing
+// is ready.
+func (r *JobRepository) Claim(ctx context.Context, workerID string, types []string, tenantLimit int32) (*domain.Job, error) {
+	picked, err := r.q.PickJob(ctx, sqlc.PickJobParams{
+		Types:       strings.Join(types, ","),
+		TenantLimit: tenantLimit,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrNoJobs
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The pick counted running jobs without a lock. Recount under the
+	// tenant's lock so concurrent workers cannot both take its last slot;
+	// other tenants are claimed in parallel.
+	if tenantLimit > 0 && picked.TenantID.Valid {
+		if err := r.q.LockTenantJobClaims(ctx, picked.TenantID.UUID); err != nil {
+			return nil, err
+		}
+		running, err := r.q.CountRunningJobs(ctx, picked.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		if running >= int64(tenantLimit) {
+			return nil, domain.ErrNoJobs
+		}
+	}
+
+	row, err := r.q.ClaimJob(ctx, sqlc.ClaimJobParams{
+		WorkerID: nullString(workerID),
+		ID:       picked.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Job{
+		ID:          row.ID,
+		TenantID:    row.TenantID.UUID,
+		Type:        row.Type,
+		Payload:     row.Payload,
+		Attempts:    int(row.Attempts),
+		MaxAttempts: int(row.MaxAttempts),
+		RunAt:       row.RunAt,
+		CreatedAt:   row.CreatedAt,
+	}, nil
+}
+
+// Complete marks a running job as succeeded.
+func (r *JobRepository) Complete(ctx context.Context, id uuid.UUID, workerID string) error {
+	n, err := r.q.CompleteJob(ctx, sqlc.CompleteJobParams{ID: id, WorkerID: nullString(workerID)})
+	return leaseResult(n, err)
+}
+
+// Retry puts a failed job back in the queue to run again at runAt.
+func (r *JobRepository) Retry(ctx context.Context, id uuid.UUID, workerID string, runAt time.Time, lastError string) error {
+	n, err := r.q.RetryJob(ctx, sqlc.RetryJobParams{
+		ID:        id,
+		WorkerID:  nullString(workerID),
+		RunAt:     runAt,
+		LastError: nullString(lastError),
+	})
+	return leaseResult(n, err)
+}
+
+// Release returns an interrupted job to the queue without counting the attempt.
+func (r *JobRepository) Release(ctx context.Context, id uuid.UUID, workerID string) error {
+	n, err := r.q.ReleaseJob(ctx, sqlc.ReleaseJobParams{ID: id, WorkerID: nullString(workerID)})
+	return leaseResult(n, err)
+}
+
+// Bury moves a failed job to the dead-letter state.
+func (r *JobRepository) Bury(ctx context.Context, id uuid.UUID, workerID string, lastError string) error {
+	n, err := r.q.BuryJob(ctx, sqlc.BuryJobParams{
+		ID:        id,
+		WorkerID:  nullString(workerID),
+		LastError: nullString(lastError),
+	})
+	return leaseResult(n, err)
+}
+
+// RequeueStale recovers running jobs locked before staleBefore, returning how many were recovered.
+func (r *JobRepository) RequeueStale(ctx context.Context, staleBefore time.Time) (int64, error) {
+	return r.q.RequeueStaleJobs(ctx, sql.NullTime{Time: staleBefore, Valid: true})
+}
+
+// RetryDead requeues a dead-lettered job with a fresh set of attempts.
+func (r *JobRepository) RetryDead(ctx context.Context, id uuid.UUID) error {
+	n, err := r.q.RetryDeadJob(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrJobNotFound
+	}
+	return nil
+}
+
+// PurgeSucceeded deletes succeeded jobs finished before finishedBefore.
+func (r *JobRepository) PurgeSucceeded(ctx context.Context, finishedBefore time.Time) (int64, error) {
+	return r.q.PurgeSucceededJobs(ctx, sql.NullTime{Time: finishedBefore, Valid: true})
+}
+
+// leaseResult maps an update that matched no row to domain.ErrLeaseLost: the
+// job was recovered as stale and may already be running elsewhere.
+func leaseResult(n int64, err error) error {
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrLeaseLost
+	}
+	return nil
+}
+
+// nullString converts an optional string to its SQL form.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
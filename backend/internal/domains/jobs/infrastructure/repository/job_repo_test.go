@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres/postgrestest"
+	"github.com/google/uuid"
+)
+
+const testWorker = "test-worker"
+
+// testJobType returns a job type no other test claims, so tests sharing a
+// database only see their own jobs.
+func testJobType() string {
+	return "test-" + uuid.NewString()
+}
+
+func enqueue(t *testing.T, db *sql.DB, tenantID uuid.UUID, jobType, uniqueKey string) *domain.Job {
+	t.Helper()
+	job, err := domain.NewJob(tenantID, jobType, struct{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.UniqueKey = uniqueKey
+	if err := NewJobRepository(db).Enqueue(context.Background(), job); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	return job
+}
+
+func claim(t *testing.T, db *sql.DB, jobType string, tenantLimit int32) (*domain.Job, error) {
+	t.Helper()
+	var job *domain.Job
+	err := postgres.WithTx(context.Background(), db, func(tx *sql.Tx) error {
+		var err error
+		job, err = NewJobRepository(tx).Claim(context.Background(), testWorker, []string{jobType}, tenantLimit)
+		return err
+	})
+	return job, err
+}
+
+// jobRow is the state RetryDead resets.
+type jobRow struct {
+	status    string
+	attempts  int
+	lastError sql.NullString
+	lockedBy  sql.NullString
+	uniqueKey sql.NullString
+}
+
+func getJobRow(t *testing.T, db *sql.DB, id uuid.UUID) jobRow {
+	t.Helper()
+	var row jobRow
+	err := db.QueryRow(`SELECT status, attempts, last_error, locked_by, unique_key FROM jobs WHERE id = $1`, id).
+		Scan(&row.status, &row.attempts, &row.lastError, &row.lockedBy, &row.uniqueKey)
+	if err != nil {
+		t.Fatalf("get job: %v", err)
+	}
+	return row
+}
+
+func TestJobRepositoryRetryDead(t *testing.T) {
+	db := postgrestest.Open(t)
+	ctx := context.Background()
+	repo := NewJobRepository(db)
+
+	tests := []struct {
+		name           string
+		queueDuplicate bool
+		wantUniqueKey  bool
+	}{
+		{name: "keeps unique key", wantUniqueKey: true},
+		{name: "drops unique key taken by a queued job", queueDuplicate: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobType := testJobType()
+			key := "key-" + uuid.NewString()
+			dead := enqueue(t, db, uuid.Nil, jobType, key)
+			if _, err := claim(t, db, jobType, 0); err != nil {
+				t.Fatalf("claim: %v", err)
+			}
+			if err := repo.Bury(ctx, dead.ID, testWorker, "boom"); err != nil {
+				t.Fatalf("bury: %v", err)
+			}
+			if tt.queueDuplicate {
+				if dup := enqueue(t, db, uuid.Nil, jobType, key); dup.ID == uuid.Nil {
+					t.Fatal("duplicate collapsed into the dead job")
+				}
+			}
+
+			if err := repo.RetryDead(ctx, dead.ID); err != nil {
+				t.Fatalf("RetryDead: %v", err)
+			}
+			row := getJobRow(t, db, dead.ID)
+			if row.status != domain.StatusQueued || row.attempts != 0 {
+				t.Errorf("status, attempts = %s, %d, want queued, 0", row.status, row.attempts)
+			}
+			if row.lastError.Valid || row.lockedBy.Valid {
+				t.Errorf("last_error, locked_by = %v, %v, want both cleared", row.lastError, row.lockedBy)
+			}
+			if row.uniqueKey.Valid != tt.wantUniqueKey {
+				t.Errorf("unique_key = %v, want kept %v", row.uniqueKey, tt.wantUniqueKey)
+			}
+
+			if err := repo.RetryDead(ctx, dead.ID); !errors.Is(err, domain.ErrJobNotFound) {
+				t.Errorf("RetryDead of a queued job error = %v, want ErrJobNotFound", err)
+			}
+		})
+	}
+}
+
+func createTenant(t *testing.T, db *sql.DB) uuid.UUID {
+	t.Helper()
+	var id uuid.UUID
+	if err := db.QueryRow(`INSERT INTO tenants (name) VALUES ($1) RETURNING id`, "test "+uuid.NewString()).Scan(&id); err != nil {
+		t.Fatalf("create tenant: %v", err)
+	}
+	return id
+}
+
+func TestJobRepositoryClaimTenantLimit(t *testing.T) {
+	db := postgrestest.Open(t)
+	ctx := context.Background()
+	jobType := testJobType()
+	busy, idle := createTenant(t, db), createTenant(t, db)
+	first := enqueue(t, db, busy, jobType, "")
+	second := enqueue(t, db, busy, jobType, "")
+	other := enqueue(t, db, idle, jobType, "")
+
+	// With a cap of one, the busy tenant's second job waits while its first
+	// runs and the idle tenant's job is claimed past it.
+	for _, want := range []uuid.UUID{first.ID, other.ID} {
+		job, err := claim(t, db, jobType, 1)
+		if err != nil {
+			t.Fatalf("claim: %v", err)
+		}
+		if job.ID != want {
+			t.Fatalf("claimed %s, want %s", job.ID, want)
+		}
+	}
+	if _, err := claim(t, db, jobType, 1); !errors.Is(err, domain.ErrNoJobs) {
+		t.Fatalf("claim over the cap error = %v, want ErrNoJobs", err)
+	}
+
+	if err := NewJobRepository(db).Complete(ctx, first.ID, testWorker); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	job, err := claim(t, db, jobType, 1)
+	if err != nil {
+		t.Fatalf("claim after completing: %v", err)
+	}
+	if job.ID != second.ID {
+		t.Errorf("claimed %s, want %s", job.ID, second.ID)
+	}
+}
@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	notificationrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+// NotificationService reads and acknowledges a user's notifications.
+type NotificationService struct {
+	notifications *notificationrepo.NotificationRepository
+}
+
+// NewNotificationService creates a NotificationService.
+func NewNotificationService(db *sql.DB) *NotificationService {
+	return &NotificationService{notifications: notificationrepo.NewNotificationRepository(db)}
+}
+
+// List returns a page of the user's notifications, newest first.
+func (s *NotificationService) List(ctx context.Context, tenantID, userID uuid.UUID, limit, offset int32) ([]domain.Notification, error) {
+	notifications, err := s.notifications.List(ctx, tenantID, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return notifications, nil
+}
+
+// UnreadCount returns how many of the user's notifications are unread.
+func (s *NotificationService) UnreadCount(ctx context.Context, tenantID, userID uuid.UUID) (int64, error) {
+	count, err := s.notifications.CountUnread(ctx, tenantID, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks one of the user's notifications as read.
+func (s *NotificationService) MarkRead(ctx context.Context, tenantID, userID, notificationID uuid.UUID) error {
+	return s.notifications.MarkRead(ctx, tenantID, userID, notificationID)
+}
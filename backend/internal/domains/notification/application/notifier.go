@@ -0,0 +1,45 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	notificationrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// Notifier sends in-app notifications to tenant users.
+type Notifier struct {
+	notifications *notificationrepo.NotificationRepository
+}
+
+// NewNotifier creates a Notifier. Pass a transaction to send the
+// notification only if the change it announces commits.
+func NewNotifier(db sqlc.DBTX) *Notifier {
+	return &Notifier{notifications: notificationrepo.NewNotificationRepository(db)}
+}
+
+// Notify stores an unread notification of type kind for the user. data is
+// encoded as JSON and may be nil.
+func (n *Notifier) Notify(ctx context.Context, tenantID, userID uuid.UUID, kind, message string, data map[string]any) error {
+	notification := &domain.Notification{
+		TenantID: tenantID,
+		UserID:   userID,
+		Message:  message,
+		Type:     kind,
+	}
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode notification data: %w", err)
+		}
+		notification.Data = raw
+	}
+	if err := n.notifications.Create(ctx, notification); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification types allowed by notifications_type_check.
+const (
+	TypeInfo    = "info"
+	TypeWarning = "warning"
+	TypeAlert   = "alert"
+)
+
+// ErrNotificationNotFound is returned when the notification does not exist for the user.
+var ErrNotificationNotFound = errors.New("notification not found")
+
+// Notification is an in-app message for a tenant user.
+type Notification struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	Message   string
+	Type      string
+	Data      json.RawMessage
+	IsRead    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
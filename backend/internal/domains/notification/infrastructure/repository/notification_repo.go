@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+// NotificationRepository persists notifications.
+type NotificationRepository struct {
+	q *sqlc.Queries
+}
+
+// NewNotificationRepository creates a NotificationRepository using the given connection or transaction.
+func NewNotificationRepository(db sqlc.DBTX) *NotificationRepository {
+	return &NotificationRepository{q: sqlc.New(db)}
+}
+
+// Create stores an unread notification.
+func (r *NotificationRepository) Create(ctx context.Context, n *domain.Notification) error {
+	var data pqtype.NullRawMessage
+	if n.Data != nil {
+		data = pqtype.NullRawMessage{RawMessage: n.Data, Valid: true}
+	}
+	_, err := r.q.CreateNotification(ctx, sqlc.CreateNotificationParams{
+		TenantID: n.TenantID,
+		UserID:   n.UserID,
+		Message:  n.Message,
+		Type:     n.Type,
+		Data:     data,
+	})
+	return err
+}
+
+// List returns a page of the user's notifications, newest first.
+func (r *NotificationRepository) List(ctx context.Context, tenantID, userID uuid.UUID, limit, offset int32) ([]domain.Notification, error) {
+	rows, err := r.q.ListNotifications(ctx, sqlc.ListNotificationsParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	notifications := make([]domain.Notification, 0, len(rows))
+	for _, row := range rows {
+		n := domain.Notification{
+			ID:        row.ID,
+			TenantID:  tenantID,
+			UserID:    userID,
+			Message:   row.Message,
+			Type:      row.Type,
+			IsRead:    row.IsRead,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		}
+		if row.Data.Valid {
+			n.Data = row.Data.RawMessage
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+// CountUnread returns the number of unread notifications for the user.
+func (r *NotificationRepository) CountUnread(ctx context.Context, tenantID, userID uuid.UUID) (int64, error) {
+	return r.q.CountUnreadNotifications(ctx, sqlc.CountUnreadNotificationsParams{TenantID: tenantID, UserID: userID})
+}
+
+// MarkRead marks the notification read, or returns domain.ErrNotificationNotFound.
+func (r *NotificationRepository) MarkRead(ctx context.Context, tenantID, userID, notificationID uuid.UUID) error {
+	_, err := r.q.MarkNotificationRead(ctx, sqlc.MarkNotificationReadParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		ID:       notificationID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotificationNotFound
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+// AnalyticsService reports how a listing's share links were used, from the
+// accesses ShareService logs. Revoked links keep their analytics.
+type AnalyticsService struct {
+	shares   *infrastructure.ShareRepository
+	accesses *infrastructure.AccessRepository
+	now      func() time.Time
+}
+
+// NewAnalyticsService creates an AnalyticsService.
+func NewAnalyticsService(db *sql.DB) *AnalyticsService {
+	return &AnalyticsService{
+		shares:   infrastructure.NewShareRepository(db),
+		accesses: infrastructure.NewAccessRepository(db),
+		now:      time.Now,
+	}
+}
+
+// Analytics sums up the accesses of the listing's share link from from up
+// to to, bucketing views by interval. Nil bounds span the link's whole
+// life; an empty interval means domain.IntervalDay.
+func (s *AnalyticsService) Analytics(ctx context.Context, tenantID, listingID, linkID uuid.UUID, from, to *time.Time, interval string) (*domain.Analytics, error) {
+	link, err := s.shares.Get(ctx, tenantID, listingID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	q, err := domain.NewAnalyticsQuery(link, from, to, interval, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.accesses.Summarize(ctx, link.ID, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize share link accesses: %w", err)
+	}
+	views, err := s.accesses.ViewsOverTime(ctx, link.ID, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count share link views: %w", err)
+	}
+	top, err := s.accesses.TopPhotos(ctx, link.ID, q, domain.TopPhotosLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank shared photos: %w", err)
+	}
+	return &domain.Analytics{Query: *q, Summary: summary, Views: views, TopPhotos: top}, nil
+}
+
+// Accesses returns a page of the access log of the listing's share link,
+// newest first.
+func (s *AnalyticsService) Accesses(ctx context.Context, tenantID, listingID, linkID uuid.UUID, limit, offset int32) ([]domain.Access, error) {
+	link, err := s.shares.Get(ctx, tenantID, listingID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	accesses, err := s.accesses.List(ctx, link.ID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share link accesses: %w", err)
+	}
+	return accesses, nil
+}
@@ -0,0 +1,143 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// ListingDownloadInput asks for a ZIP of a listing by one of its tenant's
+// members. Selection limits it to the photos chosen through the listing's
+// share links, or through ShareLinkID alone when it is set.
+type ListingDownloadInput struct {
+	TenantID    uuid.UUID
+	ListingID   uuid.UUID
+	UserID      uuid.UUID
+	Variant     string
+	Selection   bool
+	ShareLinkID *uuid.UUID
+}
+
+// SharedDownloadInput asks for a ZIP of a shared listing by a share link
+// visitor. Selection limits it to the photos chosen through the link.
+type SharedDownloadInput struct {
+	Token        string
+	SessionToken string
+	Variant      string
+	Selection    bool
+	Visitor      domain.Visitor
+}
+
+// DownloadService decides what a ZIP download of a listing holds. Members
+// get any of the listing's photos, originals by default. Share link
+// visitors get its published photos as they see them; the originals need
+// a link with admin permission.
+type DownloadService struct {
+	shares     *ShareService
+	proofing   *ProofingService
+	archives   *tenantapp.ArchiveService
+	selections *infrastructure.ProofingRepository
+}
+
+// NewDownloadService creates a DownloadService.
+func NewDownloadService(db *sql.DB, shares *ShareService, proofing *ProofingService, archives *tenantapp.ArchiveService) *DownloadService {
+	return &DownloadService{
+		shares:     shares,
+		proofing:   proofing,
+		archives:   archives,
+		selections: infrastructure.NewProofingRepository(db),
+	}
+}
+
+// ListingPlan plans a member's download of the listing.
+func (s *DownloadService) ListingPlan(ctx context.Context, in ListingDownloadInput) (*tenant.ArchivePlan, error) {
+	var keep func(*tenant.Photo) bool
+	if in.Selection {
+		chosen, err := s.proofing.Chosen(ctx, in.TenantID, in.ListingID, in.ShareLinkID)
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[uuid.UUID]bool, len(chosen))
+		for _, photo := range chosen {
+			ids[photo.PhotoID] = true
+		}
+		keep = func(p *tenant.Photo) bool { return ids[p.ID] }
+	}
+
+	plan, err := s.archives.Plan(ctx, in.TenantID, in.ListingID, in.Variant, keep)
+	if err != nil {
+		return nil, err
+	}
+	plan.RequestedBy = &in.UserID
+	return plan, nil
+}
+
+// SharedPlan plans a share link visitor's download of the shared listing,
+// watermarked copies by default, and logs the download with its photos. It
+// fails like ShareService.Authorize, so a link whose views are used up
+// gives domain.ErrShareViewsUsedUp.
+func (s *DownloadService) SharedPlan(ctx context.Context, in SharedDownloadInput) (*tenant.ArchivePlan, error) {
+	variant := in.Variant
+	if variant == "" {
+		variant = tenant.ArchiveWatermarked
+	}
+	permission := domain.PermissionRead
+	if variant == tenant.ArchiveOriginal {
+		permission = domain.PermissionAdmin
+	}
+	shared, err := s.shares.Authorize(ctx, in.Token, in.SessionToken, permission)
+	if err != nil {
+		return nil, err
+	}
+	link := shared.Link
+
+	var chosen map[uuid.UUID]bool
+	if in.Selection {
+		selections, err := s.selections.ListByLink(ctx, link.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list photo selections: %w", err)
+		}
+		chosen = make(map[uuid.UUID]bool, len(selections))
+		for _, sel := range selections {
+			if sel.Favorite {
+				chosen[sel.PhotoID] = true
+			}
+		}
+	}
+
+	plan, err := s.archives.Plan(ctx, link.TenantID, link.ListingID, variant, func(p *tenant.Photo) bool {
+		return p.IsPublished && (chosen == nil || chosen[p.ID])
+	})
+	if err != nil {
+		return nil, err
+	}
+	plan.ShareLinkID = &link.ID
+	s.shares.Record(ctx, link, in.Visitor, domain.AccessDownload, plan.PhotoIDs())
+	return plan, nil
+}
+
+// SharedArchive returns an archive asked for through the share link, or
+// tenant.ErrArchiveNotFound. Like SharedPlan, it refuses a link that is no
+// longer live, including one whose views are used up.
+func (s *DownloadService) SharedArchive(ctx context.Context, token, sessionToken string, archiveID uuid.UUID) (*tenant.Archive, error) {
+	shared, err := s.shares.Authorize(ctx, token, sessionToken, domain.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	link := shared.Link
+
+	archive, err := s.archives.Get(ctx, link.TenantID, link.ListingID, archiveID)
+	if err != nil {
+		return nil, err
+	}
+	if archive.ShareLinkID == nil || *archive.ShareLinkID != link.ID {
+		return nil, tenant.ErrArchiveNotFound
+	}
+	return archive, nil
+}
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+func TestSharedDownloadRefusesUsedUpLink(t *testing.T) {
+	f := newShareFixture(t)
+	// The link is refused before any archive is planned or loaded
+	downloads := &DownloadService{shares: f.svc}
+	_, token := f.addLink(t, domain.PermissionAdmin, 1, nil)
+
+	if err := f.do(opOpen, token); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "plan",
+			call: func() error {
+				_, err := downloads.SharedPlan(context.Background(), SharedDownloadInput{Token: token})
+				return err
+			},
+		},
+		{
+			name: "plan originals",
+			call: func() error {
+				_, err := downloads.SharedPlan(context.Background(), SharedDownloadInput{Token: token, Variant: tenant.ArchiveOriginal})
+				return err
+			},
+		},
+		{
+			name: "archive",
+			call: func() error {
+				_, err := downloads.SharedArchive(context.Background(), token, "", uuid.New())
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); !errors.Is(err, domain.ErrShareViewsUsedUp) {
+				t.Fatalf("err = %v, want ErrShareViewsUsedUp", err)
+			}
+		})
+	}
+}
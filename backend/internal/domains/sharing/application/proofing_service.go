@@ -0,0 +1,251 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	notificationapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/application"
+	notification "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/google/uuid"
+)
+
+// Selection is a share link's proofing as its visitors see it. Submission
+// is nil until the selection is submitted.
+type Selection struct {
+	Link       *domain.ShareLink
+	Photos     []domain.PhotoSelection
+	Submission *domain.SelectionSubmission
+}
+
+// ListingSelections is the proofing of a listing across its share links.
+type ListingSelections struct {
+	Photos      []domain.PhotoSelectionSummary
+	Submissions []domain.SelectionSubmission
+}
+
+// SelectPhotoInput is a visitor's marks on a photo of a shared listing.
+// Clearing every mark removes the photo from the selection.
+type SelectPhotoInput struct {
+	Token        string
+	SessionToken string
+	PhotoID      uuid.UUID
+	Favorite     bool
+	Rating       *int32
+	Comment      *string
+}
+
+// ProofingService lets share link visitors favorite, rate and comment on a
+// listing's photos and submit their selection, and the photographer review
+// what was chosen. Proofing needs a link with write permission.
+type ProofingService struct {
+	db       *sql.DB
+	shares   *ShareService
+	proofing *infrastructure.ProofingRepository
+	listings *tenantrepo.ListingRepository
+}
+
+// NewProofingService creates a ProofingService.
+func NewProofingService(db *sql.DB, shares *ShareService) *ProofingService {
+	return &ProofingService{
+		db:       db,
+		shares:   shares,
+		proofing: infrastructure.NewProofingRepository(db),
+		listings: tenantrepo.NewListingRepository(db),
+	}
+}
+
+// Get returns the selection made through the share link.
+func (s *ProofingService) Get(ctx context.Context, token, sessionToken string) (*Selection, error) {
+	shared, err := s.shares.Authorize(ctx, token, sessionToken, domain.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := s.proofing.ListByLink(ctx, shared.Link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photo selections: %w", err)
+	}
+	submission, _, err := s.proofing.Submission(ctx, shared.Link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load selection submission: %w", err)
+	}
+	return &Selection{Link: shared.Link, Photos: photos, Submission: submission}, nil
+}
+
+// SelectPhoto records a visitor's marks on a published photo of the shared
+// listing. It returns nil when the marks were cleared, and
+// domain.ErrSelectionSubmitted once the selection has been submitted.
+func (s *ProofingService) SelectPhoto(ctx context.Context, in SelectPhotoInput) (*domain.PhotoSelection, error) {
+	shared, err := s.shares.Authorize(ctx, in.Token, in.SessionToken, domain.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	link := shared.Link
+
+	selection, err := domain.NewPhotoSelection(link.ID, in.PhotoID, in.Favorite, in.Rating, in.Comment, sessionEmail(shared.Session))
+	if err != nil {
+		return nil, err
+	}
+
+	var saved *domain.PhotoSelection
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		proofing, err := s.lockOpen(ctx, tx, link)
+		if err != nil {
+			return err
+		}
+		if selection.Empty() {
+			return proofing.Delete(ctx, link.ID, in.PhotoID)
+		}
+		saved, err = proofing.Save(ctx, link, selection)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// Submit makes the share link's selection final and notifies the listing's
+// owner. At least one photo must be a favorite.
+func (s *ProofingService) Submit(ctx context.Context, token, sessionToken string, note *string) (*domain.SelectionSubmission, error) {
+	shared, err := s.shares.Authorize(ctx, token, sessionToken, domain.PermissionWrite)
+	if err != nil {
+		return nil, err
+	}
+	link, listing := shared.Link, shared.Listing
+	submittedBy := sessionEmail(shared.Session)
+
+	var submitted *domain.SelectionSubmission
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		proofing, err := s.lockOpen(ctx, tx, link)
+		if err != nil {
+			return err
+		}
+		photos, err := proofing.ListByLink(ctx, link.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list photo selections: %w", err)
+		}
+		submission, err := domain.NewSelectionSubmission(link, photos, submittedBy, note)
+		if err != nil {
+			return err
+		}
+		submitted, err = proofing.Submit(ctx, submission)
+		if err != nil {
+			return fmt.Errorf("failed to submit selection: %w", err)
+		}
+
+		return notificationapp.NewNotifier(tx).Notify(ctx, listing.TenantID, listing.UserID, notification.TypeInfo,
+			submissionMessage(listing.Title, submitted),
+			map[string]any{
+				"event":         "selection.submitted",
+				"listing_id":    listing.ID,
+				"share_link_id": link.ID,
+				"favorites":     submitted.Favorites,
+				"submitted_by":  submitted.SubmittedBy,
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submitted, nil
+}
+
+// Review sums up the listing's proofing by photo. A non-nil linkID limits
+// it to one share link.
+func (s *ProofingService) Review(ctx context.Context, tenantID, listingID uuid.UUID, linkID *uuid.UUID) (*ListingSelections, error) {
+	if _, err := s.listings.Get(ctx, tenantID, listingID); err != nil {
+		return nil, err
+	}
+
+	selections, err := s.proofing.ListByListing(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photo selections: %w", err)
+	}
+	submissions, err := s.proofing.ListSubmissions(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list selection submissions: %w", err)
+	}
+
+	if linkID != nil {
+		kept := selections[:0]
+		for _, sel := range selections {
+			if sel.ShareLinkID == *linkID {
+				kept = append(kept, sel)
+			}
+		}
+		selections = kept
+
+		var linkSubmissions []domain.SelectionSubmission
+		for _, sub := range submissions {
+			if sub.ShareLinkID == *linkID {
+				linkSubmissions = append(linkSubmissions, sub)
+			}
+		}
+		submissions = linkSubmissions
+	}
+
+	return &ListingSelections{
+		Photos:      domain.SummarizeSelections(selections),
+		Submissions: submissions,
+	}, nil
+}
+
+// Chosen returns the photos of the listing marked as favorites, as Review
+// sums them up.
+func (s *ProofingService) Chosen(ctx context.Context, tenantID, listingID uuid.UUID, linkID *uuid.UUID) ([]domain.PhotoSelectionSummary, error) {
+	review, err := s.Review(ctx, tenantID, listingID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	chosen := make([]domain.PhotoSelectionSummary, 0, len(review.Photos))
+	for _, photo := range review.Photos {
+		if photo.Chosen() {
+			chosen = append(chosen, photo)
+		}
+	}
+	return chosen, nil
+}
+
+// lockOpen locks the share link for the rest of tx and returns a proofing
+// repository on tx, or domain.ErrSelectionSubmitted if the link's selection
+// is final.
+func (s *ProofingService) lockOpen(ctx context.Context, tx *sql.Tx, link *domain.ShareLink) (*infrastructure.ProofingRepository, error) {
+	if err := infrastructure.NewShareRepository(tx).Lock(ctx, link.TenantID, link.ID); err != nil {
+		return nil, err
+	}
+	proofing := infrastructure.NewProofingRepository(tx)
+	_, submitted, err := proofing.Submission(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load selection submission: %w", err)
+	}
+	if submitted {
+		return nil, domain.ErrSelectionSubmitted
+	}
+	return proofing, nil
+}
+
+// sessionEmail is the recipient a viewer session was unlocked as, if any.
+// It is what the visitor typed, not a verified address.
+func sessionEmail(session *domain.ShareSession) *string {
+	if session == nil {
+		return nil
+	}
+	return session.Email
+}
+
+// submissionMessage tells the listing's owner about a submitted selection.
+func submissionMessage(title string, s *domain.SelectionSubmission) string {
+	who := "A client"
+	if s.SubmittedBy != nil {
+		who = *s.SubmittedBy
+	}
+	photos := "photos"
+	if s.Favorites == 1 {
+		photos = "photo"
+	}
+	return fmt.Sprintf("%s chose %d %s from %q", who, s.Favorites, photos, title)
+}
@@ -0,0 +1,263 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	authrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	infrastructure "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/google/uuid"
+)
+
+// CreateShareLinkInput describes a new share link. A zero ExpiresAt uses
+// domain.DefaultTTL. A Password gates the link, so visitors must unlock it
+// before use. AllowedEmails further limit it to the listed recipients and
+// need a Password, since the addresses are not verified on unlock.
+type CreateShareLinkInput struct {
+	TenantID      uuid.UUID
+	ListingID     uuid.UUID
+	CreatedBy     uuid.UUID
+	Permission    string
+	ExpiresAt     time.Time
+	MaxViews      int32
+	Password      string
+	AllowedEmails []string
+}
+
+// shareLinks reads the share links visitors use.
+type shareLinks interface {
+	GetByToken(ctx context.Context, tokenHash string) (*domain.ShareLink, error)
+	Recipients(ctx context.Context, linkID uuid.UUID) ([]string, error)
+	CountView(ctx context.Context, linkID uuid.UUID) (count int32, ok bool, err error)
+	ListByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.ShareLink, error)
+}
+
+// shareSessions keeps the viewer sessions of gated links.
+type shareSessions interface {
+	Create(ctx context.Context, session *domain.ShareSession) (*domain.ShareSession, error)
+	Get(ctx context.Context, linkID uuid.UUID, tokenHash string) (session *domain.ShareSession, ok bool, err error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// unlockThrottles keeps the failed unlocks and locks of share links.
+type unlockThrottles interface {
+	Get(ctx context.Context, scope, subject string) (*authdomain.LoginThrottle, error)
+	RecordFailure(ctx context.Context, scope, subject string, windowStart, resetBefore time.Time) (*authdomain.LoginThrottle, error)
+	Lock(ctx context.Context, scope, subject string, until time.Time) error
+	Reset(ctx context.Context, scope, subject string) error
+}
+
+// sharedListings finds the listing a link shares.
+type sharedListings interface {
+	Get(ctx context.Context, tenantID, listingID uuid.UUID) (*tenant.Listing, error)
+}
+
+// sharedPhotos lists the photos of a shared listing.
+type sharedPhotos interface {
+	ListByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]tenant.Photo, error)
+}
+
+// accessLog stores what visitors did with share links.
+type accessLog interface {
+	Record(ctx context.Context, access *domain.Access) error
+}
+
+// txAccessLog records each access in its own transaction, since an access
+// and its photos are separate rows.
+type txAccessLog struct {
+	db *sql.DB
+}
+
+func (l txAccessLog) Record(ctx context.Context, access *domain.Access) error {
+	return postgres.WithTx(ctx, l.db, func(tx *sql.Tx) error {
+		_, err := infrastructure.NewAccessRepository(tx).Record(ctx, access)
+		return err
+	})
+}
+
+// ShareService manages the share links of a tenant's listings.
+type ShareService struct {
+	db        *sql.DB
+	shares    shareLinks
+	sessions  shareSessions
+	throttles unlockThrottles
+	listings  sharedListings
+	photos    sharedPhotos
+	accesses  accessLog
+	// unlockLimit throttles failed unlocks of a link per client, and
+	// linkUnlockLimit those of every client together
+	unlockLimit     authdomain.LockoutPolicy
+	linkUnlockLimit authdomain.LockoutPolicy
+	now             func() time.Time
+}
+
+// NewShareService creates a ShareService.
+func NewShareService(db *sql.DB) *ShareService {
+	return &ShareService{
+		db:              db,
+		shares:          infrastructure.NewShareRepository(db),
+		sessions:        infrastructure.NewSessionRepository(db),
+		throttles:       authrepo.NewThrottleRepository(db),
+		listings:        tenantrepo.NewListingRepository(db),
+		photos:          tenantrepo.NewPhotoRepository(db),
+		accesses:        txAccessLog{db: db},
+		unlockLimit:     authdomain.DefaultShareLinkLockout,
+		linkUnlockLimit: authdomain.DefaultShareLinkTotalLockout,
+		now:             time.Now,
+	}
+}
+
+// Create issues a share link for the listing. The returned link carries the
+// plaintext token, which is not stored and cannot be read back later.
+func (s *ShareService) Create(ctx context.Context, in CreateShareLinkInput) (*domain.ShareLink, error) {
+	link, err := domain.NewShareLink(in.TenantID, in.ListingID, in.Permission, in.ExpiresAt, in.MaxViews, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if in.Password != "" {
+		if err := domain.ValidateSharePassword(in.Password); err != nil {
+			return nil, err
+		}
+		hash, err := auth.HashPassword(in.Password)
+		if err != nil {
+			return nil, err
+		}
+		link.PasswordHash = &hash
+	}
+	if link.AllowedEmails, err = normalizeRecipients(in.AllowedEmails); err != nil {
+		return nil, err
+	}
+	if err := link.CheckAccessControls(); err != nil {
+		return nil, err
+	}
+
+	var created *domain.ShareLink
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		if _, err := tenantrepo.NewListingRepository(tx).Get(ctx, in.TenantID, in.ListingID); err != nil {
+			return err
+		}
+
+		created, err = infrastructure.NewShareRepository(tx).Create(ctx, link)
+		if err != nil {
+			return fmt.Errorf("failed to create share link: %w", err)
+		}
+
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    in.TenantID,
+			PerformedBy: in.CreatedBy,
+			EntityID:    in.ListingID,
+			EntityType:  audit.EntityListing,
+			Action:      audit.ActionShare,
+			Data: map[string]any{
+				"share_link_id": created.ID,
+				"permission":    created.Permission,
+				"expires_at":    created.ExpiresAt,
+				"max_views":     created.MaxViews,
+				"password":      created.PasswordHash != nil,
+				"recipients":    len(created.AllowedEmails),
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// List returns the listing's share links with their recipients.
+func (s *ShareService) List(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.ShareLink, error) {
+	if _, err := s.listings.Get(ctx, tenantID, listingID); err != nil {
+		return nil, err
+	}
+	links, err := s.shares.ListByListing(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	for i := range links {
+		if links[i].AllowedEmails, err = s.shares.Recipients(ctx, links[i].ID); err != nil {
+			return nil, fmt.Errorf("failed to list share link recipients: %w", err)
+		}
+	}
+	return links, nil
+}
+
+// ExtendShareLinkInput changes the expiry or view limit of a share link.
+// A nil field is left as it is; a MaxViews of zero removes the limit.
+type ExtendShareLinkInput struct {
+	TenantID  uuid.UUID
+	ActorID   uuid.UUID
+	ListingID uuid.UUID
+	LinkID    uuid.UUID
+	ExpiresAt *time.Time
+	MaxViews  *int32
+}
+
+// Extend changes the share link's expiry or view limit, keeping its token,
+// and returns it with its recipients. Revoked links cannot be extended.
+func (s *ShareService) Extend(ctx context.Context, in ExtendShareLinkInput) (*domain.ShareLink, error) {
+	var updated *domain.ShareLink
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		shares := infrastructure.NewShareRepository(tx)
+		if err := shares.Lock(ctx, in.TenantID, in.LinkID); err != nil {
+			return err
+		}
+		link, err := shares.Get(ctx, in.TenantID, in.ListingID, in.LinkID)
+		if err != nil {
+			return err
+		}
+		if err := link.Extend(in.ExpiresAt, in.MaxViews, s.now()); err != nil {
+			return err
+		}
+		if updated, err = shares.UpdateLimits(ctx, link); err != nil {
+			return fmt.Errorf("failed to update share link: %w", err)
+		}
+		if updated.AllowedEmails, err = shares.Recipients(ctx, link.ID); err != nil {
+			return fmt.Errorf("failed to list share link recipients: %w", err)
+		}
+
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    in.TenantID,
+			PerformedBy: in.ActorID,
+			EntityID:    in.ListingID,
+			EntityType:  audit.EntityListing,
+			Action:      audit.ActionUpdate,
+			Data: map[string]any{
+				"share_link_id": updated.ID,
+				"expires_at":    updated.ExpiresAt,
+				"max_views":     updated.MaxViews,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Revoke stops the share link from working at once. The link is kept, so
+// its analytics stay available; revoking it again changes nothing.
+func (s *ShareService) Revoke(ctx context.Context, tenantID, actorID, listingID, linkID uuid.UUID) error {
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := infrastructure.NewShareRepository(tx).Revoke(ctx, tenantID, listingID, linkID); err != nil {
+			return err
+		}
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    tenantID,
+			PerformedBy: actorID,
+			EntityID:    listingID,
+			EntityType:  audit.EntityListing,
+			Action:      audit.ActionDelete,
+			Data:        map[string]any{"share_link_id": linkID},
+		})
+	})
+}
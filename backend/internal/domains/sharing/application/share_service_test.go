@@ -0,0 +1,243 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// fakeLinks mirrors the share link queries in memory.
+type fakeLinks struct {
+	now   func() time.Time
+	links map[string]*domain.ShareLink
+}
+
+func (f *fakeLinks) GetByToken(_ context.Context, tokenHash string) (*domain.ShareLink, error) {
+	link, ok := f.links[tokenHash]
+	if !ok {
+		return nil, domain.ErrShareLinkNotFound
+	}
+	l := *link
+	return &l, nil
+}
+
+func (f *fakeLinks) Recipients(_ context.Context, linkID uuid.UUID) ([]string, error) {
+	for _, link := range f.links {
+		if link.ID == linkID {
+			return append([]string(nil), link.AllowedEmails...), nil
+		}
+	}
+	return nil, nil
+}
+
+// CountView only counts views of a live link under its limit, like the
+// conditional update it stands for.
+func (f *fakeLinks) CountView(_ context.Context, linkID uuid.UUID) (int32, bool, error) {
+	for _, link := range f.links {
+		if link.ID != linkID {
+			continue
+		}
+		if link.Revoked() || link.Expired(f.now()) || link.UsedUp() {
+			return 0, false, nil
+		}
+		link.ViewCount++
+		return link.ViewCount, true, nil
+	}
+	return 0, false, nil
+}
+
+func (f *fakeLinks) ListByListing(_ context.Context, tenantID, listingID uuid.UUID) ([]domain.ShareLink, error) {
+	var out []domain.ShareLink
+	for _, link := range f.links {
+		if link.TenantID == tenantID && link.ListingID == listingID {
+			out = append(out, *link)
+		}
+	}
+	return out, nil
+}
+
+// fakeSessions keeps viewer sessions, hiding expired ones like the query.
+type fakeSessions struct {
+	now      func() time.Time
+	sessions []*domain.ShareSession
+}
+
+func (f *fakeSessions) Create(_ context.Context, session *domain.ShareSession) (*domain.ShareSession, error) {
+	s := *session
+	s.ID = uuid.New()
+	s.CreatedAt = f.now()
+	f.sessions = append(f.sessions, &s)
+	return &s, nil
+}
+
+func (f *fakeSessions) Get(_ context.Context, linkID uuid.UUID, tokenHash string) (*domain.ShareSession, bool, error) {
+	for _, s := range f.sessions {
+		if s.ShareLinkID == linkID && s.TokenHash == tokenHash && s.ExpiresAt.After(f.now()) {
+			session := *s
+			return &session, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (f *fakeSessions) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	var kept []*domain.ShareSession
+	for _, s := range f.sessions {
+		if s.ExpiresAt.After(now) {
+			kept = append(kept, s)
+		}
+	}
+	n := int64(len(f.sessions) - len(kept))
+	f.sessions = kept
+	return n, nil
+}
+
+type fakeListings struct {
+	listings map[uuid.UUID]*tenant.Listing
+}
+
+func (f *fakeListings) Get(_ context.Context, tenantID, listingID uuid.UUID) (*tenant.Listing, error) {
+	listing, ok := f.listings[listingID]
+	if !ok || listing.TenantID != tenantID {
+		return nil, tenant.ErrListingNotFound
+	}
+	return listing, nil
+}
+
+type fakePhotos struct {
+	photos []tenant.Photo
+}
+
+func (f *fakePhotos) ListByListing(_ context.Context, _, listingID uuid.UUID) ([]tenant.Photo, error) {
+	var out []tenant.Photo
+	for _, p := range f.photos {
+		if p.ListingID == listingID {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+type fakeAccessLog struct {
+	accesses []*domain.Access
+}
+
+func (f *fakeAccessLog) Record(_ context.Context, access *domain.Access) error {
+	f.accesses = append(f.accesses, access)
+	return nil
+}
+
+// fakeThrottles mirrors the login_throttles queries in memory.
+type fakeThrottles struct {
+	now  func() time.Time
+	rows map[[2]string]*fakeThrottle
+}
+
+type fakeThrottle struct {
+	authdomain.LoginThrottle
+	lastFailedAt time.Time
+}
+
+func (f *fakeThrottles) Get(_ context.Context, scope, subject string) (*authdomain.LoginThrottle, error) {
+	if row, ok := f.rows[[2]string{scope, subject}]; ok {
+		t := row.LoginThrottle
+		return &t, nil
+	}
+	return &authdomain.LoginThrottle{Scope: scope, Subject: subject}, nil
+}
+
+func (f *fakeThrottles) RecordFailure(_ context.Context, scope, subject string, windowStart, resetBefore time.Time) (*authdomain.LoginThrottle, error) {
+	key := [2]string{scope, subject}
+	row, ok := f.rows[key]
+	switch {
+	case !ok:
+		row = &fakeThrottle{LoginThrottle: authdomain.LoginThrottle{Scope: scope, Subject: subject, FailedAttempts: 1}}
+		f.rows[key] = row
+	case row.lastFailedAt.Before(windowStart):
+		row.FailedAttempts = 1
+	default:
+		row.FailedAttempts++
+	}
+	if ok && row.lastFailedAt.Before(resetBefore) {
+		row.LockoutCount = 0
+	}
+	row.lastFailedAt = f.now()
+	t := row.LoginThrottle
+	return &t, nil
+}
+
+func (f *fakeThrottles) Lock(_ context.Context, scope, subject string, until time.Time) error {
+	if row, ok := f.rows[[2]string{scope, subject}]; ok {
+		row.LockedUntil = &until
+		row.LockoutCount++
+		row.FailedAttempts = 0
+	}
+	return nil
+}
+
+func (f *fakeThrottles) Reset(_ context.Context, scope, subject string) error {
+	delete(f.rows, [2]string{scope, subject})
+	return nil
+}
+
+// shareFixture is a ShareService over in-memory stores holding one listing
+// with a published and an unpublished photo.
+type shareFixture struct {
+	svc       *ShareService
+	links     *fakeLinks
+	sessions  *fakeSessions
+	accesses  *fakeAccessLog
+	throttles *fakeThrottles
+	listing   *tenant.Listing
+	published tenant.Photo
+	draft     tenant.Photo
+	now       time.Time
+}
+
+func newShareFixture(t *testing.T) *shareFixture {
+	t.Helper()
+	f := &shareFixture{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	clock := func() time.Time { return f.now }
+
+	f.listing = &tenant.Listing{ID: uuid.New(), TenantID: uuid.New(), Title: "Wedding"}
+	f.published = tenant.Photo{ID: uuid.New(), ListingID: f.listing.ID, IsPublished: true}
+	f.draft = tenant.Photo{ID: uuid.New(), ListingID: f.listing.ID}
+	f.links = &fakeLinks{now: clock, links: map[string]*domain.ShareLink{}}
+	f.sessions = &fakeSessions{now: clock}
+	f.accesses = &fakeAccessLog{}
+	f.throttles = &fakeThrottles{now: clock, rows: map[[2]string]*fakeThrottle{}}
+
+	f.svc = &ShareService{
+		shares:          f.links,
+		sessions:        f.sessions,
+		listings:        &fakeListings{listings: map[uuid.UUID]*tenant.Listing{f.listing.ID: f.listing}},
+		photos:          &fakePhotos{photos: []tenant.Photo{f.published, f.draft}},
+		accesses:        f.accesses,
+		throttles:       f.throttles,
+		unlockLimit:     authdomain.DefaultShareLinkLockout,
+		linkUnlockLimit: authdomain.DefaultShareLinkTotalLockout,
+		now:             clock,
+	}
+	return f
+}
+
+// addLink stores a link to the fixture's listing and returns its token.
+// configure may set limits and access controls before it is stored.
+func (f *shareFixture) addLink(t *testing.T, permission string, maxViews int32, configure func(*domain.ShareLink)) (*domain.ShareLink, string) {
+	t.Helper()
+	link, err := domain.NewShareLink(f.listing.TenantID, f.listing.ID, permission, time.Time{}, maxViews, f.now)
+	if err != nil {
+		t.Fatalf("NewShareLink: %v", err)
+	}
+	link.ID = uuid.New()
+	if configure != nil {
+		configure(link)
+	}
+	f.links.links[link.TokenHash] = link
+	return link, link.Token
+}
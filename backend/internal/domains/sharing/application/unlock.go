@@ -0,0 +1,154 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+)
+
+// UnlockInput is a visitor's attempt to unlock a gated share link. Password
+// is always needed, and Email when the link has recipients. Email is taken
+// at the visitor's word; no code is sent to the address. IP is the client's
+// address, which failed attempts are counted against.
+type UnlockInput struct {
+	Token    string
+	Password string
+	Email    string
+	IP       string
+}
+
+// Unlock checks the credentials given for a gated share link and starts a
+// viewer session for it. The returned session carries the plaintext token,
+// which is not stored. Wrong credentials give
+// domain.ErrInvalidShareCredentials and count towards locking the link for
+// the client. A wrong password also counts, at a much higher threshold,
+// towards locking it for every client; a wrong email does not, since anyone
+// can type one. A locked unlock fails with a *authdomain.LockedError until
+// the lock passes. A link with recipients but no password, made before one
+// was required, cannot be unlocked and gives
+// domain.ErrRecipientsNeedPassword.
+func (s *ShareService) Unlock(ctx context.Context, in UnlockInput) (*domain.ShareSession, error) {
+	link, err := s.liveLink(ctx, in.Token)
+	if err != nil {
+		return nil, err
+	}
+	if !link.Gated() {
+		return nil, domain.ErrShareLinkNotGated
+	}
+	if err := link.CheckAccessControls(); err != nil {
+		return nil, err
+	}
+
+	linkSubject := link.ID.String()
+	clientSubject := domain.UnlockSubject(link.ID, in.IP)
+	if err := s.checkLocked(ctx, linkSubject); err != nil {
+		return nil, err
+	}
+	if err := s.checkLocked(ctx, clientSubject); err != nil {
+		return nil, err
+	}
+
+	email := authdomain.NormalizeEmail(in.Email)
+	passwordOK, err := auth.CheckPassword(*link.PasswordHash, in.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !passwordOK || !link.AllowsEmail(email) {
+		if err := s.recordFailure(ctx, clientSubject, s.unlockLimit); err != nil {
+			return nil, err
+		}
+		if !passwordOK {
+			if err := s.recordFailure(ctx, linkSubject, s.linkUnlockLimit); err != nil {
+				return nil, err
+			}
+		}
+		return nil, domain.ErrInvalidShareCredentials
+	}
+
+	// Only the client's history is cleared; failures from others still
+	// count towards the link's own limit
+	if err := s.throttles.Reset(ctx, authdomain.ThrottleScopeShareLink, clientSubject); err != nil {
+		return nil, fmt.Errorf("failed to reset share link throttle: %w", err)
+	}
+
+	// The password is the only secret: the email names a recipient but
+	// proves nothing, so the session keeps it only to attribute the
+	// visitor's proofing, as claimed
+	var recipient *string
+	if len(link.AllowedEmails) > 0 {
+		recipient = &email
+	}
+	session, err := domain.NewShareSession(link.ID, recipient, s.now())
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.sessions.Create(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create share session: %w", err)
+	}
+	return created, nil
+}
+
+// ExpireSessions handles domain.JobExpireSessions.
+func (s *ShareService) ExpireSessions(ctx context.Context, _ *jobs.Job, _ struct{}) error {
+	if _, err := s.sessions.DeleteExpired(ctx, s.now()); err != nil {
+		return fmt.Errorf("failed to delete expired share sessions: %w", err)
+	}
+	return nil
+}
+
+// checkLocked returns a *authdomain.LockedError if unlocking is currently
+// locked for subject.
+func (s *ShareService) checkLocked(ctx context.Context, subject string) error {
+	throttle, err := s.throttles.Get(ctx, authdomain.ThrottleScopeShareLink, subject)
+	if err != nil {
+		return fmt.Errorf("failed to load share link throttle: %w", err)
+	}
+	if throttle.IsLocked(s.now()) {
+		return &authdomain.LockedError{Scope: authdomain.ThrottleScopeShareLink, Until: *throttle.LockedUntil}
+	}
+	return nil
+}
+
+// recordFailure counts a failed unlock against subject and locks it once
+// the policy threshold is reached.
+func (s *ShareService) recordFailure(ctx context.Context, subject string, policy authdomain.LockoutPolicy) error {
+	now := s.now()
+	throttle, err := s.throttles.RecordFailure(ctx, authdomain.ThrottleScopeShareLink, subject, now.Add(-policy.Window), now.Add(-policy.ResetAfter))
+	if err != nil {
+		return fmt.Errorf("failed to record share unlock failure: %w", err)
+	}
+
+	if policy.ShouldLock(throttle.FailedAttempts) {
+		until := now.Add(policy.LockDuration(throttle.LockoutCount))
+		if err := s.throttles.Lock(ctx, authdomain.ThrottleScopeShareLink, subject, until); err != nil {
+			return fmt.Errorf("failed to lock share link: %w", err)
+		}
+	}
+	return nil
+}
+
+// normalizeRecipients lower-cases, validates and de-duplicates recipient
+// emails, keeping their order.
+func normalizeRecipients(emails []string) ([]string, error) {
+	if len(emails) > domain.MaxRecipients {
+		return nil, domain.ErrTooManyRecipients
+	}
+	seen := make(map[string]bool, len(emails))
+	out := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = authdomain.NormalizeEmail(email)
+		if authdomain.ValidateEmail(email) != nil {
+			return nil, domain.ErrInvalidRecipient
+		}
+		if !seen[email] {
+			seen[email] = true
+			out = append(out, email)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,271 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	sharePassword = "correct horse"
+	recipient     = "guest@example.com"
+)
+
+// addGatedLink stores a link protected by sharePassword and allowed only
+// to recipients, if any. The password is hashed at the lowest bcrypt cost
+// to keep repeated unlocks fast.
+func (f *shareFixture) addGatedLink(t *testing.T, recipients ...string) (*domain.ShareLink, string) {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(sharePassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("hash password: %v", err)
+	}
+	return f.addLink(t, domain.PermissionRead, 0, func(link *domain.ShareLink) {
+		passwordHash := string(hash)
+		link.PasswordHash = &passwordHash
+		link.AllowedEmails = recipients
+	})
+}
+
+func (f *shareFixture) unlock(token, password, email, ip string) (*domain.ShareSession, error) {
+	return f.svc.Unlock(context.Background(), UnlockInput{Token: token, Password: password, Email: email, IP: ip})
+}
+
+func TestShareUnlockCredentials(t *testing.T) {
+	tests := []struct {
+		name          string
+		withRecipient bool
+		password      string
+		email         string
+		wantErr       error
+	}{
+		{name: "password", password: sharePassword},
+		{name: "wrong password", password: "wrong", wantErr: domain.ErrInvalidShareCredentials},
+		{name: "password and recipient", withRecipient: true, password: sharePassword, email: " Guest@Example.com "},
+		{name: "other email", withRecipient: true, password: sharePassword, email: "other@example.com", wantErr: domain.ErrInvalidShareCredentials},
+		{name: "recipient with wrong password", withRecipient: true, password: "wrong", email: recipient, wantErr: domain.ErrInvalidShareCredentials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newShareFixture(t)
+			var recipients []string
+			if tt.withRecipient {
+				recipients = []string{recipient}
+			}
+			_, token := f.addGatedLink(t, recipients...)
+
+			session, err := f.unlock(token, tt.password, tt.email, "203.0.113.9")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unlock err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if tt.withRecipient && (session.Email == nil || *session.Email != recipient) {
+				t.Errorf("session email = %v, want %s", session.Email, recipient)
+			}
+			if err := f.doSession(opAuthorize, token, session.Token); err != nil {
+				t.Errorf("Authorize with the new session: %v", err)
+			}
+		})
+	}
+
+	t.Run("recipients without password", func(t *testing.T) {
+		f := newShareFixture(t)
+		_, token := f.addLink(t, domain.PermissionRead, 0, func(link *domain.ShareLink) {
+			link.AllowedEmails = []string{recipient}
+		})
+		if _, err := f.unlock(token, "", recipient, "203.0.113.9"); !errors.Is(err, domain.ErrRecipientsNeedPassword) {
+			t.Fatalf("Unlock err = %v, want ErrRecipientsNeedPassword", err)
+		}
+	})
+
+	t.Run("ungated link", func(t *testing.T) {
+		f := newShareFixture(t)
+		_, token := f.addLink(t, domain.PermissionRead, 0, nil)
+		if _, err := f.unlock(token, sharePassword, "", "203.0.113.9"); !errors.Is(err, domain.ErrShareLinkNotGated) {
+			t.Fatalf("Unlock err = %v, want ErrShareLinkNotGated", err)
+		}
+	})
+}
+
+func TestShareGatedSession(t *testing.T) {
+	tests := []struct {
+		name string
+		// session returns the session token to present for the link
+		session   func(t *testing.T, f *shareFixture, token string) string
+		wantNoErr bool
+	}{
+		{
+			name:    "no session",
+			session: func(*testing.T, *shareFixture, string) string { return "" },
+		},
+		{
+			name:    "unknown session",
+			session: func(*testing.T, *shareFixture, string) string { return "not-a-session" },
+		},
+		{
+			name: "expired session",
+			session: func(t *testing.T, f *shareFixture, token string) string {
+				session, err := f.unlock(token, sharePassword, "", "203.0.113.9")
+				if err != nil {
+					t.Fatalf("Unlock: %v", err)
+				}
+				f.now = session.ExpiresAt
+				return session.Token
+			},
+		},
+		{
+			name: "session of another link",
+			session: func(t *testing.T, f *shareFixture, _ string) string {
+				_, other := f.addGatedLink(t)
+				session, err := f.unlock(other, sharePassword, "", "203.0.113.9")
+				if err != nil {
+					t.Fatalf("Unlock: %v", err)
+				}
+				return session.Token
+			},
+		},
+		{
+			name: "live session",
+			session: func(t *testing.T, f *shareFixture, token string) string {
+				session, err := f.unlock(token, sharePassword, "", "203.0.113.9")
+				if err != nil {
+					t.Fatalf("Unlock: %v", err)
+				}
+				return session.Token
+			},
+			wantNoErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newShareFixture(t)
+			_, token := f.addGatedLink(t)
+			sessionToken := tt.session(t, f, token)
+
+			for _, op := range []string{opOpen, opAuthorize, opPhoto} {
+				err := f.doSession(op, token, sessionToken)
+				if tt.wantNoErr {
+					if err != nil {
+						t.Errorf("%s: %v", op, err)
+					}
+					continue
+				}
+				var required *domain.UnlockRequiredError
+				if !errors.As(err, &required) {
+					t.Errorf("%s: err = %v, want UnlockRequiredError", op, err)
+				}
+			}
+		})
+	}
+}
+
+func TestShareUnlockThrottle(t *testing.T) {
+	perClient := authdomain.DefaultShareLinkLockout.MaxAttempts
+	locked := func(err error) bool {
+		var lockedErr *authdomain.LockedError
+		return errors.As(err, &lockedErr)
+	}
+
+	t.Run("locks the client only", func(t *testing.T) {
+		f := newShareFixture(t)
+		_, token := f.addGatedLink(t)
+
+		for i := int32(0); i < perClient; i++ {
+			if _, err := f.unlock(token, "wrong", "", "198.51.100.1"); !errors.Is(err, domain.ErrInvalidShareCredentials) {
+				t.Fatalf("attempt %d: err = %v, want ErrInvalidShareCredentials", i+1, err)
+			}
+		}
+		if _, err := f.unlock(token, sharePassword, "", "198.51.100.1"); !locked(err) {
+			t.Fatalf("locked client: err = %v, want LockedError", err)
+		}
+		if _, err := f.unlock(token, sharePassword, "", "203.0.113.9"); err != nil {
+			t.Fatalf("other client: %v", err)
+		}
+	})
+
+	t.Run("locks the link for every client", func(t *testing.T) {
+		f := newShareFixture(t)
+		_, token := f.addGatedLink(t)
+
+		// One guess per client, so no client is locked on its own
+		for i := int32(0); i < authdomain.DefaultShareLinkTotalLockout.MaxAttempts; i++ {
+			ip := fmt.Sprintf("198.51.100.%d", i)
+			if _, err := f.unlock(token, "wrong", "", ip); !errors.Is(err, domain.ErrInvalidShareCredentials) {
+				t.Fatalf("attempt %d: err = %v, want ErrInvalidShareCredentials", i+1, err)
+			}
+		}
+		if _, err := f.unlock(token, sharePassword, "", "203.0.113.9"); !locked(err) {
+			t.Fatalf("fresh client: err = %v, want LockedError", err)
+		}
+	})
+
+	t.Run("wrong emails lock the client only", func(t *testing.T) {
+		f := newShareFixture(t)
+		link, token := f.addGatedLink(t, recipient)
+
+		for i := int32(0); i < perClient; i++ {
+			if _, err := f.unlock(token, sharePassword, "other@example.com", "198.51.100.1"); !errors.Is(err, domain.ErrInvalidShareCredentials) {
+				t.Fatalf("attempt %d: err = %v, want ErrInvalidShareCredentials", i+1, err)
+			}
+		}
+		if _, err := f.unlock(token, sharePassword, recipient, "198.51.100.1"); !locked(err) {
+			t.Fatalf("locked client: err = %v, want LockedError", err)
+		}
+		if _, err := f.unlock(token, sharePassword, recipient, "203.0.113.9"); err != nil {
+			t.Fatalf("recipient on another client: %v", err)
+		}
+
+		total, _ := f.throttles.Get(context.Background(), authdomain.ThrottleScopeShareLink, link.ID.String())
+		if total.FailedAttempts != 0 {
+			t.Errorf("link failures = %d, want 0", total.FailedAttempts)
+		}
+	})
+
+	t.Run("wrong emails from every client never lock the link", func(t *testing.T) {
+		f := newShareFixture(t)
+		_, token := f.addGatedLink(t, recipient)
+
+		for i := int32(0); i < 2*authdomain.DefaultShareLinkTotalLockout.MaxAttempts; i++ {
+			ip := fmt.Sprintf("198.51.100.%d", i)
+			if _, err := f.unlock(token, sharePassword, "other@example.com", ip); !errors.Is(err, domain.ErrInvalidShareCredentials) {
+				t.Fatalf("attempt %d: err = %v, want ErrInvalidShareCredentials", i+1, err)
+			}
+		}
+		if _, err := f.unlock(token, sharePassword, recipient, "203.0.113.9"); err != nil {
+			t.Fatalf("recipient: %v", err)
+		}
+	})
+
+	t.Run("success resets the client only", func(t *testing.T) {
+		f := newShareFixture(t)
+		link, token := f.addGatedLink(t)
+
+		for i := int32(0); i < perClient-1; i++ {
+			if _, err := f.unlock(token, "wrong", "", "198.51.100.1"); !errors.Is(err, domain.ErrInvalidShareCredentials) {
+				t.Fatalf("attempt %d: err = %v, want ErrInvalidShareCredentials", i+1, err)
+			}
+		}
+		if _, err := f.unlock(token, sharePassword, "", "198.51.100.1"); err != nil {
+			t.Fatalf("Unlock: %v", err)
+		}
+
+		ctx := context.Background()
+		client, _ := f.throttles.Get(ctx, authdomain.ThrottleScopeShareLink, domain.UnlockSubject(link.ID, "198.51.100.1"))
+		if client.FailedAttempts != 0 {
+			t.Errorf("client failures = %d, want 0", client.FailedAttempts)
+		}
+		total, _ := f.throttles.Get(ctx, authdomain.ThrottleScopeShareLink, link.ID.String())
+		if total.FailedAttempts != perClient-1 {
+			t.Errorf("link failures = %d, want %d", total.FailedAttempts, perClient-1)
+		}
+	})
+}
@@ -1 +1,165 @@
-package application
\ No newline at end of file
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// SharedListing is what a share link shows: the listing and its published
+// photos in display order. Session is the visitor's viewer session when the
+// link is gated, and nil otherwise.
+type SharedListing struct {
+	Link    *domain.ShareLink
+	Session *domain.ShareSession
+	Listing *tenant.Listing
+	Photos  []tenant.Photo
+}
+
+// Open validates token for a visit to the shared listing, counts the visit
+// against the link's view limit and logs it. sessionToken is the visitor's
+// viewer session, needed when the link is gated. Unknown tokens and deleted
+// listings give domain.ErrShareLinkNotFound; revoked links
+// domain.ErrShareLinkRevoked, spent links domain.ErrShareLinkExpired or
+// domain.ErrShareViewsUsedUp, and gated links without a live session a
+// *domain.UnlockRequiredError.
+func (s *ShareService) Open(ctx context.Context, token, sessionToken string, visitor domain.Visitor) (*SharedListing, error) {
+	shared, err := s.authorize(ctx, token, sessionToken, domain.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	link := shared.Link
+
+	// The count only goes up while the link is live and under its limit, so
+	// concurrent visits cannot overshoot it
+	count, ok, err := s.shares.CountView(ctx, link.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count share view: %w", err)
+	}
+	if !ok {
+		// Revoked between authorizing and counting
+		if current, err := s.shares.GetByToken(ctx, link.TokenHash); err == nil && current.Revoked() {
+			return nil, domain.ErrShareLinkRevoked
+		}
+		if link.Expired(s.now()) {
+			return nil, domain.ErrShareLinkExpired
+		}
+		return nil, domain.ErrShareViewsUsedUp
+	}
+	link.ViewCount = count
+
+	photos, err := s.photos.ListByListing(ctx, link.TenantID, link.ListingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+	shared.Photos = make([]tenant.Photo, 0, len(photos))
+	for _, photo := range photos {
+		if photo.IsPublished {
+			shared.Photos = append(shared.Photos, photo)
+		}
+	}
+	s.Record(ctx, link, visitor, domain.AccessView, nil)
+	return shared, nil
+}
+
+// Photo returns a published photo of the shared listing and logs the view,
+// without counting it against the link's view limit. It fails like
+// Authorize, or with tenant.ErrPhotoNotFound.
+func (s *ShareService) Photo(ctx context.Context, token, sessionToken string, photoID uuid.UUID, visitor domain.Visitor) (*tenant.Photo, error) {
+	shared, err := s.authorize(ctx, token, sessionToken, domain.PermissionRead)
+	if err != nil {
+		return nil, err
+	}
+	link := shared.Link
+
+	photos, err := s.photos.ListByListing(ctx, link.TenantID, link.ListingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+	for i := range photos {
+		if photos[i].ID == photoID && photos[i].IsPublished {
+			s.Record(ctx, link, visitor, domain.AccessPhotoView, []uuid.UUID{photoID})
+			return &photos[i], nil
+		}
+	}
+	return nil, tenant.ErrPhotoNotFound
+}
+
+// Record logs an action of visitor on the link with the photos it touched.
+// The log only feeds analytics, so a failure is logged rather than failing
+// the visitor's request.
+func (s *ShareService) Record(ctx context.Context, link *domain.ShareLink, visitor domain.Visitor, action string, photoIDs []uuid.UUID) {
+	if err := s.accesses.Record(ctx, domain.NewAccess(link, visitor, action, photoIDs)); err != nil {
+		log.Printf("share link %s: failed to record %s access: %v", link.ID, action, err)
+	}
+}
+
+// Authorize validates token for an action on the shared listing that needs
+// permission, without counting a view or loading photos. A link whose views
+// are used up refuses it too. It fails like Open, or with
+// domain.ErrShareNotPermitted.
+func (s *ShareService) Authorize(ctx context.Context, token, sessionToken, permission string) (*SharedListing, error) {
+	return s.authorize(ctx, token, sessionToken, permission)
+}
+
+func (s *ShareService) authorize(ctx context.Context, token, sessionToken, permission string) (*SharedListing, error) {
+	link, err := s.liveLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	var session *domain.ShareSession
+	if link.Gated() {
+		if sessionToken == "" {
+			return nil, link.UnlockRequired()
+		}
+		var ok bool
+		session, ok, err = s.sessions.Get(ctx, link.ID, domain.HashToken(sessionToken))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load share session: %w", err)
+		}
+		if !ok {
+			return nil, link.UnlockRequired()
+		}
+	}
+	if !link.Allows(permission) {
+		return nil, domain.ErrShareNotPermitted
+	}
+
+	listing, err := s.listings.Get(ctx, link.TenantID, link.ListingID)
+	if errors.Is(err, tenant.ErrListingNotFound) {
+		return nil, domain.ErrShareLinkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &SharedListing{Link: link, Session: session, Listing: listing}, nil
+}
+
+// liveLink returns the unrevoked, unexpired link token belongs to, with its
+// recipients, as long as it has views left. Every use of a link goes
+// through here, so a used up link stops working for all of them.
+func (s *ShareService) liveLink(ctx context.Context, token string) (*domain.ShareLink, error) {
+	link, err := s.shares.GetByToken(ctx, domain.HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if link.Revoked() {
+		return nil, domain.ErrShareLinkRevoked
+	}
+	if link.Expired(s.now()) {
+		return nil, domain.ErrShareLinkExpired
+	}
+	if link.UsedUp() {
+		return nil, domain.ErrShareViewsUsedUp
+	}
+	if link.AllowedEmails, err = s.shares.Recipients(ctx, link.ID); err != nil {
+		return nil, fmt.Errorf("failed to list share link recipients: %w", err)
+	}
+	return link, nil
+}
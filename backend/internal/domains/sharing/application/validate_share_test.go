@@ -0,0 +1,205 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+)
+
+// Share operations a visitor can perform in a view cap scenario.
+const (
+	opOpen      = "open"
+	opAuthorize = "authorize"
+	opPhoto     = "photo"
+)
+
+type shareStep struct {
+	op      string
+	wantErr error
+}
+
+func (f *shareFixture) do(op, token string) error {
+	return f.doSession(op, token, "")
+}
+
+// doSession performs op presenting the viewer session sessionToken.
+func (f *shareFixture) doSession(op, token, sessionToken string) error {
+	ctx := context.Background()
+	visitor := domain.Visitor{IP: "203.0.113.9", UserAgent: "test"}
+	var err error
+	switch op {
+	case opOpen:
+		_, err = f.svc.Open(ctx, token, sessionToken, visitor)
+	case opAuthorize:
+		_, err = f.svc.Authorize(ctx, token, sessionToken, domain.PermissionRead)
+	case opPhoto:
+		_, err = f.svc.Photo(ctx, token, sessionToken, f.published.ID, visitor)
+	}
+	return err
+}
+
+func TestShareViewCap(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxViews int32
+		steps    []shareStep
+	}{
+		{
+			name:     "open counts until the cap",
+			maxViews: 2,
+			steps: []shareStep{
+				{op: opOpen},
+				{op: opOpen},
+				{op: opOpen, wantErr: domain.ErrShareViewsUsedUp},
+			},
+		},
+		{
+			name:     "used up link refuses authorize and photos",
+			maxViews: 1,
+			steps: []shareStep{
+				{op: opOpen},
+				{op: opAuthorize, wantErr: domain.ErrShareViewsUsedUp},
+				{op: opPhoto, wantErr: domain.ErrShareViewsUsedUp},
+			},
+		},
+		{
+			name:     "authorize and photos do not count views",
+			maxViews: 1,
+			steps: []shareStep{
+				{op: opAuthorize},
+				{op: opPhoto},
+				{op: opAuthorize},
+				{op: opPhoto},
+				{op: opOpen},
+				{op: opAuthorize, wantErr: domain.ErrShareViewsUsedUp},
+			},
+		},
+		{
+			name:     "no cap",
+			maxViews: 0,
+			steps: []shareStep{
+				{op: opOpen},
+				{op: opOpen},
+				{op: opOpen},
+				{op: opAuthorize},
+				{op: opPhoto},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newShareFixture(t)
+			_, token := f.addLink(t, domain.PermissionRead, tt.maxViews, nil)
+
+			for i, step := range tt.steps {
+				err := f.do(step.op, token)
+				if !errors.Is(err, step.wantErr) {
+					t.Fatalf("step %d (%s): err = %v, want %v", i+1, step.op, err, step.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestShareViewCapLiftedByExtend(t *testing.T) {
+	f := newShareFixture(t)
+	link, token := f.addLink(t, domain.PermissionRead, 1, nil)
+
+	if err := f.do(opOpen, token); err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if err := f.do(opAuthorize, token); !errors.Is(err, domain.ErrShareViewsUsedUp) {
+		t.Fatalf("Authorize err = %v, want ErrShareViewsUsedUp", err)
+	}
+
+	maxViews := int32(2)
+	stored := f.links.links[link.TokenHash]
+	if err := stored.Extend(nil, &maxViews, f.now); err != nil {
+		t.Fatalf("Extend: %v", err)
+	}
+	if err := f.do(opOpen, token); err != nil {
+		t.Fatalf("Open after extending: %v", err)
+	}
+	if got := stored.ViewCount; got != 2 {
+		t.Errorf("view count = %d, want 2", got)
+	}
+}
+
+func TestShareOpen(t *testing.T) {
+	f := newShareFixture(t)
+	_, token := f.addLink(t, domain.PermissionRead, 0, nil)
+
+	shared, err := f.svc.Open(context.Background(), token, "", domain.Visitor{IP: "203.0.113.9"})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if len(shared.Photos) != 1 || shared.Photos[0].ID != f.published.ID {
+		t.Errorf("Open photos = %+v, want only the published photo", shared.Photos)
+	}
+	if shared.Link.ViewCount != 1 {
+		t.Errorf("view count = %d, want 1", shared.Link.ViewCount)
+	}
+	if len(f.accesses.accesses) != 1 || f.accesses.accesses[0].Action != domain.AccessView {
+		t.Errorf("accesses = %+v, want one view", f.accesses.accesses)
+	}
+
+	if _, err := f.svc.Photo(context.Background(), token, "", f.draft.ID, domain.Visitor{}); !errors.Is(err, tenant.ErrPhotoNotFound) {
+		t.Errorf("Photo of an unpublished photo: err = %v, want ErrPhotoNotFound", err)
+	}
+}
+
+func TestShareRefusesDeadLinks(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(f *shareFixture, link *domain.ShareLink)
+		wantErr   error
+	}{
+		{
+			name: "revoked",
+			configure: func(f *shareFixture, link *domain.ShareLink) {
+				link.RevokedAt = &f.now
+			},
+			wantErr: domain.ErrShareLinkRevoked,
+		},
+		{
+			name: "expired",
+			configure: func(f *shareFixture, link *domain.ShareLink) {
+				link.ExpiresAt = f.now
+			},
+			wantErr: domain.ErrShareLinkExpired,
+		},
+		{
+			name: "listing deleted",
+			configure: func(f *shareFixture, link *domain.ShareLink) {
+				link.ListingID = link.ID
+			},
+			wantErr: domain.ErrShareLinkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newShareFixture(t)
+			_, token := f.addLink(t, domain.PermissionRead, 0, func(link *domain.ShareLink) {
+				tt.configure(f, link)
+			})
+
+			for _, op := range []string{opOpen, opAuthorize, opPhoto} {
+				if err := f.do(op, token); !errors.Is(err, tt.wantErr) {
+					t.Errorf("%s: err = %v, want %v", op, err, tt.wantErr)
+				}
+			}
+		})
+	}
+
+	t.Run("unknown token", func(t *testing.T) {
+		f := newShareFixture(t)
+		if err := f.do(opOpen, "unknown"); !errors.Is(err, domain.ErrShareLinkNotFound) {
+			t.Errorf("err = %v, want ErrShareLinkNotFound", err)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionTTL is how long a viewer session lasts once a gated link has been
+// unlocked.
+const SessionTTL = time.Hour
+
+// JobExpireSessions is the scheduled system job that deletes expired viewer
+// sessions. It carries no payload.
+const JobExpireSessions = "share.expire_sessions"
+
+// Limits on the access controls of a share link. Passwords are hashed with
+// bcrypt, which ignores anything past 72 bytes.
+const (
+	MinSharePasswordLength = 4
+	MaxSharePasswordLength = 72
+	MaxRecipients          = 100
+)
+
+// Share link access control errors.
+var (
+	ErrInvalidSharePassword    = fmt.Errorf("share password must be between %d and %d characters", MinSharePasswordLength, MaxSharePasswordLength)
+	ErrInvalidRecipient        = errors.New("recipient email address is invalid")
+	ErrTooManyRecipients       = fmt.Errorf("a share link allows at most %d recipients", MaxRecipients)
+	ErrShareLinkNotGated       = errors.New("share link is not password or recipient protected")
+	ErrInvalidShareCredentials = errors.New("share password or email is incorrect")
+	ErrRecipientsNeedPassword  = errors.New("a share link with recipients must also have a password")
+)
+
+// UnlockRequiredError is returned when a gated link is used without a live
+// viewer session. Password and Email tell the visitor what unlocking asks for.
+type UnlockRequiredError struct {
+	Password bool
+	Email    bool
+}
+
+func (e *UnlockRequiredError) Error() string {
+	return "share link must be unlocked first"
+}
+
+// UnlockSubject is the throttle subject of unlock attempts on the link from
+// ip. The link's ID alone is the subject of its attempts from every client.
+func UnlockSubject(linkID uuid.UUID, ip string) string {
+	return linkID.String() + "/" + ip
+}
+
+// ValidateSharePassword checks the share password length policy.
+func ValidateSharePassword(password string) error {
+	if len(password) < MinSharePasswordLength || len(password) > MaxSharePasswordLength {
+		return ErrInvalidSharePassword
+	}
+	return nil
+}
+
+// Gated reports whether visitors must unlock the link with its password,
+// one of its recipient emails, or both, before using it.
+func (l *ShareLink) Gated() bool {
+	return l.PasswordHash != nil || len(l.AllowedEmails) > 0
+}
+
+// CheckAccessControls returns ErrRecipientsNeedPassword if the link has
+// recipients but no password. Nothing proves a visitor owns the address
+// they type, so recipients only say who the link is for and the password
+// is what keeps everyone else out.
+func (l *ShareLink) CheckAccessControls() error {
+	if len(l.AllowedEmails) > 0 && l.PasswordHash == nil {
+		return ErrRecipientsNeedPassword
+	}
+	return nil
+}
+
+// UnlockRequired returns the error telling a visitor how to unlock the link.
+func (l *ShareLink) UnlockRequired() *UnlockRequiredError {
+	return &UnlockRequiredError{Password: l.PasswordHash != nil, Email: len(l.AllowedEmails) > 0}
+}
+
+// AllowsEmail reports whether the normalized email is one of the link's
+// recipients. Links without recipients allow any email. It matches an
+// identifier the visitor claims; it does not authenticate them.
+func (l *ShareLink) AllowsEmail(email string) bool {
+	if len(l.AllowedEmails) == 0 {
+		return true
+	}
+	for _, allowed := range l.AllowedEmails {
+		if allowed == email {
+			return true
+		}
+	}
+	return false
+}
+
+// ShareSession lets a visitor who unlocked a gated link keep using it until
+// ExpiresAt. Like ShareLink, only TokenHash is stored and Token is only set
+// on a new session. Email is the recipient that unlocked the link, when the
+// link asks for one.
+type ShareSession struct {
+	ID          uuid.UUID
+	ShareLinkID uuid.UUID
+	Token       string
+	TokenHash   string
+	Email       *string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+// NewShareSession returns a viewer session for the link with a fresh token.
+func NewShareSession(linkID uuid.UUID, email *string, now time.Time) (*ShareSession, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	return &ShareSession{
+		ShareLinkID: linkID,
+		Token:       token,
+		TokenHash:   HashToken(token),
+		Email:       email,
+		ExpiresAt:   now.Add(SessionTTL),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestShareLinkCheckAccessControls(t *testing.T) {
+	hash := "hash"
+
+	tests := []struct {
+		name       string
+		password   *string
+		recipients []string
+		wantErr    error
+	}{
+		{name: "open"},
+		{name: "password", password: &hash},
+		{name: "password and recipients", password: &hash, recipients: []string{"guest@example.com"}},
+		{name: "recipients only", recipients: []string{"guest@example.com"}, wantErr: ErrRecipientsNeedPassword},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link := &ShareLink{PasswordHash: tt.password, AllowedEmails: tt.recipients}
+			if err := link.CheckAccessControls(); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckAccessControls err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
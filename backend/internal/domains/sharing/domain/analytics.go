@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/netip"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Access actions allowed by share_link_accesses_action_check: opening the
+// shared listing, viewing one of its photos and downloading photos.
+const (
+	AccessView      = "view"
+	AccessPhotoView = "photo_view"
+	AccessDownload  = "download"
+)
+
+// Analytics bucket sizes, as date_trunc fields.
+const (
+	IntervalHour = "hour"
+	IntervalDay  = "day"
+)
+
+// TopPhotosLimit is how many photos the analytics of a link rank.
+const TopPhotosLimit = 20
+
+// maxUserAgentLength caps the user agent kept with an access, in bytes.
+const maxUserAgentLength = 512
+
+// IP prefix lengths kept of a visitor's address; the rest is zeroed.
+const (
+	ipv4PrefixBits = 24
+	ipv6PrefixBits = 48
+)
+
+// Analytics errors.
+var (
+	ErrInvalidInterval = errors.New("interval must be hour or day")
+	ErrInvalidRange    = errors.New("from must be before to")
+)
+
+// Visitor is who used a share link, as the request tells it. IP must be the
+// address the connection came from, or one forwarded by a trusted proxy,
+// since analytics and unlock throttling are keyed by it.
+type Visitor struct {
+	IP        string
+	UserAgent string
+}
+
+// Access is one use of a share link. IPPrefix is the visitor's address with
+// its host part zeroed. VisitorHash stands for the visitor: the same
+// network and user agent give the same hash for a link, and different
+// hashes for different links. PhotoIDs are the photos viewed or downloaded.
+type Access struct {
+	ID          uuid.UUID
+	ShareLinkID uuid.UUID
+	TenantID    uuid.UUID
+	Action      string
+	VisitorHash string
+	IPPrefix    string
+	UserAgent   string
+	PhotoIDs    []uuid.UUID
+	AccessedAt  time.Time
+}
+
+// NewAccess records an action of visitor on the link, anonymizing them.
+func NewAccess(link *ShareLink, visitor Visitor, action string, photoIDs []uuid.UUID) *Access {
+	prefix := AnonymizeIP(visitor.IP)
+	userAgent := strings.ToValidUTF8(visitor.UserAgent, "")
+	for len(userAgent) > maxUserAgentLength {
+		_, size := utf8.DecodeLastRuneInString(userAgent)
+		userAgent = userAgent[:len(userAgent)-size]
+	}
+
+	sum := sha256.Sum256([]byte(link.ID.String() + "\x00" + prefix + "\x00" + userAgent))
+	return &Access{
+		ShareLinkID: link.ID,
+		TenantID:    link.TenantID,
+		Action:      action,
+		VisitorHash: hex.EncodeToString(sum[:]),
+		IPPrefix:    prefix,
+		UserAgent:   userAgent,
+		PhotoIDs:    photoIDs,
+	}
+}
+
+// AnonymizeIP keeps the network part of an IP address: the first 24 bits
+// of IPv4 and 48 of IPv6. Unparsable addresses give "".
+func AnonymizeIP(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+	addr = addr.Unmap()
+	bits := ipv6PrefixBits
+	if addr.Is4() {
+		bits = ipv4PrefixBits
+	}
+	prefix, err := addr.WithZone("").Prefix(bits)
+	if err != nil {
+		return ""
+	}
+	return prefix.Addr().String()
+}
+
+// AnalyticsQuery selects the accesses of a link counted by its analytics:
+// those from From up to To, bucketed by Interval.
+type AnalyticsQuery struct {
+	From     time.Time
+	To       time.Time
+	Interval string
+}
+
+// NewAnalyticsQuery validates an analytics query of the link. A nil from
+// starts at the link's creation, a nil to ends at now, and an empty
+// interval means IntervalDay.
+func NewAnalyticsQuery(link *ShareLink, from, to *time.Time, interval string, now time.Time) (*AnalyticsQuery, error) {
+	if interval == "" {
+		interval = IntervalDay
+	}
+	if interval != IntervalHour && interval != IntervalDay {
+		return nil, ErrInvalidInterval
+	}
+	q := &AnalyticsQuery{From: link.CreatedAt, To: now, Interval: interval}
+	if from != nil {
+		q.From = *from
+	}
+	if to != nil {
+		q.To = *to
+	}
+	if !q.From.Before(q.To) {
+		return nil, ErrInvalidRange
+	}
+	return q, nil
+}
+
+// AccessSummary counts the accesses of a link by action, and the distinct
+// visitors behind them.
+type AccessSummary struct {
+	Views          int64
+	PhotoViews     int64
+	Downloads      int64
+	UniqueVisitors int64
+}
+
+// ViewBucket counts the views of a link that started in one interval.
+type ViewBucket struct {
+	Start          time.Time
+	Views          int64
+	UniqueVisitors int64
+}
+
+// PhotoStat counts how often one photo was viewed and downloaded through a
+// link.
+type PhotoStat struct {
+	PhotoID   uuid.UUID
+	FileName  string
+	Views     int64
+	Downloads int64
+}
+
+// Analytics is how a link was used over an AnalyticsQuery: its totals, its
+// views over time and its most viewed photos, most viewed first.
+type Analytics struct {
+	Query     AnalyticsQuery
+	Summary   AccessSummary
+	Views     []ViewBucket
+	TopPhotos []PhotoStat
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Proofing limits.
+const (
+	MinRating        = 1
+	MaxRating        = 5
+	MaxCommentLength = 2000
+	MaxNoteLength    = 2000
+)
+
+// Proofing errors.
+var (
+	ErrInvalidRating      = fmt.Errorf("rating must be between %d and %d", MinRating, MaxRating)
+	ErrCommentTooLong     = fmt.Errorf("comment must be at most %d characters", MaxCommentLength)
+	ErrNoteTooLong        = fmt.Errorf("note must be at most %d characters", MaxNoteLength)
+	ErrSelectionSubmitted = errors.New("the selection has already been submitted")
+	ErrEmptySelection     = errors.New("mark at least one photo as a favorite before submitting")
+)
+
+// PhotoSelection is what a share link's visitors think of one photo: a
+// favorite mark, a rating and a comment, each optional. UpdatedBy is the
+// recipient who made the last change, when the link asks for recipients.
+// Position and FileName describe the photo when selections are listed.
+type PhotoSelection struct {
+	ShareLinkID uuid.UUID
+	PhotoID     uuid.UUID
+	Favorite    bool
+	Rating      *int32
+	Comment     *string
+	UpdatedBy   *string
+	UpdatedAt   time.Time
+	Position    int32
+	FileName    string
+}
+
+// NewPhotoSelection validates a visitor's marks on a photo. A blank comment
+// is dropped.
+func NewPhotoSelection(linkID, photoID uuid.UUID, favorite bool, rating *int32, comment *string, updatedBy *string) (*PhotoSelection, error) {
+	if rating != nil && (*rating < MinRating || *rating > MaxRating) {
+		return nil, ErrInvalidRating
+	}
+	if comment != nil {
+		trimmed := strings.TrimSpace(*comment)
+		if utf8.RuneCountInString(trimmed) > MaxCommentLength {
+			return nil, ErrCommentTooLong
+		}
+		comment = &trimmed
+		if trimmed == "" {
+			comment = nil
+		}
+	}
+	return &PhotoSelection{
+		ShareLinkID: linkID,
+		PhotoID:     photoID,
+		Favorite:    favorite,
+		Rating:      rating,
+		Comment:     comment,
+		UpdatedBy:   updatedBy,
+	}, nil
+}
+
+// Empty reports whether the selection carries no mark at all, so there is
+// nothing to keep.
+func (s *PhotoSelection) Empty() bool {
+	return !s.Favorite && s.Rating == nil && s.Comment == nil
+}
+
+// SelectionSubmission is a share link's final selection. Once submitted,
+// the link's selections can no longer change.
+type SelectionSubmission struct {
+	ShareLinkID uuid.UUID
+	TenantID    uuid.UUID
+	ListingID   uuid.UUID
+	SubmittedBy *string
+	Favorites   int32
+	Note        *string
+	SubmittedAt time.Time
+}
+
+// NewSelectionSubmission validates a submission of the link's selections.
+func NewSelectionSubmission(link *ShareLink, selections []PhotoSelection, submittedBy, note *string) (*SelectionSubmission, error) {
+	var favorites int32
+	for _, s := range selections {
+		if s.Favorite {
+			favorites++
+		}
+	}
+	if favorites == 0 {
+		return nil, ErrEmptySelection
+	}
+	if note != nil {
+		trimmed := strings.TrimSpace(*note)
+		if utf8.RuneCountInString(trimmed) > MaxNoteLength {
+			return nil, ErrNoteTooLong
+		}
+		note = &trimmed
+		if trimmed == "" {
+			note = nil
+		}
+	}
+	return &SelectionSubmission{
+		ShareLinkID: link.ID,
+		TenantID:    link.TenantID,
+		ListingID:   link.ListingID,
+		SubmittedBy: submittedBy,
+		Favorites:   favorites,
+		Note:        note,
+	}, nil
+}
+
+// PhotoSelectionSummary sums up the selections every share link of a
+// listing made of one photo.
+type PhotoSelectionSummary struct {
+	PhotoID       uuid.UUID
+	Position      int32
+	FileName      string
+	Favorites     int
+	Ratings       int
+	AverageRating float64
+	Comments      int
+	Selections    []PhotoSelection
+}
+
+// Chosen reports whether any share link marked the photo as a favorite.
+func (s *PhotoSelectionSummary) Chosen() bool {
+	return s.Favorites > 0
+}
+
+// SummarizeSelections groups selections by photo, keeping the order of the
+// first selection of each photo.
+func SummarizeSelections(selections []PhotoSelection) []PhotoSelectionSummary {
+	index := make(map[uuid.UUID]int)
+	var summaries []PhotoSelectionSummary
+	for _, s := range selections {
+		i, ok := index[s.PhotoID]
+		if !ok {
+			i = len(summaries)
+			index[s.PhotoID] = i
+			summaries = append(summaries, PhotoSelectionSummary{
+				PhotoID:  s.PhotoID,
+				Position: s.Position,
+				FileName: s.FileName,
+			})
+		}
+		summary := &summaries[i]
+		summary.Selections = append(summary.Selections, s)
+		if s.Favorite {
+			summary.Favorites++
+		}
+		if s.Comment != nil {
+			summary.Comments++
+		}
+		if s.Rating != nil {
+			// Running mean, so the ratings need not be kept separately
+			summary.Ratings++
+			summary.AverageRating += (float64(*s.Rating) - summary.AverageRating) / float64(summary.Ratings)
+		}
+	}
+	return summaries
+}
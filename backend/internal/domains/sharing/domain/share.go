@@ -1 +1,173 @@
-package domain
\ No newline at end of file
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Permissions allowed by share_links_permission_check, each granting
+// everything the one before does. Read views the listing's published
+// photos, write also lets the visitor act on them, and admin also reaches
+// the originals.
+const (
+	PermissionRead  = "read"
+	PermissionWrite = "write"
+	PermissionAdmin = "admin"
+)
+
+// permissionRank orders the permissions from least to most privileged.
+var permissionRank = map[string]int{
+	PermissionRead:  1,
+	PermissionWrite: 2,
+	PermissionAdmin: 3,
+}
+
+// DefaultTTL is how long a share link lives when no expiry is given.
+const DefaultTTL = 7 * 24 * time.Hour
+
+// tokenBytes is the amount of randomness in a share token.
+const tokenBytes = 32
+
+// Share link errors.
+var (
+	ErrShareLinkNotFound = errors.New("share link not found")
+	ErrInvalidPermission = errors.New("share permission must be read, write or admin")
+	ErrInvalidExpiry     = errors.New("share link expiry must be in the future")
+	ErrInvalidMaxViews   = errors.New("max views must not be negative")
+	// ErrMaxViewsBelowViewCount is returned when a link's view limit is set
+	// below the views it has already had.
+	ErrMaxViewsBelowViewCount = errors.New("max views must not be below the views already used")
+	ErrShareLinkExpired       = errors.New("share link has expired")
+	ErrShareViewsUsedUp       = errors.New("share link has reached its view limit")
+	ErrShareNotPermitted      = errors.New("share link does not permit this action")
+	ErrShareLinkRevoked       = errors.New("share link has been revoked")
+)
+
+// ShareLink grants access to a listing to anyone holding its token.
+// MaxViews of zero means unlimited views. Only TokenHash is stored; Token is
+// set on a newly created link so it can be handed out once. A link with a
+// PasswordHash or AllowedEmails is gated: see Gated. A revoked link is
+// kept, with its access log, but no longer works.
+type ShareLink struct {
+	ID            uuid.UUID
+	ListingID     uuid.UUID
+	TenantID      uuid.UUID
+	Permission    string
+	Token         string
+	TokenHash     string
+	PasswordHash  *string
+	AllowedEmails []string
+	ExpiresAt     time.Time
+	MaxViews      int32
+	ViewCount     int32
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewShareLink validates the options and returns a share link with a fresh token.
+// A zero expiresAt defaults to now plus DefaultTTL.
+func NewShareLink(tenantID, listingID uuid.UUID, permission string, expiresAt time.Time, maxViews int32, now time.Time) (*ShareLink, error) {
+	if permission == "" {
+		permission = PermissionRead
+	}
+	if _, ok := permissionRank[permission]; !ok {
+		return nil, ErrInvalidPermission
+	}
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(DefaultTTL)
+	}
+	if !expiresAt.After(now) {
+		return nil, ErrInvalidExpiry
+	}
+	if maxViews < 0 {
+		return nil, ErrInvalidMaxViews
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShareLink{
+		ListingID:  listingID,
+		TenantID:   tenantID,
+		Permission: permission,
+		Token:      token,
+		TokenHash:  HashToken(token),
+		ExpiresAt:  expiresAt,
+		MaxViews:   maxViews,
+	}, nil
+}
+
+// Allows reports whether the link grants permission.
+func (l *ShareLink) Allows(permission string) bool {
+	rank, ok := permissionRank[permission]
+	return ok && permissionRank[l.Permission] >= rank
+}
+
+// Expired reports whether the link has stopped working at now.
+func (l *ShareLink) Expired(now time.Time) bool {
+	return !l.ExpiresAt.After(now)
+}
+
+// UsedUp reports whether the link has been viewed as often as MaxViews
+// allows, after which it no longer works.
+func (l *ShareLink) UsedUp() bool {
+	return l.MaxViews > 0 && l.ViewCount >= l.MaxViews
+}
+
+// Revoked reports whether the link has been revoked.
+func (l *ShareLink) Revoked() bool {
+	return l.RevokedAt != nil
+}
+
+// Extend changes the link's expiry and view limit, keeping its token. A nil
+// value is left as it is; a max views of zero removes the limit, and any
+// other may not be below ViewCount. On error the link is left unchanged.
+func (l *ShareLink) Extend(expiresAt *time.Time, maxViews *int32, now time.Time) error {
+	if l.Revoked() {
+		return ErrShareLinkRevoked
+	}
+	if expiresAt != nil && !expiresAt.After(now) {
+		return ErrInvalidExpiry
+	}
+	if maxViews != nil {
+		if *maxViews < 0 {
+			return ErrInvalidMaxViews
+		}
+		if *maxViews != 0 && *maxViews < l.ViewCount {
+			return ErrMaxViewsBelowViewCount
+		}
+	}
+
+	if expiresAt != nil {
+		l.ExpiresAt = *expiresAt
+	}
+	if maxViews != nil {
+		l.MaxViews = *maxViews
+	}
+	return nil
+}
+
+// HashToken returns the hex encoded SHA-256 of a share token, as stored in
+// the database.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newToken returns a URL-safe random token.
+func newToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShareLinkExtend(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+	views := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name         string
+		viewCount    int32
+		expiresAt    *time.Time
+		maxViews     *int32
+		wantErr      error
+		wantMaxViews int32
+	}{
+		{name: "raise cap", viewCount: 3, maxViews: views(10), wantMaxViews: 10},
+		{name: "cap at views used", viewCount: 3, maxViews: views(3), wantMaxViews: 3},
+		{name: "remove cap", viewCount: 3, maxViews: views(0), wantMaxViews: 0},
+		{name: "cap below views used", viewCount: 3, maxViews: views(2), wantErr: ErrMaxViewsBelowViewCount},
+		{name: "negative cap", maxViews: views(-1), wantErr: ErrInvalidMaxViews},
+		{name: "expiry only", viewCount: 3, expiresAt: &later, wantMaxViews: 5},
+		{name: "past expiry", expiresAt: &now, wantErr: ErrInvalidExpiry},
+		{name: "bad cap keeps expiry", viewCount: 3, expiresAt: &later, maxViews: views(1), wantErr: ErrMaxViewsBelowViewCount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expiresAt := now.Add(DefaultTTL)
+			link := &ShareLink{ExpiresAt: expiresAt, MaxViews: 5, ViewCount: tt.viewCount}
+
+			err := link.Extend(tt.expiresAt, tt.maxViews, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Extend err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if link.MaxViews != 5 || !link.ExpiresAt.Equal(expiresAt) {
+					t.Errorf("failed Extend changed the link: max views %d, expires %s", link.MaxViews, link.ExpiresAt)
+				}
+				return
+			}
+			if link.MaxViews != tt.wantMaxViews {
+				t.Errorf("max views = %d, want %d", link.MaxViews, tt.wantMaxViews)
+			}
+			if tt.expiresAt != nil && !link.ExpiresAt.Equal(*tt.expiresAt) {
+				t.Errorf("expires at = %s, want %s", link.ExpiresAt, *tt.expiresAt)
+			}
+		})
+	}
+
+	t.Run("revoked", func(t *testing.T) {
+		link := &ShareLink{RevokedAt: &now}
+		if err := link.Extend(nil, views(10), now); !errors.Is(err, ErrShareLinkRevoked) {
+			t.Fatalf("Extend err = %v, want ErrShareLinkRevoked", err)
+		}
+	})
+}
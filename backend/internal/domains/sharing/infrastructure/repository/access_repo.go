@@ -0,0 +1,173 @@
+package infrastructure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository/sqlc"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// AccessRepository persists the access log of share links and sums it up.
+type AccessRepository struct {
+	q *sqlc.Queries
+}
+
+// NewAccessRepository creates an AccessRepository using the given connection or transaction.
+func NewAccessRepository(db sqlc.DBTX) *AccessRepository {
+	return &AccessRepository{q: sqlc.New(db)}
+}
+
+// Record inserts the access with its photos. Use a transaction so an access
+// is never stored without its photos.
+func (r *AccessRepository) Record(ctx context.Context, access *domain.Access) (*domain.Access, error) {
+	row, err := r.q.CreateShareLinkAccess(ctx, sqlc.CreateShareLinkAccessParams{
+		ShareLinkID: access.ShareLinkID,
+		TenantID:    access.TenantID,
+		Action:      access.Action,
+		VisitorHash: access.VisitorHash,
+		IpPrefix:    access.IPPrefix,
+		UserAgent:   access.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(access.PhotoIDs) > 0 {
+		err := r.q.AddShareLinkAccessPhotos(ctx, sqlc.AddShareLinkAccessPhotosParams{
+			AccessID:    row.ID,
+			ShareLinkID: row.ShareLinkID,
+			PhotoIds:    joinIDs(access.PhotoIDs),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &domain.Access{
+		ID:          row.ID,
+		ShareLinkID: row.ShareLinkID,
+		TenantID:    row.TenantID,
+		Action:      row.Action,
+		VisitorHash: row.VisitorHash,
+		IPPrefix:    row.IpPrefix,
+		UserAgent:   row.UserAgent,
+		PhotoIDs:    access.PhotoIDs,
+		AccessedAt:  row.AccessedAt,
+	}, nil
+}
+
+// Summarize counts the link's accesses in the query's range.
+func (r *AccessRepository) Summarize(ctx context.Context, linkID uuid.UUID, q *domain.AnalyticsQuery) (domain.AccessSummary, error) {
+	row, err := r.q.SummarizeShareLinkAccesses(ctx, sqlc.SummarizeShareLinkAccessesParams{
+		ShareLinkID:  linkID,
+		AccessedFrom: q.From,
+		AccessedTo:   q.To,
+	})
+	if err != nil {
+		return domain.AccessSummary{}, err
+	}
+	return domain.AccessSummary{
+		Views:          row.Views,
+		PhotoViews:     row.PhotoViews,
+		Downloads:      row.Downloads,
+		UniqueVisitors: row.UniqueVisitors,
+	}, nil
+}
+
+// ViewsOverTime counts the link's views in the query's range by interval,
+// oldest first. Intervals without views are left out.
+func (r *AccessRepository) ViewsOverTime(ctx context.Context, linkID uuid.UUID, q *domain.AnalyticsQuery) ([]domain.ViewBucket, error) {
+	rows, err := r.q.ShareLinkViewsOverTime(ctx, sqlc.ShareLinkViewsOverTimeParams{
+		BucketSize:   q.Interval,
+		ShareLinkID:  linkID,
+		AccessedFrom: q.From,
+		AccessedTo:   q.To,
+	})
+	if err != nil {
+		return nil, err
+	}
+	buckets := make([]domain.ViewBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, domain.ViewBucket{
+			Start:          row.Bucket,
+			Views:          row.Views,
+			UniqueVisitors: row.UniqueVisitors,
+		})
+	}
+	return buckets, nil
+}
+
+// TopPhotos returns up to limit photos viewed or downloaded through the
+// link in the query's range, most viewed first.
+func (r *AccessRepository) TopPhotos(ctx context.Context, linkID uuid.UUID, q *domain.AnalyticsQuery, limit int32) ([]domain.PhotoStat, error) {
+	rows, err := r.q.TopShareLinkPhotos(ctx, sqlc.TopShareLinkPhotosParams{
+		ShareLinkID:  linkID,
+		AccessedFrom: q.From,
+		AccessedTo:   q.To,
+		PageLimit:    limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]domain.PhotoStat, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, domain.PhotoStat{
+			PhotoID:   row.PhotoID,
+			FileName:  tenant.FileName(row.Filename, row.OriginalKey),
+			Views:     row.Views,
+			Downloads: row.Downloads,
+		})
+	}
+	return stats, nil
+}
+
+// List returns a page of the link's accesses, newest first.
+func (r *AccessRepository) List(ctx context.Context, linkID uuid.UUID, limit, offset int32) ([]domain.Access, error) {
+	rows, err := r.q.ListShareLinkAccesses(ctx, sqlc.ListShareLinkAccessesParams{
+		ShareLinkID: linkID,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	accesses := make([]domain.Access, 0, len(rows))
+	for _, row := range rows {
+		accesses = append(accesses, domain.Access{
+			ID:          row.ID,
+			ShareLinkID: linkID,
+			Action:      row.Action,
+			VisitorHash: row.VisitorHash,
+			IPPrefix:    row.IpPrefix,
+			UserAgent:   row.UserAgent,
+			PhotoIDs:    splitIDs(row.PhotoIds),
+			AccessedAt:  row.AccessedAt,
+		})
+	}
+	return accesses, nil
+}
+
+func joinIDs(ids []uuid.UUID) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return strings.Join(s, ",")
+}
+
+// splitIDs parses a list written by string_agg. The column only ever holds
+// UUIDs, so unparsable parts are skipped.
+func splitIDs(s string) []uuid.UUID {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, ",")
+	ids := make([]uuid.UUID, 0, len(parts))
+	for _, part := range parts {
+		if id, err := uuid.Parse(part); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
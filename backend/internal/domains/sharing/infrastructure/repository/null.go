@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"database/sql"
+	"time"
+)
+
+// nullString converts an optional string to its SQL form.
+func nullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+// nullStringPtr converts a nullable column to an optional string.
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// nullInt32Ptr converts a nullable integer column to an optional int32.
+func nullInt32Ptr(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int32
+}
+
+// nullTimePtr converts a nullable timestamp to an optional time.
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
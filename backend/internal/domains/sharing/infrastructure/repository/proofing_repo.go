@@ -0,0 +1,176 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository/sqlc"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// ProofingRepository persists the photo selections of share links and their
+// submissions.
+type ProofingRepository struct {
+	q *sqlc.Queries
+}
+
+// NewProofingRepository creates a ProofingRepository using the given connection or transaction.
+func NewProofingRepository(db sqlc.DBTX) *ProofingRepository {
+	return &ProofingRepository{q: sqlc.New(db)}
+}
+
+// Save stores the selection of a photo of link's listing, replacing any
+// earlier one. It returns tenant.ErrPhotoNotFound unless the photo is live
+// and published in the listing.
+func (r *ProofingRepository) Save(ctx context.Context, link *domain.ShareLink, s *domain.PhotoSelection) (*domain.PhotoSelection, error) {
+	var rating sql.NullInt32
+	if s.Rating != nil {
+		rating = sql.NullInt32{Int32: *s.Rating, Valid: true}
+	}
+	row, err := r.q.UpsertPhotoSelection(ctx, sqlc.UpsertPhotoSelectionParams{
+		ShareLinkID: link.ID,
+		IsFavorite:  s.Favorite,
+		Rating:      rating,
+		Comment:     nullString(s.Comment),
+		UpdatedBy:   nullString(s.UpdatedBy),
+		TenantID:    link.TenantID,
+		ListingID:   link.ListingID,
+		PhotoID:     s.PhotoID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, tenant.ErrPhotoNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PhotoSelection{
+		ShareLinkID: row.ShareLinkID,
+		PhotoID:     row.PhotoID,
+		Favorite:    row.IsFavorite,
+		Rating:      nullInt32Ptr(row.Rating),
+		Comment:     nullStringPtr(row.Comment),
+		UpdatedBy:   nullStringPtr(row.UpdatedBy),
+		UpdatedAt:   row.UpdatedAt,
+	}, nil
+}
+
+// Delete removes the link's selection of the photo, if there is one.
+func (r *ProofingRepository) Delete(ctx context.Context, linkID, photoID uuid.UUID) error {
+	return r.q.DeletePhotoSelection(ctx, sqlc.DeletePhotoSelectionParams{
+		ShareLinkID: linkID,
+		PhotoID:     photoID,
+	})
+}
+
+// ListByLink returns the link's selections of photos it still shows, in
+// display order.
+func (r *ProofingRepository) ListByLink(ctx context.Context, linkID uuid.UUID) ([]domain.PhotoSelection, error) {
+	rows, err := r.q.ListShareLinkSelections(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+	selections := make([]domain.PhotoSelection, 0, len(rows))
+	for _, row := range rows {
+		selections = append(selections, domain.PhotoSelection{
+			ShareLinkID: linkID,
+			PhotoID:     row.PhotoID,
+			Favorite:    row.IsFavorite,
+			Rating:      nullInt32Ptr(row.Rating),
+			Comment:     nullStringPtr(row.Comment),
+			UpdatedBy:   nullStringPtr(row.UpdatedBy),
+			UpdatedAt:   row.UpdatedAt,
+			Position:    row.Position,
+			FileName:    tenant.FileName(row.Filename, row.OriginalKey),
+		})
+	}
+	return selections, nil
+}
+
+// ListByListing returns the selections every share link made of the
+// listing's live photos, in display order.
+func (r *ProofingRepository) ListByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.PhotoSelection, error) {
+	rows, err := r.q.ListListingSelections(ctx, sqlc.ListListingSelectionsParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	selections := make([]domain.PhotoSelection, 0, len(rows))
+	for _, row := range rows {
+		selections = append(selections, domain.PhotoSelection{
+			ShareLinkID: row.ShareLinkID,
+			PhotoID:     row.PhotoID,
+			Favorite:    row.IsFavorite,
+			Rating:      nullInt32Ptr(row.Rating),
+			Comment:     nullStringPtr(row.Comment),
+			UpdatedBy:   nullStringPtr(row.UpdatedBy),
+			UpdatedAt:   row.UpdatedAt,
+			Position:    row.Position,
+			FileName:    tenant.FileName(row.Filename, row.OriginalKey),
+		})
+	}
+	return selections, nil
+}
+
+// Submit records the submission. A link's selection can only be submitted
+// once.
+func (r *ProofingRepository) Submit(ctx context.Context, s *domain.SelectionSubmission) (*domain.SelectionSubmission, error) {
+	row, err := r.q.CreateSelectionSubmission(ctx, sqlc.CreateSelectionSubmissionParams{
+		ShareLinkID:   s.ShareLinkID,
+		TenantID:      s.TenantID,
+		ListingID:     s.ListingID,
+		SubmittedBy:   nullString(s.SubmittedBy),
+		FavoriteCount: s.Favorites,
+		Note:          nullString(s.Note),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSubmission(row), nil
+}
+
+// Submission returns the link's submission. ok is false when the link's
+// selection has not been submitted.
+func (r *ProofingRepository) Submission(ctx context.Context, linkID uuid.UUID) (submission *domain.SelectionSubmission, ok bool, err error) {
+	row, err := r.q.GetSelectionSubmission(ctx, linkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return toSubmission(row), true, nil
+}
+
+// ListSubmissions returns the submissions made through the listing's share
+// links, newest first.
+func (r *ProofingRepository) ListSubmissions(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.SelectionSubmission, error) {
+	rows, err := r.q.ListListingSubmissions(ctx, sqlc.ListListingSubmissionsParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	submissions := make([]domain.SelectionSubmission, 0, len(rows))
+	for _, row := range rows {
+		submissions = append(submissions, *toSubmission(row))
+	}
+	return submissions, nil
+}
+
+func toSubmission(row sqlc.SelectionSubmission) *domain.SelectionSubmission {
+	return &domain.SelectionSubmission{
+		ShareLinkID: row.ShareLinkID,
+		TenantID:    row.TenantID,
+		ListingID:   row.ListingID,
+		SubmittedBy: nullStringPtr(row.SubmittedBy),
+		Favorites:   row.FavoriteCount,
+		Note:        nullStringPtr(row.Note),
+		SubmittedAt: row.SubmittedAt,
+	}
+}
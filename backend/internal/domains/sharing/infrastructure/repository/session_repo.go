@@ -0,0 +1,72 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// SessionRepository persists the viewer sessions of gated share links.
+type SessionRepository struct {
+	q *sqlc.Queries
+}
+
+// NewSessionRepository creates a SessionRepository using the given connection or transaction.
+func NewSessionRepository(db sqlc.DBTX) *SessionRepository {
+	return &SessionRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the session, storing only its token hash. The returned
+// session keeps the plaintext token of session.
+func (r *SessionRepository) Create(ctx context.Context, session *domain.ShareSession) (*domain.ShareSession, error) {
+	row, err := r.q.CreateShareSession(ctx, sqlc.CreateShareSessionParams{
+		ShareLinkID: session.ShareLinkID,
+		Token:       session.TokenHash,
+		Email:       nullString(session.Email),
+		ExpiresAt:   session.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toShareSession(row)
+	created.Token = session.Token
+	return created, nil
+}
+
+// Get returns the live session of the link whose token hashes to tokenHash.
+// ok is false when there is none.
+func (r *SessionRepository) Get(ctx context.Context, linkID uuid.UUID, tokenHash string) (session *domain.ShareSession, ok bool, err error) {
+	row, err := r.q.GetShareSession(ctx, sqlc.GetShareSessionParams{
+		Token:       tokenHash,
+		ShareLinkID: linkID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return toShareSession(row), true, nil
+}
+
+// DeleteExpired removes the sessions that expired before now and returns
+// how many there were.
+func (r *SessionRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	return r.q.DeleteExpiredShareSessions(ctx, now)
+}
+
+func toShareSession(row sqlc.ShareSession) *domain.ShareSession {
+	return &domain.ShareSession{
+		ID:          row.ID,
+		ShareLinkID: row.ShareLinkID,
+		TokenHash:   row.Token,
+		Email:       nullStringPtr(row.Email),
+		ExpiresAt:   row.ExpiresAt,
+		CreatedAt:   row.CreatedAt,
+	}
+}
@@ -1 +1,183 @@
-package infrastructure
\ No newline at end of file
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// ShareRepository persists share links.
+type ShareRepository struct {
+	q *sqlc.Queries
+}
+
+// NewShareRepository creates a ShareRepository using the given connection or transaction.
+func NewShareRepository(db sqlc.DBTX) *ShareRepository {
+	return &ShareRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the share link and its recipients, storing only its token
+// hash, and returns it with its generated ID and timestamps. The returned
+// link keeps the plaintext token of link. Use a transaction so the link is
+// never stored without its recipients.
+func (r *ShareRepository) Create(ctx context.Context, link *domain.ShareLink) (*domain.ShareLink, error) {
+	row, err := r.q.CreateShareLink(ctx, sqlc.CreateShareLinkParams{
+		ListingID:    link.ListingID,
+		TenantID:     link.TenantID,
+		Permission:   link.Permission,
+		Token:        link.TokenHash,
+		ExpiresAt:    link.ExpiresAt,
+		MaxViews:     link.MaxViews,
+		PasswordHash: nullString(link.PasswordHash),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, email := range link.AllowedEmails {
+		err := r.q.AddShareLinkRecipient(ctx, sqlc.AddShareLinkRecipientParams{
+			ShareLinkID: row.ID,
+			Email:       email,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	created := toShareLink(row)
+	created.Token = link.Token
+	created.AllowedEmails = link.AllowedEmails
+	return created, nil
+}
+
+// Recipients returns the emails allowed to unlock the link, in order.
+func (r *ShareRepository) Recipients(ctx context.Context, linkID uuid.UUID) ([]string, error) {
+	return r.q.ListShareLinkRecipients(ctx, linkID)
+}
+
+// GetByToken returns the share link whose token hashes to tokenHash, or
+// domain.ErrShareLinkNotFound.
+func (r *ShareRepository) GetByToken(ctx context.Context, tokenHash string) (*domain.ShareLink, error) {
+	row, err := r.q.GetShareLinkByToken(ctx, tokenHash)
+	if err != nil {
+		return nil, mapShareErr(err)
+	}
+	return toShareLink(row), nil
+}
+
+// Get returns the listing's share link, without its recipients, or
+// domain.ErrShareLinkNotFound.
+func (r *ShareRepository) Get(ctx context.Context, tenantID, listingID, linkID uuid.UUID) (*domain.ShareLink, error) {
+	row, err := r.q.GetShareLink(ctx, sqlc.GetShareLinkParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		ID:        linkID,
+	})
+	if err != nil {
+		return nil, mapShareErr(err)
+	}
+	return toShareLink(row), nil
+}
+
+// CountView counts a view of the link and returns the new view count. ok is
+// false when the link has been revoked, has expired or has used up its views.
+func (r *ShareRepository) CountView(ctx context.Context, linkID uuid.UUID) (count int32, ok bool, err error) {
+	row, err := r.q.IncrementShareLinkView(ctx, linkID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return row.ViewCount, true, nil
+}
+
+// ListByListing returns the listing's share links, newest first, without
+// their recipients.
+func (r *ShareRepository) ListByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.ShareLink, error) {
+	rows, err := r.q.ListShareLinksByListing(ctx, sqlc.ListShareLinksByListingParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	links := make([]domain.ShareLink, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, domain.ShareLink{
+			ID:           row.ID,
+			ListingID:    listingID,
+			TenantID:     tenantID,
+			Permission:   row.Permission,
+			TokenHash:    row.Token,
+			PasswordHash: nullStringPtr(row.PasswordHash),
+			ExpiresAt:    row.ExpiresAt,
+			MaxViews:     row.MaxViews,
+			ViewCount:    row.ViewCount,
+			RevokedAt:    nullTimePtr(row.RevokedAt),
+			CreatedAt:    row.CreatedAt,
+			UpdatedAt:    row.UpdatedAt,
+		})
+	}
+	return links, nil
+}
+
+// Lock takes a row lock on the share link until the transaction ends, or
+// returns domain.ErrShareLinkNotFound.
+func (r *ShareRepository) Lock(ctx context.Context, tenantID, linkID uuid.UUID) error {
+	_, err := r.q.LockShareLink(ctx, sqlc.LockShareLinkParams{TenantID: tenantID, ID: linkID})
+	return mapShareErr(err)
+}
+
+// UpdateLimits stores the link's expiry and view limit, and returns the
+// updated link without its recipients, or domain.ErrShareLinkNotFound.
+func (r *ShareRepository) UpdateLimits(ctx context.Context, link *domain.ShareLink) (*domain.ShareLink, error) {
+	row, err := r.q.UpdateShareLinkLimits(ctx, sqlc.UpdateShareLinkLimitsParams{
+		TenantID:  link.TenantID,
+		ListingID: link.ListingID,
+		ID:        link.ID,
+		ExpiresAt: link.ExpiresAt,
+		MaxViews:  link.MaxViews,
+	})
+	if err != nil {
+		return nil, mapShareErr(err)
+	}
+	return toShareLink(row), nil
+}
+
+// Revoke stops the share link from working, keeping it and its access log,
+// or returns domain.ErrShareLinkNotFound.
+func (r *ShareRepository) Revoke(ctx context.Context, tenantID, listingID, linkID uuid.UUID) error {
+	_, err := r.q.RevokeShareLink(ctx, sqlc.RevokeShareLinkParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		ID:        linkID,
+	})
+	return mapShareErr(err)
+}
+
+func mapShareErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrShareLinkNotFound
+	}
+	return err
+}
+
+func toShareLink(row sqlc.ShareLink) *domain.ShareLink {
+	return &domain.ShareLink{
+		ID:           row.ID,
+		ListingID:    row.ListingID,
+		TenantID:     row.TenantID,
+		Permission:   row.Permission,
+		TokenHash:    row.Token,
+		PasswordHash: nullStringPtr(row.PasswordHash),
+		ExpiresAt:    row.ExpiresAt,
+		MaxViews:     row.MaxViews,
+		ViewCount:    row.ViewCount,
+		RevokedAt:    nullTimePtr(row.RevokedAt),
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	}
+}
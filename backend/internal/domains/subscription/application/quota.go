@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	subscriptionrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// QuotaResolver finds the plan limits that apply to a tenant.
+type QuotaResolver struct {
+	subscriptions *subscriptionrepo.SubscriptionRepository
+}
+
+// NewQuotaResolver creates a QuotaResolver. Pass the transaction that will
+// apply the change so the limits are read in the same snapshot.
+func NewQuotaResolver(db sqlc.DBTX) *QuotaResolver {
+	return &QuotaResolver{subscriptions: subscriptionrepo.NewSubscriptionRepository(db)}
+}
+
+// Limits resolves the tenant's active subscription, then its plan's limits.
+// Tenants without an active subscription get domain.ErrSubscriptionRequired.
+func (r *QuotaResolver) Limits(ctx context.Context, tenantID uuid.UUID) (*domain.PlanLimits, error) {
+	sub, err := r.subscriptions.GetActive(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoActiveSubscription) {
+			return nil, domain.ErrSubscriptionRequired
+		}
+		return nil, fmt.Errorf("failed to load subscription: %w", err)
+	}
+	limits, err := r.subscriptions.GetLimits(ctx, sub.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan limits: %w", err)
+	}
+	return limits, nil
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	subscriptionrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/infrastructure/repository"
+	"github.com/google/uuid"
+)
+
+// CurrentSubscription is a tenant's active subscription with its plan and limits.
+type CurrentSubscription struct {
+	Subscription domain.Subscription
+	Plan         domain.Plan
+	Limits       domain.PlanLimits
+}
+
+// SubscriptionService answers questions about plans and tenant subscriptions.
+type SubscriptionService struct {
+	subscriptions *subscriptionrepo.SubscriptionRepository
+}
+
+// NewSubscriptionService creates a SubscriptionService.
+func NewSubscriptionService(db *sql.DB) *SubscriptionService {
+	return &SubscriptionService{subscriptions: subscriptionrepo.NewSubscriptionRepository(db)}
+}
+
+// Current returns the tenant's active subscription, or domain.ErrNoActiveSubscription.
+func (s *SubscriptionService) Current(ctx context.Context, tenantID uuid.UUID) (*CurrentSubscription, error) {
+	sub, err := s.subscriptions.GetActive(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.subscriptions.GetPlan(ctx, sub.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan: %w", err)
+	}
+	limits, err := s.subscriptions.GetLimits(ctx, sub.PlanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plan limits: %w", err)
+	}
+
+	return &CurrentSubscription{
+		Subscription: *sub,
+		Plan:         *plan,
+		Limits:       *limits,
+	}, nil
+}
+
+// ListPlans returns every available plan.
+func (s *SubscriptionService) ListPlans(ctx context.Context) ([]domain.Plan, error) {
+	plans, err := s.subscriptions.ListPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	return plans, nil
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Quota resources reported in QuotaExceededError.
+const (
+	QuotaStorage       = "storage_bytes"
+	QuotaUpload        = "upload_bytes"
+	QuotaListings      = "listings"
+	QuotaListingPhotos = "listing_photos"
+)
+
+// ErrSubscriptionRequired is returned when a quota is checked for a tenant
+// without an active subscription.
+var ErrSubscriptionRequired = errors.New("an active subscription is required")
+
+// QuotaExceededError reports that an action would take a tenant past one of
+// its plan limits. Usage is the amount in use before the action.
+type QuotaExceededError struct {
+	Resource string
+	Usage    int64
+	Limit    int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded: %d of %d used", e.Resource, e.Usage, e.Limit)
+}
+
+// CheckStorage returns a QuotaExceededError if adding delta bytes to used
+// would exceed the plan's storage.
+func (l PlanLimits) CheckStorage(used, delta int64) error {
+	return check(QuotaStorage, used, delta, l.MaxStorageBytes)
+}
+
+// CheckUpload returns a QuotaExceededError if a single upload of size bytes
+// is larger than the plan allows.
+func (l PlanLimits) CheckUpload(size int64) error {
+	if size > l.MaxUploadBytes {
+		return &QuotaExceededError{Resource: QuotaUpload, Usage: size, Limit: l.MaxUploadBytes}
+	}
+	return nil
+}
+
+// CheckListings returns a QuotaExceededError if the tenant cannot create another listing.
+func (l PlanLimits) CheckListings(count int64) error {
+	return check(QuotaListings, count, 1, int64(l.MaxListings))
+}
+
+// CheckListingPhotos returns a QuotaExceededError if the listing cannot take another photo.
+func (l PlanLimits) CheckListingPhotos(count int64) error {
+	return check(QuotaListingPhotos, count, 1, int64(l.MaxListingPhotos))
+}
+
+// CheckAddListingPhotos returns a QuotaExceededError if the listing cannot
+// take n more photos.
+func (l PlanLimits) CheckAddListingPhotos(count, n int64) error {
+	return check(QuotaListingPhotos, count, n, int64(l.MaxListingPhotos))
+}
+
+func check(resource string, usage, delta, limit int64) error {
+	if usage+delta > limit {
+		return &QuotaExceededError{Resource: resource, Usage: usage, Limit: limit}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Plan types allowed by plan_type_check.
+const (
+	PlanFree     = "free"
+	PlanBasic    = "basic"
+	PlanBusiness = "business"
+)
+
+// Billing cycles allowed by plan_billing_cycle_check.
+const (
+	BillingMonthly = "monthly"
+	BillingYearly  = "yearly"
+)
+
+// Subscription statuses allowed by subscription_status_check.
+const (
+	StatusActive   = "active"
+	StatusInactive = "inactive"
+	StatusCanceled = "canceled"
+	StatusPastDue  = "past_due"
+)
+
+// ErrNoActiveSubscription is returned when a tenant has no current subscription.
+var ErrNoActiveSubscription = errors.New("tenant has no active subscription")
+
+// freePlanTermYears is how long a free subscription runs; it effectively never ends.
+const freePlanTermYears = 100
+
+// Subscription ties a tenant to a plan for a period of time.
+type Subscription struct {
+	ID        uuid.UUID
+	TenantID  uuid.UUID
+	PlanID    uuid.UUID
+	Status    string
+	StartedAt time.Time
+	EndAt     time.Time
+}
+
+// NewFreeSubscription returns an active free-plan subscription starting at now.
+func NewFreeSubscription(tenantID, planID uuid.UUID, now time.Time) *Subscription {
+	return &Subscription{
+		TenantID:  tenantID,
+		PlanID:    planID,
+		Status:    StatusActive,
+		StartedAt: now,
+		EndAt:     now.AddDate(freePlanTermYears, 0, 0),
+	}
+}
+
+// Plan is a purchasable tier.
+type Plan struct {
+	ID           uuid.UUID
+	Type         string
+	Price        string
+	BillingCycle string
+}
+
+// PlanLimits are the quotas that come with a plan.
+type PlanLimits struct {
+	PlanID           uuid.UUID
+	MaxStorageBytes  int64
+	MaxUploadBytes   int64
+	MaxListings      int32
+	MaxListingPhotos int32
+}
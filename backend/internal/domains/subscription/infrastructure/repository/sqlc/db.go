@@ -24,145 +24,121 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
-	if q.countUsersStmt, err = db.PrepareContext(ctx, countUsers); err != nil {
-		return nil, fmt.Errorf("error preparing query CountUsers: %w", err)
+	if q.createPlanStmt, err = db.PrepareContext(ctx, createPlan); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlan: %w", err)
 	}
-	if q.countUsersByCreationDateStmt, err = db.PrepareContext(ctx, countUsersByCreationDate); err != nil {
-		return nil, fmt.Errorf("error preparing query CountUsersByCreationDate: %w", err)
+	if q.createPlanLimitsStmt, err = db.PrepareContext(ctx, createPlanLimits); err != nil {
+		return nil, fmt.Errorf("error preparing query CreatePlanLimits: %w", err)
 	}
-	if q.createUserStmt, err = db.PrepareContext(ctx, createUser); err != nil {
-		return nil, fmt.Errorf("error preparing query CreateUser: %w", err)
+	if q.createSubscriptionStmt, err = db.PrepareContext(ctx, createSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateSubscription: %w", err)
 	}
-	if q.deleteUserStmt, err = db.PrepareContext(ctx, deleteUser); err != nil {
-		return nil, fmt.Errorf("error preparing query DeleteUser: %w", err)
+	if q.getActiveSubscriptionStmt, err = db.PrepareContext(ctx, getActiveSubscription); err != nil {
+		return nil, fmt.Errorf("error preparing query GetActiveSubscription: %w", err)
 	}
-	if q.deleteUserByEmailStmt, err = db.PrepareContext(ctx, deleteUserByEmail); err != nil {
-		return nil, fmt.Errorf("error preparing query DeleteUserByEmail: %w", err)
+	if q.getPlanByIDStmt, err = db.PrepareContext(ctx, getPlanByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlanByID: %w", err)
 	}
-	if q.getUserByEmailStmt, err = db.PrepareContext(ctx, getUserByEmail); err != nil {
-		return nil, fmt.Errorf("error preparing query GetUserByEmail: %w", err)
+	if q.getPlanByTypeStmt, err = db.PrepareContext(ctx, getPlanByType); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlanByType: %w", err)
 	}
-	if q.getUserByIDStmt, err = db.PrepareContext(ctx, getUserByID); err != nil {
-		return nil, fmt.Errorf("error preparing query GetUserByID: %w", err)
+	if q.getPlanLimitsByPlanStmt, err = db.PrepareContext(ctx, getPlanLimitsByPlan); err != nil {
+		return nil, fmt.Errorf("error preparing query GetPlanLimitsByPlan: %w", err)
 	}
-	if q.getUserCreationDateStmt, err = db.PrepareContext(ctx, getUserCreationDate); err != nil {
-		return nil, fmt.Errorf("error preparing query GetUserCreationDate: %w", err)
+	if q.getSubscriptionByIDStmt, err = db.PrepareContext(ctx, getSubscriptionByID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSubscriptionByID: %w", err)
 	}
-	if q.getUserPasswordHashStmt, err = db.PrepareContext(ctx, getUserPasswordHash); err != nil {
-		return nil, fmt.Errorf("error preparing query GetUserPasswordHash: %w", err)
+	if q.listPlansStmt, err = db.PrepareContext(ctx, listPlans); err != nil {
+		return nil, fmt.Errorf("error preparing query ListPlans: %w", err)
 	}
-	if q.listRecentUsersStmt, err = db.PrepareContext(ctx, listRecentUsers); err != nil {
-		return nil, fmt.Errorf("error preparing query ListRecentUsers: %w", err)
+	if q.listSubscriptionsByTenantStmt, err = db.PrepareContext(ctx, listSubscriptionsByTenant); err != nil {
+		return nil, fmt.Errorf("error preparing query ListSubscriptionsByTenant: %w", err)
 	}
-	if q.listUsersStmt, err = db.PrepareContext(ctx, listUsers); err != nil {
-		return nil, fmt.Errorf("error preparing query ListUsers: %w", err)
+	if q.updatePlanStmt, err = db.PrepareContext(ctx, updatePlan); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePlan: %w", err)
 	}
-	if q.listUsersByCreationDateStmt, err = db.PrepareContext(ctx, listUsersByCreationDate); err != nil {
-		return nil, fmt.Errorf("error preparing query ListUsersByCreationDate: %w", err)
+	if q.updatePlanLimitsStmt, err = db.PrepareContext(ctx, updatePlanLimits); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdatePlanLimits: %w", err)
 	}
-	if q.listUsersByIDsStmt, err = db.PrepareContext(ctx, listUsersByIDs); err != nil {
-		return nil, fmt.Errorf("error preparing query ListUsersByIDs: %w", err)
+	if q.updateSubscriptionPlanStmt, err = db.PrepareContext(ctx, updateSubscriptionPlan); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSubscriptionPlan: %w", err)
 	}
-	if q.updateUserEmailStmt, err = db.PrepareContext(ctx, updateUserEmail); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateUserEmail: %w", err)
-	}
-	if q.updateUserPasswordStmt, err = db.PrepareContext(ctx, updateUserPassword); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateUserPassword: %w", err)
-	}
-	if q.updateUserPasswordByEmailStmt, err = db.PrepareContext(ctx, updateUserPasswordByEmail); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateUserPasswordByEmail: %w", err)
-	}
-	if q.updateUsernameStmt, err = db.PrepareContext(ctx, updateUsername); err != nil {
-		return nil, fmt.Errorf("error preparing query UpdateUsername: %w", err)
+	if q.updateSubscriptionStatusStmt, err = db.PrepareContext(ctx, updateSubscriptionStatus); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateSubscriptionStatus: %w", err)
 	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
-	if q.countUsersStmt != nil {
-		if cerr := q.countUsersStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing countUsersStmt: %w", cerr)
-		}
-	}
-	if q.countUsersByCreationDateStmt != nil {
-		if cerr := q.countUsersByCreationDateStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing countUsersByCreationDateStmt: %w", cerr)
-		}
-	}
-	if q.createUserStmt != nil {
-		if cerr := q.createUserStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing createUserStmt: %w", cerr)
-		}
-	}
-	if q.deleteUserStmt != nil {
-		if cerr := q.deleteUserStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing deleteUserStmt: %w", cerr)
+	if q.createPlanStmt != nil {
+		if cerr := q.createPlanStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlanStmt: %w", cerr)
 		}
 	}
-	if q.deleteUserByEmailStmt != nil {
-		if cerr := q.deleteUserByEmailStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing deleteUserByEmailStmt: %w", cerr)
+	if q.createPlanLimitsStmt != nil {
+		if cerr := q.createPlanLimitsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createPlanLimitsStmt: %w", cerr)
 		}
 	}
-	if q.getUserByEmailStmt != nil {
-		if cerr := q.getUserByEmailStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getUserByEmailStmt: %w", cerr)
+	if q.createSubscriptionStmt != nil {
+		if cerr := q.createSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createSubscriptionStmt: %w", cerr)
 		}
 	}
-	if q.getUserByIDStmt != nil {
-		if cerr := q.getUserByIDStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getUserByIDStmt: %w", cerr)
+	if q.getActiveSubscriptionStmt != nil {
+		if cerr := q.getActiveSubscriptionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getActiveSubscriptionStmt: %w", cerr)
 		}
 	}
-	if q.getUserCreationDateStmt != nil {
-		if cerr := q.getUserCreationDateStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getUserCreationDateStmt: %w", cerr)
+	if q.getPlanByIDStmt != nil {
+		if cerr := q.getPlanByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlanByIDStmt: %w", cerr)
 		}
 	}
-	if q.getUserPasswordHashStmt != nil {
-		if cerr := q.getUserPasswordHashStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing getUserPasswordHashStmt: %w", cerr)
+	if q.getPlanByTypeStmt != nil {
+		if cerr := q.getPlanByTypeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlanByTypeStmt: %w", cerr)
 		}
 	}
-	if q.listRecentUsersStmt != nil {
-		if cerr := q.listRecentUsersStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing listRecentUsersStmt: %w", cerr)
+	if q.getPlanLimitsByPlanStmt != nil {
+		if cerr := q.getPlanLimitsByPlanStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getPlanLimitsByPlanStmt: %w", cerr)
 		}
 	}
-	if q.listUsersStmt != nil {
-		if cerr := q.listUsersStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing listUsersStmt: %w", cerr)
+	if q.getSubscriptionByIDStmt != nil {
+		if cerr := q.getSubscriptionByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSubscriptionByIDStmt: %w", cerr)
 		}
 	}
-	if q.listUsersByCreationDateStmt != nil {
-		if cerr := q.listUsersByCreationDateStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing listUsersByCreationDateStmt: %w", cerr)
+	if q.listPlansStmt != nil {
+		if cerr := q.listPlansStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listPlansStmt: %w", cerr)
 		}
 	}
-	if q.listUsersByIDsStmt != nil {
-		if cerr := q.listUsersByIDsStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing listUsersByIDsStmt: %w", cerr)
+	if q.listSubscriptionsByTenantStmt != nil {
+		if cerr := q.listSubscriptionsByTenantStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listSubscriptionsByTenantStmt: %w", cerr)
 		}
 	}
-	if q.updateUserEmailStmt != nil {
-		if cerr := q.updateUserEmailStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateUserEmailStmt: %w", cerr)
+	if q.updatePlanStmt != nil {
+		if cerr := q.updatePlanStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePlanStmt: %w", cerr)
 		}
 	}
-	if q.updateUserPasswordStmt != nil {
-		if cerr := q.updateUserPasswordStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateUserPasswordStmt: %w", cerr)
+	if q.updatePlanLimitsStmt != nil {
+		if cerr := q.updatePlanLimitsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updatePlanLimitsStmt: %w", cerr)
 		}
 	}
-	if q.updateUserPasswordByEmailStmt != nil {
-		if cerr := q.updateUserPasswordByEmailStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateUserPasswordByEmailStmt: %w", cerr)
+	if q.updateSubscriptionPlanStmt != nil {
+		if cerr := q.updateSubscriptionPlanStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSubscriptionPlanStmt: %w", cerr)
 		}
 	}
-	if q.updateUsernameStmt != nil {
-		if cerr := q.updateUsernameStmt.Close(); cerr != nil {
-			err = fmt.Errorf("error closing updateUsernameStmt: %w", cerr)
+	if q.updateSubscriptionStatusStmt != nil {
+		if cerr := q.updateSubscriptionStatusStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateSubscriptionStatusStmt: %w", cerr)
 		}
 	}
 	return err
@@ -204,45 +180,39 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 type Queries struct {
 	db                            DBTX
 	tx                            *sql.Tx
-	countUsersStmt                *sql.Stmt
-	countUsersByCreationDateStmt  *sql.Stmt
-	createUserStmt                *sql.Stmt
-	deleteUserStmt                *sql.Stmt
-	deleteUserByEmailStmt         *sql.Stmt
-	getUserByEmailStmt            *sql.Stmt
-	getUserByIDStmt               *sql.Stmt
-	getUserCreationDateStmt       *sql.Stmt
-	getUserPasswordHashStmt       *sql.Stmt
-	listRecentUsersStmt           *sql.Stmt
-	listUsersStmt                 *sql.Stmt
-	listUsersByCreationDateStmt   *sql.Stmt
-	listUsersByIDsStmt            *sql.Stmt
-	updateUserEmailStmt           *sql.Stmt
-	updateUserPasswordStmt        *sql.Stmt
-	updateUserPasswordByEmailStmt *sql.Stmt
-	updateUsernameStmt            *sql.Stmt
+	createPlanStmt                *sql.Stmt
+	createPlanLimitsStmt          *sql.Stmt
+	createSubscriptionStmt        *sql.Stmt
+	getActiveSubscriptionStmt     *sql.Stmt
+	getPlanByIDStmt               *sql.Stmt
+	getPlanByTypeStmt             *sql.Stmt
+	getPlanLimitsByPlanStmt       *sql.Stmt
+	getSubscriptionByIDStmt       *sql.Stmt
+	listPlansStmt                 *sql.Stmt
+	listSubscriptionsByTenantStmt *sql.Stmt
+	updatePlanStmt                *sql.Stmt
+	updatePlanLimitsStmt          *sql.Stmt
+	updateSubscriptionPlanStmt    *sql.Stmt
+	updateSubscriptionStatusStmt  *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
 		db:                            tx,
 		tx:                            tx,
-		countUsersStmt:                q.countUsersStmt,
-		countUsersByCreationDateStmt:  q.countUsersByCreationDateStmt,
-		createUserStmt:                q.createUserStmt,
-		deleteUserStmt:                q.deleteUserStmt,
-		deleteUserByEmailStmt:         q.deleteUserByEmailStmt,
-		getUserByEmailStmt:            q.getUserByEmailStmt,
-		getUserByIDStmt:               q.getUserByIDStmt,
-		getUserCreationDateStmt:       q.getUserCreationDateStmt,
-		getUserPasswordHashStmt:       q.getUserPasswordHashStmt,
-		listRecentUsersStmt:           q.listRecentUsersStmt,
-		listUsersStmt:                 q.listUsersStmt,
-		listUsersByCreationDateStmt:   q.listUsersByCreationDateStmt,
-		listUsersByIDsStmt:            q.listUsersByIDsStmt,
-		updateUserEmailStmt:           q.updateUserEmailStmt,
-		updateUserPasswordStmt:        q.updateUserPasswordStmt,
-		updateUserPasswordByEmailStmt: q.updateUserPasswordByEmailStmt,
-		updateUsernameStmt:            q.updateUsernameStmt,
+		createPlanStmt:                q.createPlanStmt,
+		createPlanLimitsStmt:          q.createPlanLimitsStmt,
+		createSubscriptionStmt:        q.createSubscriptionStmt,
+		getActiveSubscriptionStmt:     q.getActiveSubscriptionStmt,
+		getPlanByIDStmt:               q.getPlanByIDStmt,
+		getPlanByTypeStmt:             q.getPlanByTypeStmt,
+		getPlanLimitsByPlanStmt:       q.getPlanLimitsByPlanStmt,
+		getSubscriptionByIDStmt:       q.getSubscriptionByIDStmt,
+		listPlansStmt:                 q.listPlansStmt,
+		listSubscriptionsByTenantStmt: q.listSubscriptionsByTenantStmt,
+		updatePlanStmt:                q.updatePlanStmt,
+		updatePlanLimitsStmt:          q.updatePlanLimitsStmt,
+		updateSubscriptionPlanStmt:    q.updateSubscriptionPlanStmt,
+		updateSubscriptionStatusStmt:  q.updateSubscriptionStatusStmt,
 	}
 }
@@ -5,18 +5,426 @@
 package sqlc
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 )
 
+type Archive struct {
+	ID          uuid.UUID      `json:"id"`
+	TenantID    uuid.UUID      `json:"tenant_id"`
+	ListingID   uuid.UUID      `json:"listing_id"`
+	ShareLinkID uuid.NullUUID  `json:"share_link_id"`
+	RequestedBy uuid.NullUUID  `json:"requested_by"`
+	Variant     string         `json:"variant"`
+	Status      string         `json:"status"`
+	PhotoCount  int32          `json:"photo_count"`
+	ObjectKey   sql.NullString `json:"object_key"`
+	SizeBytes   sql.NullInt64  `json:"size_bytes"`
+	ExpiresAt   time.Time      `json:"expires_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type AuditLog struct {
+	ID          uuid.UUID             `json:"id"`
+	TenantID    uuid.UUID             `json:"tenant_id"`
+	PerformedBy uuid.NullUUID         `json:"performed_by"`
+	EntityID    uuid.UUID             `json:"entity_id"`
+	EntityType  string                `json:"entity_type"`
+	Action      string                `json:"action"`
+	ChangedData pqtype.NullRawMessage `json:"changed_data"`
+	PerformedAt time.Time             `json:"performed_at"`
+}
+
+type AuthSession struct {
+	ID           uuid.UUID    `json:"id"`
+	UserID       uuid.UUID    `json:"user_id"`
+	TenantID     uuid.UUID    `json:"tenant_id"`
+	RefreshToken string       `json:"refresh_token"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+	FamilyID     uuid.UUID    `json:"family_id"`
+	RotatedAt    sql.NullTime `json:"rotated_at"`
+	RevokedAt    sql.NullTime `json:"revoked_at"`
+}
+
+type File struct {
+	ID             uuid.UUID      `json:"id"`
+	TenantID       uuid.UUID      `json:"tenant_id"`
+	ListingID      uuid.UUID      `json:"listing_id"`
+	UserID         uuid.UUID      `json:"user_id"`
+	OriginalKey    string         `json:"original_key"`
+	WatermarkedKey sql.NullString `json:"watermarked_key"`
+	WatermarkType  sql.NullString `json:"watermark_type"`
+	ThumbnailKey   sql.NullString `json:"thumbnail_key"`
+	FileSizeBytes  int64          `json:"file_size_bytes"`
+	MimeType       string         `json:"mime_type"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	ContentSha256  sql.NullString `json:"content_sha256"`
+	Filename       string         `json:"filename"`
+}
+
+type FileBlob struct {
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+	ContentSha256 string    `json:"content_sha256"`
+	ObjectKey     string    `json:"object_key"`
+	FileSizeBytes int64     `json:"file_size_bytes"`
+	MimeType      string    `json:"mime_type"`
+	RefCount      int32     `json:"ref_count"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type FileMetadatum struct {
+	FileID              uuid.UUID       `json:"file_id"`
+	TenantID            uuid.UUID       `json:"tenant_id"`
+	Width               int32           `json:"width"`
+	Height              int32           `json:"height"`
+	Orientation         int32           `json:"orientation"`
+	CapturedAt          sql.NullTime    `json:"captured_at"`
+	CameraMake          sql.NullString  `json:"camera_make"`
+	CameraModel         sql.NullString  `json:"camera_model"`
+	LensModel           sql.NullString  `json:"lens_model"`
+	FocalLengthMm       sql.NullFloat64 `json:"focal_length_mm"`
+	FNumber             sql.NullFloat64 `json:"f_number"`
+	ExposureTimeSeconds sql.NullFloat64 `json:"exposure_time_seconds"`
+	Iso                 sql.NullInt32   `json:"iso"`
+	Artist              sql.NullString  `json:"artist"`
+	Copyright           sql.NullString  `json:"copyright"`
+	Description         sql.NullString  `json:"description"`
+	GpsLatitude         sql.NullFloat64 `json:"gps_latitude"`
+	GpsLongitude        sql.NullFloat64 `json:"gps_longitude"`
+	Iptc                json.RawMessage `json:"iptc"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+}
+
+type FileVariant struct {
+	ID            uuid.UUID `json:"id"`
+	TenantID      uuid.UUID `json:"tenant_id"`
+	FileID        uuid.UUID `json:"file_id"`
+	Width         int32     `json:"width"`
+	Height        int32     `json:"height"`
+	ObjectKey     string    `json:"object_key"`
+	FileSizeBytes int64     `json:"file_size_bytes"`
+	MimeType      string    `json:"mime_type"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type Invoice struct {
+	ID             uuid.UUID    `json:"id"`
+	TenantID       uuid.UUID    `json:"tenant_id"`
+	SubscriptionID uuid.UUID    `json:"subscription_id"`
+	Amount         string       `json:"amount"`
+	Currency       string       `json:"currency"`
+	Status         string       `json:"status"`
+	IssuedAt       time.Time    `json:"issued_at"`
+	PaidAt         sql.NullTime `json:"paid_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+type Job struct {
+	ID          uuid.UUID       `json:"id"`
+	TenantID    uuid.NullUUID   `json:"tenant_id"`
+	Type        string          `json:"type"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      string          `json:"status"`
+	UniqueKey   sql.NullString  `json:"unique_key"`
+	Attempts    int32           `json:"attempts"`
+	MaxAttempts int32           `json:"max_attempts"`
+	RunAt       time.Time       `json:"run_at"`
+	LockedAt    sql.NullTime    `json:"locked_at"`
+	LockedBy    sql.NullString  `json:"locked_by"`
+	LastError   sql.NullString  `json:"last_error"`
+	FinishedAt  sql.NullTime    `json:"finished_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+type Listing struct {
+	ID          uuid.UUID      `json:"id"`
+	TenantID    uuid.UUID      `json:"tenant_id"`
+	UserID      uuid.UUID      `json:"user_id"`
+	Title       string         `json:"title"`
+	Description sql.NullString `json:"description"`
+	Status      string         `json:"status"`
+	Visibility  string         `json:"visibility"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   sql.NullTime   `json:"deleted_at"`
+	PublishedAt sql.NullTime   `json:"published_at"`
+	PublishAt   sql.NullTime   `json:"publish_at"`
+	UnpublishAt sql.NullTime   `json:"unpublish_at"`
+}
+
+type ListingPhoto struct {
+	ID          uuid.UUID    `json:"id"`
+	TenantID    uuid.UUID    `json:"tenant_id"`
+	ListingID   uuid.UUID    `json:"listing_id"`
+	FileID      uuid.UUID    `json:"file_id"`
+	Position    int32        `json:"position"`
+	IsCover     bool         `json:"is_cover"`
+	IsPublished bool         `json:"is_published"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+}
+
+type LoginThrottle struct {
+	Scope          string       `json:"scope"`
+	Subject        string       `json:"subject"`
+	FailedAttempts int32        `json:"failed_attempts"`
+	LockoutCount   int32        `json:"lockout_count"`
+	LastFailedAt   sql.NullTime `json:"last_failed_at"`
+	LockedUntil    sql.NullTime `json:"locked_until"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+type Notification struct {
+	ID        uuid.UUID             `json:"id"`
+	UserID    uuid.UUID             `json:"user_id"`
+	TenantID  uuid.UUID             `json:"tenant_id"`
+	Message   string                `json:"message"`
+	Type      string                `json:"type"`
+	Data      pqtype.NullRawMessage `json:"data"`
+	IsRead    bool                  `json:"is_read"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+type Payment struct {
+	ID                uuid.UUID    `json:"id"`
+	UserID            uuid.UUID    `json:"user_id"`
+	TenantID          uuid.UUID    `json:"tenant_id"`
+	InvoiceID         uuid.UUID    `json:"invoice_id"`
+	SubscriptionID    uuid.UUID    `json:"subscription_id"`
+	Amount            string       `json:"amount"`
+	Currency          string       `json:"currency"`
+	Status            string       `json:"status"`
+	Method            string       `json:"method"`
+	Provider          string       `json:"provider"`
+	ProviderPaymentID string       `json:"provider_payment_id"`
+	IdempotencyKey    string       `json:"idempotency_key"`
+	PaidAt            sql.NullTime `json:"paid_at"`
+}
+
+type PhotoSelection struct {
+	ID          uuid.UUID      `json:"id"`
+	TenantID    uuid.UUID      `json:"tenant_id"`
+	ShareLinkID uuid.UUID      `json:"share_link_id"`
+	ListingID   uuid.UUID      `json:"listing_id"`
+	PhotoID     uuid.UUID      `json:"photo_id"`
+	IsFavorite  bool           `json:"is_favorite"`
+	Rating      sql.NullInt32  `json:"rating"`
+	Comment     sql.NullString `json:"comment"`
+	UpdatedBy   sql.NullString `json:"updated_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type Plan struct {
+	ID           uuid.UUID `json:"id"`
+	Type         string    `json:"type"`
+	Price        string    `json:"price"`
+	BillingCycle string    `json:"billing_cycle"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type PlanLimit struct {
+	PlanID           uuid.UUID `json:"plan_id"`
+	MaxStorageBytes  int64     `json:"max_storage_bytes"`
+	MaxUploadBytes   int64     `json:"max_upload_bytes"`
+	MaxListings      int32     `json:"max_listings"`
+	MaxListingPhotos int32     `json:"max_listing_photos"`
+}
+
+type Refund struct {
+	ID        uuid.UUID `json:"id"`
+	PaymentID uuid.UUID `json:"payment_id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	Amount    string    `json:"amount"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Role struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SelectionSubmission struct {
+	ShareLinkID   uuid.UUID      `json:"share_link_id"`
+	TenantID      uuid.UUID      `json:"tenant_id"`
+	ListingID     uuid.UUID      `json:"listing_id"`
+	SubmittedBy   sql.NullString `json:"submitted_by"`
+	FavoriteCount int32          `json:"favorite_count"`
+	Note          sql.NullString `json:"note"`
+	SubmittedAt   time.Time      `json:"submitted_at"`
+}
+
+type ShareLink struct {
+	ID           uuid.UUID      `json:"id"`
+	ListingID    uuid.UUID      `json:"listing_id"`
+	TenantID     uuid.UUID      `json:"tenant_id"`
+	Permission   string         `json:"permission"`
+	Token        string         `json:"token"`
+	ExpiresAt    time.Time      `json:"expires_at"`
+	MaxViews     int32          `json:"max_views"`
+	ViewCount    int32          `json:"view_count"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	PasswordHash sql.NullString `json:"password_hash"`
+	RevokedAt    sql.NullTime   `json:"revoked_at"`
+}
+
+type ShareLinkAccess struct {
+	ID          uuid.UUID `json:"id"`
+	ShareLinkID uuid.UUID `json:"share_link_id"`
+	TenantID    uuid.UUID `json:"tenant_id"`
+	Action      string    `json:"action"`
+	VisitorHash string    `json:"visitor_hash"`
+	IpPrefix    string    `json:"ip_prefix"`
+	UserAgent   string    `json:"user_agent"`
+	AccessedAt  time.Time `json:"accessed_at"`
+}
+
+type ShareLinkAccessPhoto struct {
+	AccessID    uuid.UUID `json:"access_id"`
+	ShareLinkID uuid.UUID `json:"share_link_id"`
+	PhotoID     uuid.UUID `json:"photo_id"`
+}
+
+type ShareLinkRecipient struct {
+	ShareLinkID uuid.UUID `json:"share_link_id"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ShareSession struct {
+	ID          uuid.UUID      `json:"id"`
+	ShareLinkID uuid.UUID      `json:"share_link_id"`
+	Token       string         `json:"token"`
+	Email       sql.NullString `json:"email"`
+	ExpiresAt   time.Time      `json:"expires_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+type Subscription struct {
+	ID        uuid.UUID `json:"id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	PlanID    uuid.UUID `json:"plan_id"`
+	Status    string    `json:"status"`
+	StartedAt time.Time `json:"started_at"`
+	EndAt     time.Time `json:"end_at"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Tenant struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TenantSetting struct {
+	TenantID          uuid.UUID      `json:"tenant_id"`
+	Theme             string         `json:"theme"`
+	WatermarkEnabled  bool           `json:"watermark_enabled"`
+	WatermarkText     sql.NullString `json:"watermark_text"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	WatermarkType     string         `json:"watermark_type"`
+	WatermarkImageKey sql.NullString `json:"watermark_image_key"`
+	WatermarkPosition string         `json:"watermark_position"`
+	WatermarkOpacity  float64        `json:"watermark_opacity"`
+	WatermarkScale    float64        `json:"watermark_scale"`
+	WatermarkTiled    bool           `json:"watermark_tiled"`
+	StripGps          bool           `json:"strip_gps"`
+}
+
+type TenantStorageUsage struct {
+	TenantID         uuid.UUID `json:"tenant_id"`
+	UsedStorageBytes int64     `json:"used_storage_bytes"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type TenantUser struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Upload struct {
+	ID           uuid.UUID       `json:"id"`
+	TenantID     uuid.UUID       `json:"tenant_id"`
+	ListingID    uuid.UUID       `json:"listing_id"`
+	UserID       uuid.UUID       `json:"user_id"`
+	Filename     string          `json:"filename"`
+	Metadata     json.RawMessage `json:"metadata"`
+	UploadLength int64           `json:"upload_length"`
+	UploadOffset int64           `json:"upload_offset"`
+	PhotoID      uuid.NullUUID   `json:"photo_id"`
+	CompletedAt  sql.NullTime    `json:"completed_at"`
+	ExpiresAt    time.Time       `json:"expires_at"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+type UploadPart struct {
+	UploadID   uuid.UUID `json:"upload_id"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	PartOffset int64     `json:"part_offset"`
+	SizeBytes  int64     `json:"size_bytes"`
+	ObjectKey  string    `json:"object_key"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type UploadReservation struct {
+	ID            uuid.UUID     `json:"id"`
+	TenantID      uuid.UUID     `json:"tenant_id"`
+	ListingID     uuid.UUID     `json:"listing_id"`
+	UserID        uuid.UUID     `json:"user_id"`
+	Filename      string        `json:"filename"`
+	ObjectKey     string        `json:"object_key"`
+	MimeType      string        `json:"mime_type"`
+	FileSizeBytes int64         `json:"file_size_bytes"`
+	PhotoID       uuid.NullUUID `json:"photo_id"`
+	CompletedAt   sql.NullTime  `json:"completed_at"`
+	ExpiresAt     time.Time     `json:"expires_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+type UsageStat struct {
+	ID                    uuid.UUID `json:"id"`
+	TenantID              uuid.UUID `json:"tenant_id"`
+	UserID                uuid.UUID `json:"user_id"`
+	TotalUploads          int64     `json:"total_uploads"`
+	TotalStorageUsedBytes int64     `json:"total_storage_used_bytes"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
 type User struct {
 	ID           uuid.UUID `json:"id"`
 	TenantID     uuid.UUID `json:"tenant_id"`
 	Username     string    `json:"username"`
 	Email        string    `json:"email"`
 	PasswordHash string    `json:"password_hash"`
-	Role         string    `json:"role"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// SubscriptionRepository persists plans and subscriptions.
+type SubscriptionRepository struct {
+	q *sqlc.Queries
+}
+
+// NewSubscriptionRepository creates a SubscriptionRepository using the given connection or transaction.
+func NewSubscriptionRepository(db sqlc.DBTX) *SubscriptionRepository {
+	return &SubscriptionRepository{q: sqlc.New(db)}
+}
+
+// GetPlanByType returns the plan of the given type and billing cycle.
+func (r *SubscriptionRepository) GetPlanByType(ctx context.Context, planType, billingCycle string) (*domain.Plan, error) {
+	row, err := r.q.GetPlanByType(ctx, sqlc.GetPlanByTypeParams{
+		Type:         planType,
+		BillingCycle: billingCycle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPlan(row), nil
+}
+
+// Create inserts the subscription and returns it with its generated ID.
+func (r *SubscriptionRepository) Create(ctx context.Context, s *domain.Subscription) (*domain.Subscription, error) {
+	row, err := r.q.CreateSubscription(ctx, sqlc.CreateSubscriptionParams{
+		TenantID:  s.TenantID,
+		PlanID:    s.PlanID,
+		Status:    s.Status,
+		StartedAt: s.StartedAt,
+		EndAt:     s.EndAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Subscription{
+		ID:        row.ID,
+		TenantID:  row.TenantID,
+		PlanID:    row.PlanID,
+		Status:    row.Status,
+		StartedAt: row.StartedAt,
+		EndAt:     row.EndAt,
+	}, nil
+}
+
+// GetActive returns the tenant's current active subscription, or domain.ErrNoActiveSubscription.
+func (r *SubscriptionRepository) GetActive(ctx context.Context, tenantID uuid.UUID) (*domain.Subscription, error) {
+	row, err := r.q.GetActiveSubscription(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNoActiveSubscription
+		}
+		return nil, err
+	}
+	return &domain.Subscription{
+		ID:        row.ID,
+		TenantID:  row.TenantID,
+		PlanID:    row.PlanID,
+		Status:    row.Status,
+		StartedAt: row.StartedAt,
+		EndAt:     row.EndAt,
+	}, nil
+}
+
+// GetPlan returns the plan by ID.
+func (r *SubscriptionRepository) GetPlan(ctx context.Context, planID uuid.UUID) (*domain.Plan, error) {
+	row, err := r.q.GetPlanByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	return toPlan(row), nil
+}
+
+// ListPlans returns every plan.
+func (r *SubscriptionRepository) ListPlans(ctx context.Context) ([]domain.Plan, error) {
+	rows, err := r.q.ListPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plans := make([]domain.Plan, 0, len(rows))
+	for _, row := range rows {
+		plans = append(plans, *toPlan(row))
+	}
+	return plans, nil
+}
+
+// GetLimits returns the quotas attached to the plan.
+func (r *SubscriptionRepository) GetLimits(ctx context.Context, planID uuid.UUID) (*domain.PlanLimits, error) {
+	row, err := r.q.GetPlanLimitsByPlan(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PlanLimits{
+		PlanID:           row.PlanID,
+		MaxStorageBytes:  row.MaxStorageBytes,
+		MaxUploadBytes:   row.MaxUploadBytes,
+		MaxListings:      row.MaxListings,
+		MaxListingPhotos: row.MaxListingPhotos,
+	}, nil
+}
+
+func toPlan(row sqlc.Plan) *domain.Plan {
+	return &domain.Plan{
+		ID:           row.ID,
+		Type:         row.Type,
+		Price:        row.Price,
+		BillingCycle: row.BillingCycle,
+	}
+}
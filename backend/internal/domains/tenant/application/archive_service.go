@@ -0,0 +1,270 @@
+package application
+
+import (
+	"archive/zip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	jobsapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/application"
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// archiveContentType is the MIME type of a ZIP archive.
+const archiveContentType = "application/zip"
+
+// purgeArchivesBatchSize is how many expired archives one query fetches.
+const purgeArchivesBatchSize = 100
+
+// ArchiveService packs a listing's photos into a ZIP, streamed straight from
+// storage. Small archives are written to the caller; large ones are built
+// by the worker and downloaded from storage until domain.ArchiveTTL passes.
+type ArchiveService struct {
+	db       *sql.DB
+	store    storage.Backend
+	listings *tenantrepo.ListingRepository
+	photos   *tenantrepo.PhotoRepository
+	archives *tenantrepo.ArchiveRepository
+}
+
+// NewArchiveService creates an ArchiveService for objects in store.
+func NewArchiveService(db *sql.DB, store storage.Backend) *ArchiveService {
+	return &ArchiveService{
+		db:       db,
+		store:    store,
+		listings: tenantrepo.NewListingRepository(db),
+		photos:   tenantrepo.NewPhotoRepository(db),
+		archives: tenantrepo.NewArchiveRepository(db),
+	}
+}
+
+// Plan plans an archive of the listing's photos in display order. A non-nil
+// keep limits it to the photos keep accepts.
+func (s *ArchiveService) Plan(ctx context.Context, tenantID, listingID uuid.UUID, variant string, keep func(*domain.Photo) bool) (*domain.ArchivePlan, error) {
+	listing, err := s.listings.Get(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, err
+	}
+	photos, err := s.photos.ListByListing(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+	if keep != nil {
+		kept := photos[:0]
+		for i := range photos {
+			if keep(&photos[i]) {
+				kept = append(kept, photos[i])
+			}
+		}
+		photos = kept
+	}
+	return domain.NewArchivePlan(listing, photos, variant)
+}
+
+// Stream writes the ZIP of plan to w, one stored object at a time. Photos
+// are already compressed, so entries are stored as they are.
+func (s *ArchiveService) Stream(ctx context.Context, w io.Writer, plan *domain.ArchivePlan) error {
+	zw := zip.NewWriter(w)
+	for _, entry := range plan.Entries {
+		if err := s.writeEntry(ctx, zw, entry); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func (s *ArchiveService) writeEntry(ctx context.Context, zw *zip.Writer, entry domain.ArchiveEntry) error {
+	r, obj, err := s.store.Get(ctx, entry.Key)
+	if err != nil {
+		return fmt.Errorf("failed to open photo %s: %w", entry.PhotoID, err)
+	}
+	defer r.Close()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     entry.Name,
+		Method:   zip.Store,
+		Modified: obj.ModifiedAt,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to archive photo %s: %w", entry.PhotoID, err)
+	}
+	return nil
+}
+
+// Queue records a pending archive of plan and queues domain.JobBuildArchive
+// for it in the same transaction.
+func (s *ArchiveService) Queue(ctx context.Context, plan *domain.ArchivePlan) (*domain.Archive, error) {
+	var archive *domain.Archive
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+		archive, err = tenantrepo.NewArchiveRepository(tx).Create(ctx, plan, time.Now().Add(domain.ArchiveTTL))
+		if err != nil {
+			return fmt.Errorf("failed to create archive: %w", err)
+		}
+		return jobsapp.NewEnqueuer(tx).Enqueue(ctx, plan.TenantID, domain.JobBuildArchive, domain.ArchiveJob{
+			ArchiveID: archive.ID,
+			PhotoIDs:  plan.PhotoIDs(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
+// Get returns the listing's archive, or domain.ErrArchiveNotFound.
+func (s *ArchiveService) Get(ctx context.Context, tenantID, listingID, archiveID uuid.UUID) (*domain.Archive, error) {
+	archive, err := s.archives.Get(ctx, tenantID, archiveID)
+	if err != nil {
+		return nil, err
+	}
+	if archive.ListingID != listingID {
+		return nil, domain.ErrArchiveNotFound
+	}
+	return archive, nil
+}
+
+// DownloadURL presigns a download link for a ready archive, valid for at
+// most as long as the archive is kept.
+func (s *ArchiveService) DownloadURL(ctx context.Context, archive *domain.Archive) (*string, error) {
+	if !archive.Ready() {
+		return nil, nil
+	}
+	ttl := min(downloadURLTTL, time.Until(archive.ExpiresAt))
+	if ttl <= 0 {
+		return nil, nil
+	}
+	url, err := s.store.PresignGet(ctx, *archive.ObjectKey, ttl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign archive url: %w", err)
+	}
+	return &url, nil
+}
+
+// Build handles domain.JobBuildArchive, streaming the archive into storage.
+// Photos deleted since the archive was queued are left out, as are photos
+// unpublished since when it was asked for through a share link. An archive
+// with nothing left to hold, or whose last attempt failed, is marked failed.
+func (s *ArchiveService) Build(ctx context.Context, job *jobs.Job, payload domain.ArchiveJob) error {
+	archive, err := s.archives.Get(ctx, job.TenantID, payload.ArchiveID)
+	if errors.Is(err, domain.ErrArchiveNotFound) {
+		// Expired and purged before it was built
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load archive: %w", err)
+	}
+	if archive.Status != domain.ArchivePending {
+		return nil
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(payload.PhotoIDs))
+	for _, id := range payload.PhotoIDs {
+		wanted[id] = true
+	}
+	shared := archive.ShareLinkID != nil
+	plan, err := s.Plan(ctx, archive.TenantID, archive.ListingID, archive.Variant, func(p *domain.Photo) bool {
+		return wanted[p.ID] && (!shared || p.IsPublished)
+	})
+	if errors.Is(err, domain.ErrEmptyArchive) || errors.Is(err, domain.ErrListingNotFound) {
+		return s.fail(ctx, archive)
+	}
+	if err != nil {
+		return s.failLastAttempt(ctx, job, archive, err)
+	}
+
+	key := domain.ArchiveObjectKey(archive.TenantID, archive.ID)
+	size, err := s.put(ctx, key, plan)
+	if err != nil {
+		s.deleteObject(key)
+		return s.failLastAttempt(ctx, job, archive, fmt.Errorf("failed to store archive: %w", err))
+	}
+
+	_, err = s.archives.Complete(ctx, archive.TenantID, archive.ID, key, size, time.Now().Add(domain.ArchiveTTL))
+	if errors.Is(err, domain.ErrArchiveNotFound) {
+		// Purged while it was being built
+		s.deleteObject(key)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to complete archive: %w", err)
+	}
+	return nil
+}
+
+// put streams the ZIP of plan into the object at key through a pipe, so
+// only a copy buffer is held in memory, and returns its size.
+func (s *ArchiveService) put(ctx context.Context, key string, plan *domain.ArchivePlan) (int64, error) {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(s.Stream(ctx, pw, plan))
+	}()
+	size, err := s.store.Put(ctx, key, pr, archiveContentType)
+	// Unblocks the writer if the backend stopped reading early
+	pr.CloseWithError(err)
+	return size, err
+}
+
+// failLastAttempt marks the archive failed when job will not be retried, and
+// returns err either way.
+func (s *ArchiveService) failLastAttempt(ctx context.Context, job *jobs.Job, archive *domain.Archive, err error) error {
+	if !job.CanRetry() {
+		if ferr := s.fail(ctx, archive); ferr != nil {
+			log.Printf("archive build: %v", ferr)
+		}
+	}
+	return err
+}
+
+func (s *ArchiveService) fail(ctx context.Context, archive *domain.Archive) error {
+	if err := s.archives.Fail(ctx, archive.TenantID, archive.ID); err != nil {
+		return fmt.Errorf("failed to mark archive %s failed: %w", archive.ID, err)
+	}
+	return nil
+}
+
+// PurgeExpired handles domain.JobPurgeArchives, removing archives past their
+// expiry along with their objects.
+func (s *ArchiveService) PurgeExpired(ctx context.Context, _ *jobs.Job, _ struct{}) error {
+	for {
+		expired, err := s.archives.ListExpired(ctx, purgeArchivesBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list expired archives: %w", err)
+		}
+		for _, archive := range expired {
+			// The object goes first, so a failed delete is retried by the
+			// next run rather than leaving it behind
+			if archive.ObjectKey != nil {
+				if err := s.store.Delete(ctx, *archive.ObjectKey); err != nil {
+					return fmt.Errorf("failed to remove archive %s: %w", archive.ID, err)
+				}
+			}
+			if err := s.archives.Delete(ctx, archive.TenantID, archive.ID); err != nil {
+				return fmt.Errorf("failed to delete archive %s: %w", archive.ID, err)
+			}
+		}
+		if len(expired) < purgeArchivesBatchSize {
+			return nil
+		}
+	}
+}
+
+// deleteObject removes an archive object that will not be handed out.
+// Failures are logged; the object is unreachable either way.
+func (s *ArchiveService) deleteObject(key string) {
+	if err := s.store.Delete(context.Background(), key); err != nil {
+		log.Printf("archive: failed to remove object %s: %v", key, err)
+	}
+}
@@ -0,0 +1,278 @@
+package application
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// expireReservationsBatchSize is how many expired reservations one query fetches.
+const expireReservationsBatchSize = 100
+
+// ReserveUploadInput asks for an object key to upload a photo to directly.
+type ReserveUploadInput struct {
+	TenantID  uuid.UUID
+	ListingID uuid.UUID
+	UserID    uuid.UUID
+	Filename  string
+	MimeType  string
+	SizeBytes int64
+}
+
+// UploadTarget is where a client uploads a reserved photo, until ExpiresAt.
+type UploadTarget struct {
+	URL       string
+	ExpiresAt time.Time
+}
+
+// DirectUploadService lets clients upload photos straight to storage with
+// presigned URLs, so the bytes never pass through the API. The declared
+// size is reserved against the tenant's storage up front and the photo is
+// uploaded to a staging key; completing the reservation checks what arrived,
+// copies it to the photo's own key and adds it to the listing.
+type DirectUploadService struct {
+	db       *sql.DB
+	store    storage.Backend
+	photos   *PhotoService
+	listings *tenantrepo.ListingRepository
+	uploads  *tenantrepo.UploadRepository
+}
+
+// NewDirectUploadService creates a DirectUploadService for objects in store.
+func NewDirectUploadService(db *sql.DB, store storage.Backend) *DirectUploadService {
+	return &DirectUploadService{
+		db:       db,
+		store:    store,
+		photos:   NewPhotoService(db, store),
+		listings: tenantrepo.NewListingRepository(db),
+		uploads:  tenantrepo.NewUploadRepository(db),
+	}
+}
+
+// Reserve checks the declared photo against the plan, reserves its size
+// against the tenant's storage and returns the reservation with a URL that
+// accepts a PUT of the photo for domain.UploadURLTTL. The PUT must send
+// in.MimeType as its Content-Type and in.SizeBytes as its Content-Length.
+func (s *DirectUploadService) Reserve(ctx context.Context, in ReserveUploadInput) (*domain.UploadReservation, *UploadTarget, error) {
+	if in.SizeBytes <= 0 {
+		return nil, nil, domain.ErrInvalidUploadLength
+	}
+	if _, err := domain.PhotoExtension(in.MimeType); err != nil {
+		return nil, nil, err
+	}
+	if _, err := s.listings.Get(ctx, in.TenantID, in.ListingID); err != nil {
+		return nil, nil, err
+	}
+
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, in.TenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := limits.CheckUpload(in.SizeBytes); err != nil {
+		return nil, nil, err
+	}
+	if in.SizeBytes > domain.MaxPhotoSizeBytes {
+		return nil, nil, domain.ErrPhotoTooLarge
+	}
+	// Fail fast on a full listing; the authoritative check happens on completion
+	count, err := tenantrepo.NewPhotoRepository(s.db).CountByListing(ctx, in.TenantID, in.ListingID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to count photos: %w", err)
+	}
+	if err := limits.CheckListingPhotos(count); err != nil {
+		return nil, nil, err
+	}
+
+	key := domain.StagingObjectKey(in.TenantID, uuid.New())
+	target := &UploadTarget{ExpiresAt: time.Now().Add(domain.UploadURLTTL)}
+	target.URL, err = s.store.PresignPut(ctx, key, in.MimeType, in.SizeBytes, domain.UploadURLTTL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to presign upload url: %w", err)
+	}
+
+	var reservation *domain.UploadReservation
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := reserveStorage(ctx, tx, in.TenantID, in.SizeBytes, limits.MaxStorageBytes); err != nil {
+			return err
+		}
+		var err error
+		reservation, err = tenantrepo.NewUploadRepository(tx).CreateReservation(ctx, &domain.UploadReservation{
+			TenantID:  in.TenantID,
+			ListingID: in.ListingID,
+			UserID:    in.UserID,
+			Filename:  in.Filename,
+			ObjectKey: key,
+			MimeType:  in.MimeType,
+			SizeBytes: in.SizeBytes,
+			ExpiresAt: time.Now().Add(domain.ReservationTTL),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create upload reservation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reservation, target, nil
+}
+
+// Complete verifies that the object uploaded for the reservation has the
+// reserved size and content type, copies it to the photo's own key and adds
+// it to the listing. An object that does not match is deleted so the client
+// can upload it again while the reservation lasts. The staging object is
+// removed once the photo is added, so the upload URL cannot reach it.
+func (s *DirectUploadService) Complete(ctx context.Context, tenantID, userID, listingID, reservationID uuid.UUID) (*domain.Photo, error) {
+	reservation, err := s.uploads.GetReservation(ctx, tenantID, userID, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	if reservation.ListingID != listingID || reservation.Expired(time.Now()) {
+		return nil, domain.ErrReservationNotFound
+	}
+	if reservation.Completed() {
+		return nil, domain.ErrReservationComplete
+	}
+
+	original, err := s.promote(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, tenantID)
+	if err != nil {
+		s.photos.deleteObject(original.Key)
+		return nil, err
+	}
+	photo, duplicate, err := s.photos.register(ctx, UploadPhotoInput{
+		TenantID:  tenantID,
+		ListingID: reservation.ListingID,
+		UserID:    userID,
+		Filename:  reservation.Filename,
+	}, limits, *original, func(tx *sql.Tx, photo *domain.Photo) error {
+		_, err := tenantrepo.NewUploadRepository(tx).CompleteReservation(ctx, tenantID, reservationID, photo.ID)
+		return err
+	})
+	if err != nil {
+		// The staging object stays with the reservation, which may be
+		// completed again or left to expire
+		s.photos.deleteObject(original.Key)
+		return nil, err
+	}
+	if duplicate {
+		s.photos.deleteObject(original.Key)
+	}
+	s.photos.deleteObject(reservation.ObjectKey)
+	return photo, nil
+}
+
+// promote checks the staged object's size and sniffed content type and
+// copies it to a new original key, hashing it on the way. The copy is what
+// was verified, whatever is uploaded to the staging key afterwards.
+func (s *DirectUploadService) promote(ctx context.Context, reservation *domain.UploadReservation) (*storedOriginal, error) {
+	ext, err := domain.PhotoExtension(reservation.MimeType)
+	if err != nil {
+		return nil, err
+	}
+	body, obj, err := s.store.Get(ctx, reservation.ObjectKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, domain.ErrReservedObjectAbsent
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open uploaded photo: %w", err)
+	}
+	defer body.Close()
+
+	if obj.Size != reservation.SizeBytes {
+		s.photos.deleteObject(reservation.ObjectKey)
+		return nil, domain.ErrReservedSizeMismatch
+	}
+
+	// The MIME type comes from the content, not the header of the PUT
+	content := bufio.NewReaderSize(body, sniffLength)
+	head, err := content.Peek(sniffLength)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, fmt.Errorf("failed to read uploaded photo: %w", err)
+	}
+	if http.DetectContentType(head) != reservation.MimeType {
+		s.photos.deleteObject(reservation.ObjectKey)
+		return nil, domain.ErrReservedTypeMismatch
+	}
+
+	key := domain.OriginalObjectKey(reservation.TenantID, uuid.New(), ext)
+	hash := sha256.New()
+	n, err := s.store.Put(ctx, key, io.TeeReader(io.LimitReader(content, reservation.SizeBytes+1), hash), reservation.MimeType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store photo: %w", err)
+	}
+	if n != reservation.SizeBytes {
+		// Replaced by another PUT while being read
+		s.photos.deleteObject(key)
+		return nil, domain.ErrReservedSizeMismatch
+	}
+	return &storedOriginal{
+		Key:      key,
+		Size:     n,
+		MimeType: reservation.MimeType,
+		Digest:   hex.EncodeToString(hash.Sum(nil)),
+		Reserved: reservation.SizeBytes,
+	}, nil
+}
+
+// ExpireReservations handles domain.JobExpireReservations. Reservations
+// past their expiry are deleted along with any staging object left behind;
+// for those never completed the reserved storage is released.
+func (s *DirectUploadService) ExpireReservations(ctx context.Context, _ *jobs.Job, _ struct{}) error {
+	for {
+		expired, err := s.uploads.ListExpiredReservations(ctx, expireReservationsBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list expired upload reservations: %w", err)
+		}
+		for _, r := range expired {
+			var reservation *domain.UploadReservation
+			err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+				var err error
+				reservation, err = tenantrepo.NewUploadRepository(tx).DeleteExpiredReservation(ctx, r.TenantID, r.ID)
+				if err != nil || reservation.Completed() {
+					return err
+				}
+				if err := tenantrepo.NewTenantRepository(tx).ReleaseStorageUsage(ctx, r.TenantID, reservation.SizeBytes); err != nil {
+					return fmt.Errorf("failed to update storage usage: %w", err)
+				}
+				return nil
+			})
+			if errors.Is(err, domain.ErrReservationNotFound) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to expire upload reservation %s: %w", r.ID, err)
+			}
+			if reservation.Completed() && !domain.IsStagingObjectKey(reservation.ObjectKey) {
+				// Reserved before staging keys; the object belongs to the photo
+				continue
+			}
+			if err := s.store.Delete(ctx, reservation.ObjectKey); err != nil {
+				log.Printf("reservation expiry: failed to remove object %s: %v", reservation.ObjectKey, err)
+			}
+		}
+		if len(expired) < expireReservationsBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"image"
+	"image/png"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	authapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/application"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// createListing signs up a fresh tenant on the free plan and creates a draft
+// listing owned by its admin, so tests sharing a database never see each
+// other's rows.
+func createListing(t *testing.T, db *sql.DB) *domain.Listing {
+	t.Helper()
+	ctx := context.Background()
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+
+	signup, err := authapp.NewSignupService(db).Signup(ctx, authapp.SignupInput{
+		TenantName: "test " + suffix,
+		Username:   "user_" + suffix,
+		Email:      "user_" + suffix + "@example.com",
+		Password:   "Correct-Horse-42",
+	})
+	if err != nil {
+		t.Fatalf("signup: %v", err)
+	}
+	return addListing(t, db, signup.TenantID, signup.UserID)
+}
+
+// addListing creates another draft listing of the tenant's user.
+func addListing(t *testing.T, db *sql.DB, tenantID, userID uuid.UUID) *domain.Listing {
+	t.Helper()
+	listing, err := tenantrepo.NewListingRepository(db).Create(context.Background(), &domain.Listing{
+		TenantID:   tenantID,
+		UserID:     userID,
+		Title:      "Listing " + uuid.NewString()[:8],
+		Status:     domain.ListingStatusDraft,
+		Visibility: domain.VisibilityPrivate,
+	})
+	if err != nil {
+		t.Fatalf("create listing: %v", err)
+	}
+	return listing
+}
+
+// newTestStore returns local storage under a temporary directory, and the
+// directory so tests can see which objects are left.
+func newTestStore(t *testing.T) (storage.Backend, string) {
+	t.Helper()
+	root := t.TempDir()
+	store, err := storage.NewLocalBackend(root, "http://localhost/storage", []byte("test-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store, root
+}
+
+// storedObjects counts the objects under a newTestStore directory.
+func storedObjects(t *testing.T, root string) int {
+	t.Helper()
+	n := 0
+	err := filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && d.Type().IsRegular() {
+			n++
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// testPNG encodes a small image whose content differs with seed.
+func testPNG(t *testing.T, seed byte) []byte {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, 16, 16))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i) ^ seed
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	notificationapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/application"
+	notification "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+)
+
+// ListingEventHandler reacts to listing domain events in the worker.
+type ListingEventHandler struct {
+	notifier *notificationapp.Notifier
+}
+
+// NewListingEventHandler creates a ListingEventHandler.
+func NewListingEventHandler(db *sql.DB) *ListingEventHandler {
+	return &ListingEventHandler{notifier: notificationapp.NewNotifier(db)}
+}
+
+// Handle handles domain.JobListingEvent. The listing's owner is notified
+// when a teammate or the schedule moved their listing.
+func (h *ListingEventHandler) Handle(ctx context.Context, _ *jobs.Job, event domain.ListingEvent) error {
+	if event.ActorID == event.OwnerID {
+		return nil
+	}
+	return h.notifier.Notify(ctx, event.TenantID, event.OwnerID, notification.TypeInfo, listingEventMessage(&event), map[string]any{
+		"event":      event.Type,
+		"listing_id": event.ListingID,
+		"from":       event.From,
+		"to":         event.To,
+		"scheduled":  event.Scheduled,
+	})
+}
+
+// listingEventMessage describes the event for its listing's owner.
+func listingEventMessage(event *domain.ListingEvent) string {
+	var msg string
+	switch event.Type {
+	case domain.EventListingPublished:
+		msg = fmt.Sprintf("%q was published", event.Title)
+	case domain.EventListingUnpublished:
+		msg = fmt.Sprintf("%q was unpublished", event.Title)
+	default:
+		msg = fmt.Sprintf("%q moved from %s to %s", event.Title, event.From, event.To)
+	}
+	if event.Scheduled {
+		msg += " as scheduled"
+	}
+	return msg
+}
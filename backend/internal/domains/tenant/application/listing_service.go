@@ -0,0 +1,325 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	jobsapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/application"
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/google/uuid"
+)
+
+// scheduleBatchSize is how many due listing schedules one query fetches.
+const scheduleBatchSize = 100
+
+// CreateListingInput is the data needed to create a listing.
+type CreateListingInput struct {
+	TenantID    uuid.UUID
+	UserID      uuid.UUID
+	Title       string
+	Description *string
+	Visibility  string
+}
+
+// ListingService manages listings.
+type ListingService struct {
+	db       *sql.DB
+	listings *tenantrepo.ListingRepository
+}
+
+// NewListingService creates a ListingService.
+func NewListingService(db *sql.DB) *ListingService {
+	return &ListingService{db: db, listings: tenantrepo.NewListingRepository(db)}
+}
+
+// Create validates and stores a new draft listing.
+func (s *ListingService) Create(ctx context.Context, in CreateListingInput) (*domain.Listing, error) {
+	listing, err := domain.NewListing(in.TenantID, in.UserID, in.Title, in.Description, in.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *domain.Listing
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		// The tenant lock serialises listing creation so the count stays accurate
+		if err := tenantrepo.NewTenantRepository(tx).Lock(ctx, in.TenantID); err != nil {
+			return fmt.Errorf("failed to lock tenant: %w", err)
+		}
+		limits, err := subscriptionapp.NewQuotaResolver(tx).Limits(ctx, in.TenantID)
+		if err != nil {
+			return err
+		}
+		listings := tenantrepo.NewListingRepository(tx)
+		count, err := listings.CountByTenant(ctx, in.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to count listings: %w", err)
+		}
+		if err := limits.CheckListings(count); err != nil {
+			return err
+		}
+
+		created, err = listings.Create(ctx, listing)
+		if err != nil {
+			return fmt.Errorf("failed to create listing: %w", err)
+		}
+		return logListingEvent(ctx, tx, created, in.UserID, audit.ActionCreate, map[string]any{"title": created.Title})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Get returns a listing, or domain.ErrListingNotFound.
+func (s *ListingService) Get(ctx context.Context, tenantID, listingID uuid.UUID) (*domain.Listing, error) {
+	return s.listings.Get(ctx, tenantID, listingID)
+}
+
+// List returns a page of the tenant's listings. The query's Limit must be
+// positive; Sort defaults to newest first.
+func (s *ListingService) List(ctx context.Context, q domain.ListingQuery) (*domain.ListingPage, error) {
+	if q.Sort == "" {
+		q.Sort = domain.ListingSortNewest
+	}
+	if err := domain.ValidateListingSort(q.Sort); err != nil {
+		return nil, err
+	}
+	if err := q.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	limit := q.Limit
+	q.Limit++
+	listings, err := s.listings.List(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list listings: %w", err)
+	}
+
+	page := &domain.ListingPage{Listings: listings}
+	if int32(len(listings)) > limit {
+		page.Listings = listings[:limit]
+		page.NextCursor = domain.CursorAfter(&page.Listings[limit-1], q.Sort).Encode()
+	}
+	return page, nil
+}
+
+// Publish publishes the listing.
+func (s *ListingService) Publish(ctx context.Context, tenantID, actorID, listingID uuid.UUID) (*domain.Listing, error) {
+	return s.Transition(ctx, tenantID, actorID, listingID, domain.ListingStatusPublished)
+}
+
+// Unpublish returns the published listing to draft.
+func (s *ListingService) Unpublish(ctx context.Context, tenantID, actorID, listingID uuid.UUID) (*domain.Listing, error) {
+	return s.move(ctx, tenantID, actorID, listingID, domain.ListingStatusPublished, domain.ListingStatusDraft)
+}
+
+// Transition moves the listing to status, or returns
+// domain.ErrInvalidTransition when the workflow does not allow the move.
+func (s *ListingService) Transition(ctx context.Context, tenantID, actorID, listingID uuid.UUID, status string) (*domain.Listing, error) {
+	return s.move(ctx, tenantID, actorID, listingID, "", status)
+}
+
+// move transitions the listing to status. A non-empty from is the status
+// the listing has to be in.
+func (s *ListingService) move(ctx context.Context, tenantID, actorID, listingID uuid.UUID, from, status string) (*domain.Listing, error) {
+	var updated *domain.Listing
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		listing, err := tenantrepo.NewListingRepository(tx).GetForUpdate(ctx, tenantID, listingID)
+		if err != nil {
+			return err
+		}
+		if from != "" && listing.Status != from {
+			return domain.ErrInvalidTransition
+		}
+		updated, err = transition(ctx, tx, listing, status, actorID, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Schedule sets when the worker publishes and unpublishes the listing; nil
+// clears a time.
+func (s *ListingService) Schedule(ctx context.Context, tenantID, actorID, listingID uuid.UUID, publishAt, unpublishAt *time.Time) (*domain.Listing, error) {
+	var updated *domain.Listing
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		listings := tenantrepo.NewListingRepository(tx)
+		listing, err := listings.GetForUpdate(ctx, tenantID, listingID)
+		if err != nil {
+			return err
+		}
+		if err := listing.Schedule(publishAt, unpublishAt, time.Now()); err != nil {
+			return err
+		}
+
+		updated, err = listings.UpdateStatus(ctx, listing)
+		if err != nil {
+			return err
+		}
+		return logListingEvent(ctx, tx, updated, actorID, audit.ActionUpdate, map[string]any{
+			"publish_at":   updated.PublishAt,
+			"unpublish_at": updated.UnpublishAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// RunSchedules handles domain.JobRunListingSchedules, publishing and
+// unpublishing listings whose scheduled time has passed.
+func (s *ListingService) RunSchedules(ctx context.Context, _ *jobs.Job, _ struct{}) error {
+	now := time.Now()
+	for {
+		due, err := s.listings.ListDueScheduled(ctx, now, scheduleBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list scheduled listings: %w", err)
+		}
+		for _, item := range due {
+			err := s.runSchedule(ctx, item, now)
+			if err != nil && !errors.Is(err, domain.ErrListingNotFound) {
+				return fmt.Errorf("failed to run schedule of listing %s: %w", item.ID, err)
+			}
+		}
+		if len(due) < scheduleBatchSize {
+			return nil
+		}
+	}
+}
+
+// runSchedule makes the listing's due transition as the system.
+func (s *ListingService) runSchedule(ctx context.Context, item domain.ScheduledListing, now time.Time) error {
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		listings := tenantrepo.NewListingRepository(tx)
+		listing, err := listings.GetForUpdate(ctx, item.TenantID, item.ID)
+		if err != nil {
+			return err
+		}
+		status, ok := listing.DueTransition(now)
+		if !ok {
+			// Rescheduled since it was listed
+			return nil
+		}
+
+		_, err = transition(ctx, tx, listing, status, uuid.Nil, true)
+		if !errors.Is(err, domain.ErrInvalidTransition) {
+			return err
+		}
+		// The listing has been moved on by hand; drop the stale time so it
+		// is not picked up again
+		if status == domain.ListingStatusPublished {
+			listing.PublishAt = nil
+		} else {
+			listing.UnpublishAt = nil
+		}
+		_, err = listings.UpdateStatus(ctx, listing)
+		return err
+	})
+}
+
+// Update edits the listing's title, description and visibility.
+func (s *ListingService) Update(ctx context.Context, tenantID, actorID, listingID uuid.UUID, changes domain.ListingChanges) (*domain.Listing, error) {
+	var updated *domain.Listing
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		listings := tenantrepo.NewListingRepository(tx)
+		listing, err := listings.Get(ctx, tenantID, listingID)
+		if err != nil {
+			return err
+		}
+		if err := listing.Apply(changes); err != nil {
+			return err
+		}
+
+		updated, err = listings.UpdateDetails(ctx, listing)
+		if err != nil {
+			return err
+		}
+		return logListingEvent(ctx, tx, updated, actorID, audit.ActionUpdate, map[string]any{
+			"title":       updated.Title,
+			"description": updated.Description,
+			"visibility":  updated.Visibility,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete soft-deletes the listing.
+func (s *ListingService) Delete(ctx context.Context, tenantID, actorID, listingID uuid.UUID) error {
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		listings := tenantrepo.NewListingRepository(tx)
+		listing, err := listings.Get(ctx, tenantID, listingID)
+		if err != nil {
+			return err
+		}
+		if err := listings.SoftDelete(ctx, tenantID, listingID); err != nil {
+			return err
+		}
+		return logListingEvent(ctx, tx, listing, actorID, audit.ActionDelete, nil)
+	})
+}
+
+// transition moves the locked listing to status in the caller's
+// transaction, writing the audit entry and queueing the domain event.
+// actorID is uuid.Nil for scheduled moves.
+func transition(ctx context.Context, tx *sql.Tx, listing *domain.Listing, status string, actorID uuid.UUID, scheduled bool) (*domain.Listing, error) {
+	event, err := listing.Transition(status, actorID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	event.Scheduled = scheduled
+
+	updated, err := tenantrepo.NewListingRepository(tx).UpdateStatus(ctx, listing)
+	if err != nil {
+		return nil, err
+	}
+	if err := logListingEvent(ctx, tx, updated, actorID, transitionAction(event), map[string]any{
+		"from":      event.From,
+		"to":        event.To,
+		"scheduled": scheduled,
+	}); err != nil {
+		return nil, err
+	}
+	if err := jobsapp.NewEnqueuer(tx).Enqueue(ctx, updated.TenantID, domain.JobListingEvent, event); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// transitionAction is the audit action recorded for a listing event.
+func transitionAction(event *domain.ListingEvent) string {
+	switch event.Type {
+	case domain.EventListingPublished:
+		return audit.ActionPublish
+	case domain.EventListingUnpublished:
+		return audit.ActionUnpublish
+	}
+	return audit.ActionUpdate
+}
+
+// logListingEvent writes an audit entry for listing in the caller's transaction.
+func logListingEvent(ctx context.Context, tx *sql.Tx, listing *domain.Listing, actorID uuid.UUID, action string, data map[string]any) error {
+	return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+		TenantID:    listing.TenantID,
+		PerformedBy: actorID,
+		EntityID:    listing.ID,
+		EntityType:  audit.EntityListing,
+		Action:      action,
+		Data:        data,
+	})
+}
@@ -0,0 +1,457 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"log"
+	"math"
+	"slices"
+
+	jobsapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/application"
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/imaging"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// defaultThumbnailSize is the thumbnail edge length when none is configured.
+const defaultThumbnailSize = 256
+
+// rewatermarkPageSize is how many files a re-watermark fan-out queues per transaction.
+const rewatermarkPageSize = 500
+
+// VariantOptions configures the renditions produced for every photo.
+type VariantOptions struct {
+	// Widths are the srcset widths in pixels. Widths at or above the
+	// original's are skipped so photos are never upscaled.
+	Widths []int
+	// ThumbnailSize is the edge length of the square thumbnail.
+	ThumbnailSize int
+}
+
+// PhotoProcessor renders derived images of uploaded photos. It runs in the
+// worker; the API only queues its jobs.
+type PhotoProcessor struct {
+	db      *sql.DB
+	store   storage.Backend
+	files   *tenantrepo.FileRepository
+	tenants *tenantrepo.TenantRepository
+	opts    VariantOptions
+}
+
+// NewPhotoProcessor creates a PhotoProcessor that reads and writes objects in store.
+func NewPhotoProcessor(db *sql.DB, store storage.Backend, opts VariantOptions) *PhotoProcessor {
+	widths := slices.DeleteFunc(slices.Clone(opts.Widths), func(w int) bool { return w <= 0 })
+	slices.Sort(widths)
+	opts.Widths = slices.Compact(widths)
+	if opts.ThumbnailSize <= 0 {
+		opts.ThumbnailSize = defaultThumbnailSize
+	}
+
+	return &PhotoProcessor{
+		db:      db,
+		store:   store,
+		files:   tenantrepo.NewFileRepository(db),
+		tenants: tenantrepo.NewTenantRepository(db),
+		opts:    opts,
+	}
+}
+
+// ExtractMetadata handles domain.JobPhotoMetadata. It reads the EXIF and
+// IPTC blocks of the original upload into file_metadata, then queues the
+// watermark job, whose renditions embed the sanitized metadata.
+func (p *PhotoProcessor) ExtractMetadata(ctx context.Context, job *jobs.Job, payload domain.PhotoJob) error {
+	file, err := p.files.Get(ctx, job.TenantID, payload.FileID)
+	if errors.Is(err, domain.ErrFileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load file: %w", err)
+	}
+
+	data, err := p.read(ctx, file.OriginalKey)
+	if err != nil {
+		return err
+	}
+	meta, err := imaging.ReadMetadata(data)
+	if err != nil {
+		return jobs.Permanent(err)
+	}
+
+	err = postgres.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		if _, err := tenantrepo.NewFileRepository(tx).SaveMetadata(ctx, toFileMetadata(file, meta)); err != nil {
+			return err
+		}
+		return enqueuePhotoJob(ctx, tx, domain.JobPhotoWatermark, file)
+	})
+	if _, ok := postgres.ForeignKeyViolation(err); ok {
+		// The file was deleted after it was loaded
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record metadata: %w", err)
+	}
+	return nil
+}
+
+// Watermark handles domain.JobPhotoWatermark. It renders the tenant's current
+// watermark onto the original, or drops the watermarked copy when watermarks
+// are off, then queues the variants so they are rendered from the result.
+func (p *PhotoProcessor) Watermark(ctx context.Context, job *jobs.Job, payload domain.PhotoJob) error {
+	file, err := p.files.Get(ctx, job.TenantID, payload.FileID)
+	if errors.Is(err, domain.ErrFileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load file: %w", err)
+	}
+	settings, err := p.tenants.GetSettings(ctx, file.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+
+	var key, watermarkType *string
+	if settings.WatermarkEnabled {
+		img, err := p.load(ctx, file.OriginalKey)
+		if err != nil {
+			return err
+		}
+		marked, err := p.renderWatermark(ctx, img, settings)
+		if err != nil {
+			return err
+		}
+		exif, err := p.publicEXIF(ctx, file, settings)
+		if err != nil {
+			return err
+		}
+		out, err := p.put(ctx, marked, exif, func(ext string) string {
+			return domain.WatermarkedObjectKey(file.TenantID, file.ID, ext)
+		})
+		if err != nil {
+			return err
+		}
+		key, watermarkType = &out.ObjectKey, &settings.WatermarkType
+	}
+
+	err = postgres.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		if err := tenantrepo.NewFileRepository(tx).SetWatermark(ctx, file.TenantID, file.ID, key, watermarkType); err != nil {
+			return err
+		}
+		return enqueuePhotoJob(ctx, tx, domain.JobPhotoVariants, file)
+	})
+	if errors.Is(err, domain.ErrFileNotFound) {
+		if key != nil {
+			p.deleteObjects([]string{*key})
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record watermark: %w", err)
+	}
+
+	if file.WatermarkedKey != nil && (key == nil || *key != *file.WatermarkedKey) {
+		p.deleteObjects([]string{*file.WatermarkedKey})
+	}
+	return nil
+}
+
+// renderWatermark draws the watermark described by settings onto img.
+func (p *PhotoProcessor) renderWatermark(ctx context.Context, img image.Image, settings *domain.Settings) (image.Image, error) {
+	opts := imaging.WatermarkOptions{
+		Position: settings.WatermarkPosition,
+		Opacity:  settings.WatermarkOpacity,
+		Scale:    settings.WatermarkScale,
+		Tiled:    settings.WatermarkTiled,
+	}
+
+	if settings.WatermarkType == domain.WatermarkTypeImage {
+		if settings.WatermarkImageKey == nil {
+			return nil, jobs.Permanent(domain.ErrWatermarkImageRequired)
+		}
+		logo, err := p.load(ctx, *settings.WatermarkImageKey)
+		if err != nil {
+			return nil, err
+		}
+		return imaging.WatermarkImage(img, logo, opts), nil
+	}
+
+	text := ""
+	if settings.WatermarkText != nil {
+		text = *settings.WatermarkText
+	} else {
+		t, err := p.tenants.Get(ctx, settings.TenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tenant: %w", err)
+		}
+		text = "© " + t.Name
+	}
+	marked, err := imaging.WatermarkText(img, text, opts)
+	if err != nil {
+		return nil, jobs.Permanent(err)
+	}
+	return marked, nil
+}
+
+// RewatermarkTenant handles domain.JobRewatermarkTenant by queuing a
+// watermark job for each of the tenant's files. Files already waiting for
+// one are skipped by the job's unique key.
+func (p *PhotoProcessor) RewatermarkTenant(ctx context.Context, job *jobs.Job, _ struct{}) error {
+	var after uuid.UUID
+	for {
+		ids, err := p.files.ListIDs(ctx, job.TenantID, after, rewatermarkPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		err = postgres.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+			for _, id := range ids {
+				if err := enqueuePhotoJob(ctx, tx, domain.JobPhotoWatermark, &domain.File{ID: id, TenantID: job.TenantID}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		after = ids[len(ids)-1]
+	}
+}
+
+// GenerateVariants handles domain.JobPhotoVariants. It stores a square
+// thumbnail and one rendition per configured width of the file's display
+// image, then records them on the file. Object keys are deterministic, so a
+// rerun overwrites its own output. The widths carry the sanitized metadata;
+// thumbnails carry none.
+func (p *PhotoProcessor) GenerateVariants(ctx context.Context, job *jobs.Job, payload domain.PhotoJob) error {
+	file, err := p.files.Get(ctx, job.TenantID, payload.FileID)
+	if errors.Is(err, domain.ErrFileNotFound) {
+		// Deleted since the job was queued; nothing left to render
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load file: %w", err)
+	}
+
+	settings, err := p.tenants.GetSettings(ctx, file.TenantID)
+	if err != nil {
+		return fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+	exif, err := p.publicEXIF(ctx, file, settings)
+	if err != nil {
+		return err
+	}
+	img, err := p.load(ctx, file.DisplayKey())
+	if err != nil {
+		return err
+	}
+
+	var written []string
+	thumb, err := p.put(ctx, imaging.Square(img, p.opts.ThumbnailSize), nil, func(ext string) string {
+		return domain.ThumbnailObjectKey(file.TenantID, file.ID, ext)
+	})
+	if err != nil {
+		return err
+	}
+	written = append(written, thumb.ObjectKey)
+
+	variants := make([]domain.Variant, 0, len(p.opts.Widths))
+	for _, width := range p.opts.Widths {
+		if width >= img.Bounds().Dx() {
+			break
+		}
+		variant, err := p.put(ctx, imaging.FitWidth(img, width), exif, func(ext string) string {
+			return domain.VariantObjectKey(file.TenantID, file.ID, width, ext)
+		})
+		if err != nil {
+			return err
+		}
+		variant.FileID = file.ID
+		variants = append(variants, variant)
+		written = append(written, variant.ObjectKey)
+	}
+
+	var removed []string
+	err = postgres.WithTx(ctx, p.db, func(tx *sql.Tx) error {
+		files := tenantrepo.NewFileRepository(tx)
+		if err := files.SetThumbnail(ctx, file.TenantID, file.ID, thumb.ObjectKey); err != nil {
+			return err
+		}
+		removed, err = files.ReplaceVariants(ctx, file.TenantID, file.ID, variants)
+		return err
+	})
+	if errors.Is(err, domain.ErrFileNotFound) {
+		p.deleteObjects(written)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record variants: %w", err)
+	}
+
+	// Renditions that were not regenerated, e.g. for a width no longer configured
+	if file.ThumbnailKey != nil {
+		removed = append(removed, *file.ThumbnailKey)
+	}
+	p.deleteObjects(slices.DeleteFunc(removed, func(key string) bool {
+		return slices.Contains(written, key)
+	}))
+	return nil
+}
+
+// load reads and decodes a stored photo, turned upright. Missing or
+// undecodable photos fail permanently since retrying cannot fix them.
+func (p *PhotoProcessor) load(ctx context.Context, key string) (image.Image, error) {
+	data, err := p.read(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	img, err := imaging.Decode(data)
+	if err != nil {
+		return nil, jobs.Permanent(err)
+	}
+	return img, nil
+}
+
+// read returns the bytes of a stored photo.
+func (p *PhotoProcessor) read(ctx context.Context, key string) ([]byte, error) {
+	body, _, err := p.store.Get(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, jobs.Permanent(fmt.Errorf("photo object %s: %w", key, err))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open photo: %w", err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, domain.MaxPhotoSizeBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read photo: %w", err)
+	}
+	return data, nil
+}
+
+// publicEXIF builds the EXIF block embedded in the file's public renditions,
+// leaving out the location when the tenant strips GPS. Files whose metadata
+// has not been extracted get none.
+func (p *PhotoProcessor) publicEXIF(ctx context.Context, file *domain.File, settings *domain.Settings) ([]byte, error) {
+	m, err := p.files.GetMetadata(ctx, file.TenantID, file.ID)
+	if errors.Is(err, domain.ErrFileMetadataNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load file metadata: %w", err)
+	}
+
+	meta := imaging.Metadata{
+		CapturedAt:   m.CapturedAt,
+		CameraMake:   m.CameraMake,
+		CameraModel:  m.CameraModel,
+		LensModel:    m.LensModel,
+		FocalLength:  m.FocalLength,
+		FNumber:      m.FNumber,
+		ExposureTime: m.ExposureTime,
+		ISO:          int(m.ISO),
+		Artist:       m.Artist,
+		Copyright:    m.Copyright,
+		Description:  m.Description,
+		IPTC: imaging.IPTC{
+			Creator:   m.IPTC.Creator,
+			Copyright: m.IPTC.Copyright,
+			Caption:   m.IPTC.Caption,
+		},
+	}
+	if m.GPS != nil {
+		meta.GPS = &imaging.GPS{Latitude: m.GPS.Latitude, Longitude: m.GPS.Longitude}
+	}
+	return meta.EXIF(!settings.StripGPS), nil
+}
+
+// put encodes img with the given EXIF block, which may be nil, and stores it
+// under the key built for the chosen format.
+func (p *PhotoProcessor) put(ctx context.Context, img image.Image, exif []byte, keyFor func(ext string) string) (domain.Variant, error) {
+	var buf bytes.Buffer
+	mimeType, ext, err := imaging.EncodeWithEXIF(&buf, img, exif)
+	if err != nil {
+		return domain.Variant{}, err
+	}
+	key := keyFor(ext)
+	size, err := p.store.Put(ctx, key, &buf, mimeType)
+	if err != nil {
+		return domain.Variant{}, fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	return domain.Variant{
+		Width:     int32(img.Bounds().Dx()),
+		Height:    int32(img.Bounds().Dy()),
+		ObjectKey: key,
+		SizeBytes: size,
+		MimeType:  mimeType,
+	}, nil
+}
+
+// deleteObjects removes derived objects that are no longer referenced.
+// Failures only leave garbage behind, so they are logged and skipped.
+func (p *PhotoProcessor) deleteObjects(keys []string) {
+	for _, key := range keys {
+		if err := p.store.Delete(context.Background(), key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("photo processor: failed to remove object %s: %v", key, err)
+		}
+	}
+}
+
+// toFileMetadata converts metadata read from file's original.
+func toFileMetadata(file *domain.File, m *imaging.Metadata) *domain.FileMetadata {
+	out := &domain.FileMetadata{
+		FileID:       file.ID,
+		TenantID:     file.TenantID,
+		Width:        int32(m.Width),
+		Height:       int32(m.Height),
+		Orientation:  int32(m.Orientation),
+		CapturedAt:   m.CapturedAt,
+		CameraMake:   m.CameraMake,
+		CameraModel:  m.CameraModel,
+		LensModel:    m.LensModel,
+		FocalLength:  m.FocalLength,
+		FNumber:      m.FNumber,
+		ExposureTime: m.ExposureTime,
+		ISO:          int32(min(m.ISO, math.MaxInt32)),
+		Artist:       m.Artist,
+		Copyright:    m.Copyright,
+		Description:  m.Description,
+		IPTC: domain.IPTC{
+			Title:     m.IPTC.Title,
+			Headline:  m.IPTC.Headline,
+			Caption:   m.IPTC.Caption,
+			Keywords:  m.IPTC.Keywords,
+			Creator:   m.IPTC.Creator,
+			Copyright: m.IPTC.Copyright,
+			City:      m.IPTC.City,
+			Country:   m.IPTC.Country,
+		},
+	}
+	if m.GPS != nil {
+		out.GPS = &domain.GPSLocation{Latitude: m.GPS.Latitude, Longitude: m.GPS.Longitude}
+	}
+	return out
+}
+
+// enqueuePhotoJob queues jobType for file in the caller's transaction. A job
+// for the same file that has not started yet absorbs the new one.
+func enqueuePhotoJob(ctx context.Context, tx *sql.Tx, jobType string, file *domain.File) error {
+	job, err := jobs.NewJob(file.TenantID, jobType, domain.PhotoJob{FileID: file.ID})
+	if err != nil {
+		return err
+	}
+	job.UniqueKey = jobType + ":" + file.ID.String()
+	return jobsapp.NewEnqueuer(tx).EnqueueJob(ctx, job)
+}
@@ -0,0 +1,593 @@
+package application
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	subscription "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// sniffLength is how many leading bytes http.DetectContentType looks at.
+const sniffLength = 512
+
+// downloadURLTTL is how long presigned photo URLs stay valid.
+const downloadURLTTL = 15 * time.Minute
+
+// PhotoURLs are short-lived download links for a photo's stored objects.
+type PhotoURLs struct {
+	Original    string
+	Watermarked *string
+	Thumbnail   *string
+	Variants    []VariantURL
+	// Srcset lists the variants in HTML srcset syntax, narrowest first.
+	Srcset string
+}
+
+// PublicPhotoURLs are short-lived download links for the renditions of a
+// photo shown outside the tenant.
+type PublicPhotoURLs struct {
+	Display   string
+	Thumbnail *string
+	Variants  []VariantURL
+	// Srcset lists the variants in HTML srcset syntax, narrowest first.
+	Srcset string
+}
+
+// VariantURL is a download link for one resized rendition of a photo.
+type VariantURL struct {
+	Width  int32
+	Height int32
+	URL    string
+}
+
+// UploadPhotoInput is a photo being uploaded into a listing.
+type UploadPhotoInput struct {
+	TenantID  uuid.UUID
+	ListingID uuid.UUID
+	UserID    uuid.UUID
+	Filename  string
+	Body      io.Reader
+}
+
+// PhotoService manages the photos placed in listings.
+type PhotoService struct {
+	db       *sql.DB
+	store    storage.Backend
+	listings *tenantrepo.ListingRepository
+	photos   *tenantrepo.PhotoRepository
+	tenants  *tenantrepo.TenantRepository
+}
+
+// NewPhotoService creates a PhotoService that keeps photo bytes in store.
+func NewPhotoService(db *sql.DB, store storage.Backend) *PhotoService {
+	return &PhotoService{
+		db:       db,
+		store:    store,
+		listings: tenantrepo.NewListingRepository(db),
+		photos:   tenantrepo.NewPhotoRepository(db),
+		tenants:  tenantrepo.NewTenantRepository(db),
+	}
+}
+
+// Upload streams the photo to blob storage, then records the file, appends it
+// to the listing and counts the bytes against the tenant and uploader in one
+// transaction. Content the tenant has stored before is shared with the
+// earlier files instead of being kept or counted twice. The new object is
+// removed again if the transaction fails or the content was a duplicate.
+func (s *PhotoService) Upload(ctx context.Context, in UploadPhotoInput) (*domain.Photo, error) {
+	return s.upload(ctx, in, nil)
+}
+
+// upload implements Upload. A non-nil inTx runs in the upload's transaction
+// once the photo is added, so callers can record it atomically.
+func (s *PhotoService) upload(ctx context.Context, in UploadPhotoInput, inTx func(tx *sql.Tx, photo *domain.Photo) error) (*domain.Photo, error) {
+	if _, err := s.listings.Get(ctx, in.TenantID, in.ListingID); err != nil {
+		return nil, err
+	}
+
+	// Reject early when the tenant is already out of storage; the
+	// authoritative check happens in the transaction below
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, in.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := s.tenants.GetStorageUsage(ctx, in.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load storage usage: %w", err)
+	}
+	if err := limits.CheckStorage(usage.UsedStorageBytes, 1); err != nil {
+		return nil, err
+	}
+
+	// The MIME type comes from the content, not the client-supplied header
+	body := bufio.NewReaderSize(in.Body, sniffLength)
+	head, err := body.Peek(sniffLength)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, fmt.Errorf("failed to read photo: %w", err)
+	}
+	if len(head) == 0 {
+		return nil, domain.ErrEmptyPhoto
+	}
+	mimeType := http.DetectContentType(head)
+	ext, err := domain.PhotoExtension(mimeType)
+	if err != nil {
+		return nil, err
+	}
+
+	// The content is hashed while it streams so duplicates can be found
+	// without buffering the upload
+	maxSize := min(int64(domain.MaxPhotoSizeBytes), limits.MaxUploadBytes)
+	key := domain.OriginalObjectKey(in.TenantID, uuid.New(), ext)
+	hash := sha256.New()
+	size, err := s.store.Put(ctx, key, io.TeeReader(io.LimitReader(body, maxSize+1), hash), mimeType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store photo: %w", err)
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+	if err := limits.CheckUpload(size); err != nil {
+		s.deleteObject(key)
+		return nil, err
+	}
+	if size > domain.MaxPhotoSizeBytes {
+		s.deleteObject(key)
+		return nil, domain.ErrPhotoTooLarge
+	}
+
+	photo, duplicate, err := s.register(ctx, in, limits, storedOriginal{
+		Key:      key,
+		Size:     size,
+		MimeType: mimeType,
+		Digest:   digest,
+	}, inTx)
+	if err != nil || duplicate {
+		// Either nothing references the upload, or it duplicates a stored blob
+		s.deleteObject(key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return photo, nil
+}
+
+// storedOriginal is photo content already written to storage.
+type storedOriginal struct {
+	Key      string
+	Size     int64
+	MimeType string
+	Digest   string
+	// Reserved is how many bytes already count against the tenant's storage
+	// for this content, e.g. from an upload reservation.
+	Reserved int64
+}
+
+// register records the stored original as a file, appends it to the listing
+// and counts its bytes against the tenant and uploader in one transaction.
+// When the tenant already holds the same content the existing blob is
+// shared, any reserved bytes are released and duplicate is true; the caller
+// then deletes original.Key. A non-nil inTx runs in the transaction once the
+// photo is added.
+func (s *PhotoService) register(ctx context.Context, in UploadPhotoInput, limits *subscription.PlanLimits, original storedOriginal, inTx func(tx *sql.Tx, photo *domain.Photo) error) (photo *domain.Photo, duplicate bool, err error) {
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		// The listing lock serialises uploads into the listing, so the photo
+		// count cannot change until commit
+		if err := tenantrepo.NewListingRepository(tx).Lock(ctx, in.TenantID, in.ListingID); err != nil {
+			return err
+		}
+		photos := tenantrepo.NewPhotoRepository(tx)
+		count, err := photos.CountByListing(ctx, in.TenantID, in.ListingID)
+		if err != nil {
+			return fmt.Errorf("failed to count photos: %w", err)
+		}
+		if err := limits.CheckListingPhotos(count); err != nil {
+			return err
+		}
+
+		// Identical content already stored by the tenant is reused, and only
+		// new content counts against the storage quota
+		files := tenantrepo.NewFileRepository(tx)
+		blob, err := files.AcquireBlob(ctx, &domain.Blob{
+			TenantID:      in.TenantID,
+			ContentSHA256: original.Digest,
+			ObjectKey:     original.Key,
+			SizeBytes:     original.Size,
+			MimeType:      original.MimeType,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register photo content: %w", err)
+		}
+		duplicate = blob.ObjectKey != original.Key
+		storedBytes := original.Size
+		switch {
+		case duplicate:
+			storedBytes = 0
+			if original.Reserved > 0 {
+				if err := tenantrepo.NewTenantRepository(tx).ReleaseStorageUsage(ctx, in.TenantID, original.Reserved); err != nil {
+					return fmt.Errorf("failed to update storage usage: %w", err)
+				}
+			}
+		case original.Size > original.Reserved:
+			if err := reserveStorage(ctx, tx, in.TenantID, original.Size-original.Reserved, limits.MaxStorageBytes); err != nil {
+				return err
+			}
+		}
+
+		file, err := files.Create(ctx, &domain.File{
+			TenantID:      in.TenantID,
+			ListingID:     in.ListingID,
+			UserID:        in.UserID,
+			OriginalKey:   blob.ObjectKey,
+			SizeBytes:     blob.SizeBytes,
+			MimeType:      blob.MimeType,
+			ContentSHA256: &original.Digest,
+			Filename:      domain.CleanFilename(in.Filename),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create file: %w", err)
+		}
+
+		photo, err = photos.Add(ctx, file)
+		if err != nil {
+			return fmt.Errorf("failed to add listing photo: %w", err)
+		}
+		if inTx != nil {
+			if err := inTx(tx, photo); err != nil {
+				return err
+			}
+		}
+
+		if err := enqueuePhotoJob(ctx, tx, domain.JobPhotoMetadata, file); err != nil {
+			return err
+		}
+
+		if err := auditapp.NewUsageRecorder(tx).RecordUpload(ctx, in.TenantID, in.UserID, storedBytes); err != nil {
+			return err
+		}
+
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    in.TenantID,
+			PerformedBy: in.UserID,
+			EntityID:    file.ID,
+			EntityType:  audit.EntityFile,
+			Action:      audit.ActionCreate,
+			Data: map[string]any{
+				"listing_id":     in.ListingID,
+				"filename":       in.Filename,
+				"size_bytes":     original.Size,
+				"mime_type":      original.MimeType,
+				"content_sha256": original.Digest,
+				"deduplicated":   duplicate,
+			},
+		})
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return photo, duplicate, nil
+}
+
+// List returns the listing's photos in display order.
+func (s *PhotoService) List(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.Photo, error) {
+	if _, err := s.listings.Get(ctx, tenantID, listingID); err != nil {
+		return nil, err
+	}
+	photos, err := s.photos.ListByListing(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list photos: %w", err)
+	}
+	return photos, nil
+}
+
+// URLs presigns download links for the photo's original and derived objects.
+func (s *PhotoService) URLs(ctx context.Context, photo *domain.Photo) (PhotoURLs, error) {
+	var urls PhotoURLs
+	var err error
+	if urls.Original, err = s.store.PresignGet(ctx, photo.OriginalKey, downloadURLTTL); err != nil {
+		return PhotoURLs{}, fmt.Errorf("failed to presign photo url: %w", err)
+	}
+	if urls.Watermarked, err = s.presignOptional(ctx, photo.WatermarkedKey); err != nil {
+		return PhotoURLs{}, err
+	}
+	if urls.Thumbnail, err = s.presignOptional(ctx, photo.ThumbnailKey); err != nil {
+		return PhotoURLs{}, err
+	}
+	if urls.Variants, urls.Srcset, err = s.presignVariants(ctx, photo.Variants); err != nil {
+		return PhotoURLs{}, err
+	}
+	return urls, nil
+}
+
+// PublicURLs presigns download links for what people outside the tenant
+// see of the photo: its display rendition, thumbnail and variants. The
+// original is only linked when it is the display rendition.
+func (s *PhotoService) PublicURLs(ctx context.Context, photo *domain.Photo) (PublicPhotoURLs, error) {
+	var urls PublicPhotoURLs
+	var err error
+	if urls.Display, err = s.store.PresignGet(ctx, photo.DisplayKey(), downloadURLTTL); err != nil {
+		return PublicPhotoURLs{}, fmt.Errorf("failed to presign photo url: %w", err)
+	}
+	if urls.Thumbnail, err = s.presignOptional(ctx, photo.ThumbnailKey); err != nil {
+		return PublicPhotoURLs{}, err
+	}
+	if urls.Variants, urls.Srcset, err = s.presignVariants(ctx, photo.Variants); err != nil {
+		return PublicPhotoURLs{}, err
+	}
+	return urls, nil
+}
+
+func (s *PhotoService) presignVariants(ctx context.Context, variants []domain.Variant) ([]VariantURL, string, error) {
+	urls := make([]VariantURL, 0, len(variants))
+	srcset := make([]string, 0, len(variants))
+	for _, v := range variants {
+		u, err := s.store.PresignGet(ctx, v.ObjectKey, downloadURLTTL)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to presign photo url: %w", err)
+		}
+		urls = append(urls, VariantURL{Width: v.Width, Height: v.Height, URL: u})
+		srcset = append(srcset, fmt.Sprintf("%s %dw", u, v.Width))
+	}
+	return urls, strings.Join(srcset, ", "), nil
+}
+
+func (s *PhotoService) presignOptional(ctx context.Context, key *string) (*string, error) {
+	if key == nil {
+		return nil, nil
+	}
+	u, err := s.store.PresignGet(ctx, *key, downloadURLTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to presign photo url: %w", err)
+	}
+	return &u, nil
+}
+
+// SetCover makes the photo the listing's cover.
+func (s *PhotoService) SetCover(ctx context.Context, tenantID, listingID, photoID uuid.UUID) error {
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		photos := tenantrepo.NewPhotoRepository(tx)
+		if err := photos.Exists(ctx, tenantID, listingID, photoID); err != nil {
+			return err
+		}
+		if err := photos.SetCover(ctx, tenantID, listingID, photoID); err != nil {
+			return fmt.Errorf("failed to set cover photo: %w", err)
+		}
+		return nil
+	})
+}
+
+// Delete soft-deletes the photo from the listing.
+func (s *PhotoService) Delete(ctx context.Context, tenantID, listingID, photoID uuid.UUID) error {
+	return s.photos.SoftDelete(ctx, tenantID, listingID, photoID)
+}
+
+// Reorder sets the display order of the listing's photos. photoIDs must
+// name every live photo of the listing exactly once, or
+// domain.ErrInvalidPhotoOrder is returned.
+func (s *PhotoService) Reorder(ctx context.Context, tenantID, listingID uuid.UUID, photoIDs []uuid.UUID) error {
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := tenantrepo.NewListingRepository(tx).Lock(ctx, tenantID, listingID); err != nil {
+			return err
+		}
+		photos := tenantrepo.NewPhotoRepository(tx)
+		live, err := photos.IDs(ctx, tenantID, listingID)
+		if err != nil {
+			return fmt.Errorf("failed to list photos: %w", err)
+		}
+		if !samePhotos(live, photoIDs) {
+			return domain.ErrInvalidPhotoOrder
+		}
+		if err := photos.Reorder(ctx, tenantID, listingID, photoIDs); err != nil {
+			return fmt.Errorf("failed to reorder photos: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetPublished publishes or unpublishes the listing's photos. Nothing
+// changes unless every photo is live in the listing.
+func (s *PhotoService) SetPublished(ctx context.Context, tenantID, listingID uuid.UUID, photoIDs []uuid.UUID, published bool) error {
+	if err := checkPhotoSelection(photoIDs); err != nil {
+		return err
+	}
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		updated, err := tenantrepo.NewPhotoRepository(tx).SetPublished(ctx, tenantID, listingID, photoIDs, published)
+		if err != nil {
+			return fmt.Errorf("failed to update photos: %w", err)
+		}
+		if len(updated) != len(photoIDs) {
+			return domain.ErrPhotoNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteMany soft-deletes the listing's photos. Nothing changes unless every
+// photo is live in the listing. If the cover goes, the first remaining photo
+// takes its place.
+func (s *PhotoService) DeleteMany(ctx context.Context, tenantID, listingID uuid.UUID, photoIDs []uuid.UUID) error {
+	if err := checkPhotoSelection(photoIDs); err != nil {
+		return err
+	}
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := tenantrepo.NewListingRepository(tx).Lock(ctx, tenantID, listingID); err != nil {
+			return err
+		}
+		photos := tenantrepo.NewPhotoRepository(tx)
+		deleted, err := photos.SoftDeleteMany(ctx, tenantID, listingID, photoIDs)
+		if err != nil {
+			return fmt.Errorf("failed to delete photos: %w", err)
+		}
+		if len(deleted) != len(photoIDs) {
+			return domain.ErrPhotoNotFound
+		}
+		if err := photos.EnsureCover(ctx, tenantID, listingID); err != nil {
+			return fmt.Errorf("failed to set cover photo: %w", err)
+		}
+		return nil
+	})
+}
+
+// Move transfers the listing's photos, in the given order, to the end of
+// another listing of the tenant. Their files go with them, so they outlive
+// the listing they were uploaded to.
+func (s *PhotoService) Move(ctx context.Context, tenantID, listingID, targetListingID uuid.UUID, photoIDs []uuid.UUID) error {
+	return s.transfer(ctx, tenantID, listingID, targetListingID, photoIDs, true)
+}
+
+// Copy adds the listing's photos, in the given order, to the end of another
+// listing of the tenant. The copies share the originals' files, so they take
+// no further storage.
+func (s *PhotoService) Copy(ctx context.Context, tenantID, listingID, targetListingID uuid.UUID, photoIDs []uuid.UUID) error {
+	return s.transfer(ctx, tenantID, listingID, targetListingID, photoIDs, false)
+}
+
+// transfer implements Move and Copy. The target listing's photo quota is
+// checked for all photos up front, and nothing changes unless every photo
+// is live in the source listing and not yet in the target.
+func (s *PhotoService) transfer(ctx context.Context, tenantID, listingID, targetListingID uuid.UUID, photoIDs []uuid.UUID, move bool) error {
+	if err := checkPhotoSelection(photoIDs); err != nil {
+		return err
+	}
+	if listingID == targetListingID {
+		return domain.ErrSameListing
+	}
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		// Locked in a fixed order so that opposite transfers between the same
+		// listings cannot deadlock
+		first, second := listingID, targetListingID
+		if bytes.Compare(first[:], second[:]) > 0 {
+			first, second = second, first
+		}
+		listings := tenantrepo.NewListingRepository(tx)
+		if err := listings.Lock(ctx, tenantID, first); err != nil {
+			return err
+		}
+		if err := listings.Lock(ctx, tenantID, second); err != nil {
+			return err
+		}
+
+		photos := tenantrepo.NewPhotoRepository(tx)
+		count, err := photos.CountByListing(ctx, tenantID, targetListingID)
+		if err != nil {
+			return fmt.Errorf("failed to count photos: %w", err)
+		}
+		if err := limits.CheckAddListingPhotos(count, int64(len(photoIDs))); err != nil {
+			return err
+		}
+
+		for _, photoID := range photoIDs {
+			if move {
+				err = photos.Move(ctx, tenantID, listingID, targetListingID, photoID)
+			} else {
+				err = photos.Copy(ctx, tenantID, listingID, targetListingID, photoID)
+			}
+			if _, ok := postgres.UniqueViolation(err); ok {
+				return domain.ErrPhotoInListing
+			}
+			if errors.Is(err, domain.ErrPhotoNotFound) {
+				return err
+			}
+			if err != nil {
+				return fmt.Errorf("failed to transfer photo %s: %w", photoID, err)
+			}
+		}
+
+		if err := photos.EnsureCover(ctx, tenantID, targetListingID); err != nil {
+			return fmt.Errorf("failed to set cover photo: %w", err)
+		}
+		if move {
+			if err := photos.EnsureCover(ctx, tenantID, listingID); err != nil {
+				return fmt.Errorf("failed to set cover photo: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// checkPhotoSelection validates the photos named by a bulk operation.
+func checkPhotoSelection(photoIDs []uuid.UUID) error {
+	switch {
+	case len(photoIDs) == 0:
+		return domain.ErrNoPhotosSelected
+	case len(photoIDs) > domain.MaxBulkPhotos:
+		return domain.ErrTooManyPhotos
+	}
+	seen := make(map[uuid.UUID]struct{}, len(photoIDs))
+	for _, id := range photoIDs {
+		if _, ok := seen[id]; ok {
+			return domain.ErrDuplicatePhoto
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+// samePhotos reports whether order names each of live exactly once.
+func samePhotos(live, order []uuid.UUID) bool {
+	if len(live) != len(order) {
+		return false
+	}
+	pending := make(map[uuid.UUID]struct{}, len(live))
+	for _, id := range live {
+		pending[id] = struct{}{}
+	}
+	for _, id := range order {
+		if _, ok := pending[id]; !ok {
+			return false
+		}
+		delete(pending, id)
+	}
+	return true
+}
+
+// reserveStorage atomically adds size bytes to the tenant's usage, or returns
+// a QuotaExceededError with the current usage if that would pass maxBytes.
+func reserveStorage(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID, size, maxBytes int64) error {
+	tenants := tenantrepo.NewTenantRepository(tx)
+	_, ok, err := tenants.AddStorageUsageWithinLimit(ctx, tenantID, size, maxBytes)
+	if err != nil {
+		return fmt.Errorf("failed to update storage usage: %w", err)
+	}
+	if ok {
+		return nil
+	}
+	usage, err := tenants.GetStorageUsage(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to load storage usage: %w", err)
+	}
+	return &subscription.QuotaExceededError{
+		Resource: subscription.QuotaStorage,
+		Usage:    usage.UsedStorageBytes,
+		Limit:    maxBytes,
+	}
+}
+
+// deleteObject removes an object that never made it into the database. It
+// runs detached from the request context, which may already be cancelled.
+func (s *PhotoService) deleteObject(key string) {
+	if err := s.store.Delete(context.Background(), key); err != nil {
+		log.Printf("photo upload: failed to remove orphaned object %s: %v", key, err)
+	}
+}
@@ -0,0 +1,217 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	subscription "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres/postgrestest"
+	"github.com/google/uuid"
+)
+
+// fillStorage counts used bytes against the tenant's storage.
+func fillStorage(t *testing.T, db *sql.DB, tenantID uuid.UUID, used int64) {
+	t.Helper()
+	_, ok, err := tenantrepo.NewTenantRepository(db).AddStorageUsageWithinLimit(context.Background(), tenantID, used, used)
+	if err != nil || !ok {
+		t.Fatalf("fill storage: ok %v, err %v", ok, err)
+	}
+}
+
+func storageUsed(t *testing.T, db *sql.DB, tenantID uuid.UUID) int64 {
+	t.Helper()
+	usage, err := tenantrepo.NewTenantRepository(db).GetStorageUsage(context.Background(), tenantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return usage.UsedStorageBytes
+}
+
+func TestReserveStorage(t *testing.T) {
+	db := postgrestest.Open(t)
+	ctx := context.Background()
+	listing := createListing(t, db)
+	const maxBytes = 1000
+	fillStorage(t, db, listing.TenantID, 990)
+
+	// Cases run in order against the same usage
+	tests := []struct {
+		name      string
+		size      int64
+		wantQuota bool
+		wantUsed  int64
+	}{
+		{name: "over quota", size: 11, wantQuota: true, wantUsed: 990},
+		{name: "up to quota", size: 10, wantUsed: 1000},
+		{name: "at quota", size: 1, wantQuota: true, wantUsed: 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := postgres.WithTx(ctx, db, func(tx *sql.Tx) error {
+				return reserveStorage(ctx, tx, listing.TenantID, tt.size, maxBytes)
+			})
+			var quota *subscription.QuotaExceededError
+			if got := errors.As(err, &quota); got != tt.wantQuota {
+				t.Fatalf("reserveStorage error = %v, want quota error %v", err, tt.wantQuota)
+			}
+			if quota != nil && (quota.Resource != subscription.QuotaStorage || quota.Usage != tt.wantUsed || quota.Limit != maxBytes) {
+				t.Errorf("quota error = %+v, want storage usage %d of %d", quota, tt.wantUsed, maxBytes)
+			}
+			if used := storageUsed(t, db, listing.TenantID); used != tt.wantUsed {
+				t.Errorf("used storage = %d, want %d", used, tt.wantUsed)
+			}
+		})
+	}
+}
+
+func TestPhotoUploadOverStorageQuota(t *testing.T) {
+	db := postgrestest.Open(t)
+	ctx := context.Background()
+	listing := createListing(t, db)
+	store, root := newTestStore(t)
+	photo := testPNG(t, 1)
+
+	// One byte short of fitting, so only the reservation in the upload's
+	// transaction catches it
+	limits, err := subscriptionapp.NewQuotaResolver(db).Limits(ctx, listing.TenantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	used := limits.MaxStorageBytes - int64(len(photo)) + 1
+	fillStorage(t, db, listing.TenantID, used)
+
+	_, err = NewPhotoService(db, store).Upload(ctx, UploadPhotoInput{
+		TenantID:  listing.TenantID,
+		ListingID: listing.ID,
+		UserID:    listing.UserID,
+		Filename:  "photo.png",
+		Body:      bytes.NewReader(photo),
+	})
+	var quota *subscription.QuotaExceededError
+	if !errors.As(err, &quota) || quota.Resource != subscription.QuotaStorage {
+		t.Fatalf("Upload error = %v, want storage quota error", err)
+	}
+
+	if got := storageUsed(t, db, listing.TenantID); got != used {
+		t.Errorf("used storage = %d, want %d", got, used)
+	}
+	photos, err := tenantrepo.NewPhotoRepository(db).ListByListing(ctx, listing.TenantID, listing.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(photos) != 0 {
+		t.Errorf("listing has %d photos, want none", len(photos))
+	}
+	if n := storedObjects(t, root); n != 0 {
+		t.Errorf("%d objects left in storage, want none", n)
+	}
+}
+
+// blobRef is a tenant's stored original and how many files share it.
+type blobRef struct {
+	objectKey string
+	refCount  int
+}
+
+func tenantBlobs(t *testing.T, db *sql.DB, tenantID uuid.UUID) []blobRef {
+	t.Helper()
+	rows, err := db.Query(`SELECT object_key, ref_count FROM file_blobs WHERE tenant_id = $1`, tenantID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var blobs []blobRef
+	for rows.Next() {
+		var b blobRef
+		if err := rows.Scan(&b.objectKey, &b.refCount); err != nil {
+			t.Fatal(err)
+		}
+		blobs = append(blobs, b)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return blobs
+}
+
+func TestPhotoUploadSharesDuplicateContent(t *testing.T) {
+	db := postgrestest.Open(t)
+	ctx := context.Background()
+	first := createListing(t, db)
+	second := addListing(t, db, first.TenantID, first.UserID)
+	store, root := newTestStore(t)
+	photos := NewPhotoService(db, store)
+	content := testPNG(t, 2)
+
+	var uploaded []*domain.Photo
+	for _, listing := range []*domain.Listing{first, second} {
+		photo, err := photos.Upload(ctx, UploadPhotoInput{
+			TenantID:  listing.TenantID,
+			ListingID: listing.ID,
+			UserID:    listing.UserID,
+			Filename:  "photo.png",
+			Body:      bytes.NewReader(content),
+		})
+		if err != nil {
+			t.Fatalf("Upload into %s: %v", listing.ID, err)
+		}
+		uploaded = append(uploaded, photo)
+	}
+
+	blobs := tenantBlobs(t, db, first.TenantID)
+	if len(blobs) != 1 || blobs[0].refCount != 2 {
+		t.Fatalf("blobs = %+v, want one with 2 references", blobs)
+	}
+	if n := storedObjects(t, root); n != 1 {
+		t.Errorf("%d objects stored, want 1", n)
+	}
+	if used := storageUsed(t, db, first.TenantID); used != int64(len(content)) {
+		t.Errorf("used storage = %d, want %d counted once", used, len(content))
+	}
+
+	// Purging one photo drops a reference and keeps the shared object
+	trash := NewTrashService(db, store)
+	cutoff := time.Now().Add(time.Minute)
+	for i, listing := range []*domain.Listing{first, second} {
+		if err := photos.Delete(ctx, listing.TenantID, listing.ID, uploaded[i].ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+	}
+	keys, err := trash.purgePhoto(ctx, domain.PurgeCandidate{TenantID: first.TenantID, ID: uploaded[0].ID}, cutoff)
+	if err != nil {
+		t.Fatalf("purge first photo: %v", err)
+	}
+	if slices.Contains(keys, blobs[0].objectKey) {
+		t.Errorf("purging one of two references released the object, keys %v", keys)
+	}
+	if got := tenantBlobs(t, db, first.TenantID); len(got) != 1 || got[0].refCount != 1 {
+		t.Errorf("blobs = %+v, want one with 1 reference", got)
+	}
+	if used := storageUsed(t, db, first.TenantID); used != int64(len(content)) {
+		t.Errorf("used storage = %d, want %d", used, len(content))
+	}
+
+	// Purging the last reference releases the object and its bytes
+	keys, err = trash.purgePhoto(ctx, domain.PurgeCandidate{TenantID: first.TenantID, ID: uploaded[1].ID}, cutoff)
+	if err != nil {
+		t.Fatalf("purge second photo: %v", err)
+	}
+	if !slices.Contains(keys, blobs[0].objectKey) {
+		t.Errorf("purging the last reference kept the object, keys %v", keys)
+	}
+	if got := tenantBlobs(t, db, first.TenantID); len(got) != 0 {
+		t.Errorf("blobs = %+v, want none", got)
+	}
+	if used := storageUsed(t, db, first.TenantID); used != 0 {
+		t.Errorf("used storage = %d, want 0", used)
+	}
+}
@@ -1 +1,358 @@
-package application
\ No newline at end of file
+package application
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	jobsapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/application"
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/imaging"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// TenantService manages a tenant's profile, settings and members.
+type TenantService struct {
+	db      *sql.DB
+	store   storage.Backend
+	tenants *tenantrepo.TenantRepository
+}
+
+// NewTenantService creates a TenantService that keeps branding assets in store.
+func NewTenantService(db *sql.DB, store storage.Backend) *TenantService {
+	return &TenantService{db: db, store: store, tenants: tenantrepo.NewTenantRepository(db)}
+}
+
+// Get returns the tenant.
+func (s *TenantService) Get(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	return s.tenants.Get(ctx, tenantID)
+}
+
+// Rename changes the tenant's name. A name used by another tenant returns domain.ErrTenantNameTaken.
+func (s *TenantService) Rename(ctx context.Context, tenantID, actorID uuid.UUID, name string) (*domain.Tenant, error) {
+	renamed, err := domain.NewTenant(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *domain.Tenant
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		updated, err = tenantrepo.NewTenantRepository(tx).Rename(ctx, tenantID, renamed.Name)
+		if err != nil {
+			if _, ok := postgres.UniqueViolation(err); ok {
+				return domain.ErrTenantNameTaken
+			}
+			return fmt.Errorf("failed to rename tenant: %w", err)
+		}
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    tenantID,
+			PerformedBy: actorID,
+			EntityID:    tenantID,
+			EntityType:  audit.EntityTenant,
+			Action:      audit.ActionUpdate,
+			Data:        map[string]any{"name": updated.Name},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// GetSettings returns the tenant's settings.
+func (s *TenantService) GetSettings(ctx context.Context, tenantID uuid.UUID) (*domain.Settings, error) {
+	settings, err := s.tenants.GetSettings(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tenant settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdateSettings validates and stores the tenant's settings. A change to how
+// watermarks look or to GPS stripping queues re-rendering of all the
+// tenant's photos.
+func (s *TenantService) UpdateSettings(ctx context.Context, actorID uuid.UUID, settings *domain.Settings) (*domain.Settings, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	var updated *domain.Settings
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		tenants := tenantrepo.NewTenantRepository(tx)
+		previous, err := tenants.GetSettings(ctx, settings.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to load tenant settings: %w", err)
+		}
+		updated, err = tenants.UpdateSettings(ctx, settings)
+		if err != nil {
+			return fmt.Errorf("failed to update tenant settings: %w", err)
+		}
+		if updated.RenditionsChanged(previous) {
+			if err := enqueueRewatermark(ctx, tx, settings.TenantID); err != nil {
+				return err
+			}
+		}
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    settings.TenantID,
+			PerformedBy: actorID,
+			EntityID:    settings.TenantID,
+			EntityType:  audit.EntityTenant,
+			Action:      audit.ActionUpdate,
+			Data: map[string]any{
+				"theme":              updated.Theme,
+				"watermark_enabled":  updated.WatermarkEnabled,
+				"watermark_text":     updated.WatermarkText,
+				"watermark_type":     updated.WatermarkType,
+				"watermark_position": updated.WatermarkPosition,
+				"watermark_opacity":  updated.WatermarkOpacity,
+				"watermark_scale":    updated.WatermarkScale,
+				"watermark_tiled":    updated.WatermarkTiled,
+				"strip_gps":          updated.StripGPS,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// SetWatermarkImage stores body as the tenant's watermark logo, replacing any
+// previous one. Photos are re-watermarked if the logo is in use.
+func (s *TenantService) SetWatermarkImage(ctx context.Context, tenantID, actorID uuid.UUID, body io.Reader) (*domain.Settings, error) {
+	data, err := io.ReadAll(io.LimitReader(body, domain.MaxWatermarkImageBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read watermark image: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, domain.ErrEmptyWatermarkImage
+	}
+	if len(data) > domain.MaxWatermarkImageBytes {
+		return nil, domain.ErrWatermarkImageTooLarge
+	}
+	mimeType := http.DetectContentType(data[:min(len(data), sniffLength)])
+	ext, err := domain.PhotoExtension(mimeType)
+	if err != nil {
+		return nil, err
+	}
+	// Reject undecodable logos now rather than failing every watermark job later
+	if _, err := imaging.Decode(data); err != nil {
+		return nil, domain.ErrUnsupportedPhotoType
+	}
+
+	key := domain.WatermarkImageObjectKey(tenantID, uuid.New(), ext)
+	if _, err := s.store.Put(ctx, key, bytes.NewReader(data), mimeType); err != nil {
+		return nil, fmt.Errorf("failed to store watermark image: %w", err)
+	}
+
+	updated, previous, err := s.setWatermarkImage(ctx, tenantID, actorID, &key)
+	if err != nil {
+		s.deleteObject(key)
+		return nil, err
+	}
+	if previous.WatermarkImageKey != nil {
+		s.deleteObject(*previous.WatermarkImageKey)
+	}
+	return updated, nil
+}
+
+// RemoveWatermarkImage deletes the tenant's watermark logo. It fails with
+// domain.ErrWatermarkImageRequired while image watermarks are enabled.
+func (s *TenantService) RemoveWatermarkImage(ctx context.Context, tenantID, actorID uuid.UUID) (*domain.Settings, error) {
+	updated, previous, err := s.setWatermarkImage(ctx, tenantID, actorID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if previous.WatermarkImageKey != nil {
+		s.deleteObject(*previous.WatermarkImageKey)
+	}
+	return updated, nil
+}
+
+func (s *TenantService) setWatermarkImage(ctx context.Context, tenantID, actorID uuid.UUID, key *string) (updated, previous *domain.Settings, err error) {
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		tenants := tenantrepo.NewTenantRepository(tx)
+		if previous, err = tenants.GetSettings(ctx, tenantID); err != nil {
+			return fmt.Errorf("failed to load tenant settings: %w", err)
+		}
+		changed := *previous
+		changed.WatermarkImageKey = key
+		if err := changed.Validate(); err != nil {
+			return err
+		}
+
+		if updated, err = tenants.SetWatermarkImage(ctx, tenantID, key); err != nil {
+			return fmt.Errorf("failed to update watermark image: %w", err)
+		}
+		if updated.WatermarkChanged(previous) {
+			if err := enqueueRewatermark(ctx, tx, tenantID); err != nil {
+				return err
+			}
+		}
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    tenantID,
+			PerformedBy: actorID,
+			EntityID:    tenantID,
+			EntityType:  audit.EntityTenant,
+			Action:      audit.ActionUpdate,
+			Data:        map[string]any{"watermark_image": key != nil},
+		})
+	})
+	return updated, previous, err
+}
+
+// deleteObject removes a branding object that is no longer referenced.
+func (s *TenantService) deleteObject(key string) {
+	if err := s.store.Delete(context.Background(), key); err != nil {
+		log.Printf("tenant settings: failed to remove object %s: %v", key, err)
+	}
+}
+
+// enqueueRewatermark queues re-rendering of every photo of the tenant. A
+// re-watermark that has not started yet absorbs the new one.
+func enqueueRewatermark(ctx context.Context, tx *sql.Tx, tenantID uuid.UUID) error {
+	job, err := jobs.NewJob(tenantID, domain.JobRewatermarkTenant, nil)
+	if err != nil {
+		return err
+	}
+	job.UniqueKey = domain.JobRewatermarkTenant + ":" + tenantID.String()
+	return jobsapp.NewEnqueuer(tx).EnqueueJob(ctx, job)
+}
+
+// GetStorageUsage returns how many bytes the tenant stores.
+func (s *TenantService) GetStorageUsage(ctx context.Context, tenantID uuid.UUID) (*domain.StorageUsage, error) {
+	usage, err := s.tenants.GetStorageUsage(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load storage usage: %w", err)
+	}
+	return usage, nil
+}
+
+// ListMembers returns the tenant's members.
+func (s *TenantService) ListMembers(ctx context.Context, tenantID uuid.UUID) ([]domain.Member, error) {
+	members, err := s.tenants.ListMembers(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+	return members, nil
+}
+
+// GetMember returns a member of the tenant, or domain.ErrNotMember.
+func (s *TenantService) GetMember(ctx context.Context, tenantID, userID uuid.UUID) (*domain.Member, error) {
+	return s.tenants.GetMember(ctx, tenantID, userID)
+}
+
+// ChangeMemberRole assigns a new role to another member. The tenant always
+// keeps at least one tenant admin, and super_admin cannot be granted here.
+func (s *TenantService) ChangeMemberRole(ctx context.Context, tenantID, actorID, userID uuid.UUID, role string) (*domain.Member, error) {
+	if err := authdomain.ValidateRole(role); err != nil {
+		return nil, err
+	}
+	if role == authdomain.RoleSuperAdmin {
+		return nil, domain.ErrRoleNotAssignable
+	}
+	if actorID == userID {
+		return nil, domain.ErrCannotChangeOwnRole
+	}
+
+	var updated *domain.Member
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		tenants := tenantrepo.NewTenantRepository(tx)
+		member, err := lockMember(ctx, tenants, tenantID, userID)
+		if err != nil {
+			return err
+		}
+		if member.Role == role {
+			updated = member
+			return nil
+		}
+		if err := ensureOtherAdmin(ctx, tenants, tenantID, member); err != nil {
+			return err
+		}
+
+		if err := tenants.UpdateMemberRole(ctx, tenantID, userID, role); err != nil {
+			return err
+		}
+		if updated, err = tenants.GetMember(ctx, tenantID, userID); err != nil {
+			return err
+		}
+
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    tenantID,
+			PerformedBy: actorID,
+			EntityID:    userID,
+			EntityType:  audit.EntityUser,
+			Action:      audit.ActionUpdate,
+			Data:        map[string]any{"role": role, "previous_role": member.Role},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// RemoveMember removes another member from the tenant. The last tenant admin cannot be removed.
+func (s *TenantService) RemoveMember(ctx context.Context, tenantID, actorID, userID uuid.UUID) error {
+	if actorID == userID {
+		return domain.ErrCannotChangeOwnRole
+	}
+
+	return postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		tenants := tenantrepo.NewTenantRepository(tx)
+		member, err := lockMember(ctx, tenants, tenantID, userID)
+		if err != nil {
+			return err
+		}
+		if err := ensureOtherAdmin(ctx, tenants, tenantID, member); err != nil {
+			return err
+		}
+
+		if err := tenants.RemoveMember(ctx, tenantID, userID); err != nil {
+			return fmt.Errorf("failed to remove member: %w", err)
+		}
+
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:    tenantID,
+			PerformedBy: actorID,
+			EntityID:    userID,
+			EntityType:  audit.EntityUser,
+			Action:      audit.ActionDelete,
+			Data:        map[string]any{"role": member.Role},
+		})
+	})
+}
+
+// lockMember serializes membership changes in the tenant and loads the member.
+func lockMember(ctx context.Context, tenants *tenantrepo.TenantRepository, tenantID, userID uuid.UUID) (*domain.Member, error) {
+	if err := tenants.Lock(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to lock tenant: %w", err)
+	}
+	return tenants.GetMember(ctx, tenantID, userID)
+}
+
+// ensureOtherAdmin returns domain.ErrLastAdmin if member is the tenant's only tenant admin.
+func ensureOtherAdmin(ctx context.Context, tenants *tenantrepo.TenantRepository, tenantID uuid.UUID, member *domain.Member) error {
+	if member.Role != authdomain.RoleTenantAdmin {
+		return nil
+	}
+	admins, err := tenants.CountMembersWithRole(ctx, tenantID, authdomain.RoleTenantAdmin)
+	if err != nil {
+		return fmt.Errorf("failed to count tenant admins: %w", err)
+	}
+	if admins <= 1 {
+		return domain.ErrLastAdmin
+	}
+	return nil
+}
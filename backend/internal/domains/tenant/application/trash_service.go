@@ -0,0 +1,331 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// purgeBatchSize is how many purgeable listings or photos one query fetches.
+const purgeBatchSize = 100
+
+// TrashService lists and restores deleted listings and photos, and purges
+// them for good once they have been deleted for domain.TrashRetention.
+type TrashService struct {
+	db       *sql.DB
+	store    storage.Backend
+	listings *tenantrepo.ListingRepository
+	photos   *tenantrepo.PhotoRepository
+}
+
+// NewTrashService creates a TrashService for objects in store.
+func NewTrashService(db *sql.DB, store storage.Backend) *TrashService {
+	return &TrashService{
+		db:       db,
+		store:    store,
+		listings: tenantrepo.NewListingRepository(db),
+		photos:   tenantrepo.NewPhotoRepository(db),
+	}
+}
+
+// ListListings returns a page of the tenant's deleted listings, most
+// recently deleted first.
+func (s *TrashService) ListListings(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]domain.Listing, error) {
+	listings, err := s.listings.ListTrashed(ctx, tenantID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted listings: %w", err)
+	}
+	return listings, nil
+}
+
+// ListPhotos returns a page of the tenant's deleted photos in live
+// listings, most recently deleted first.
+func (s *TrashService) ListPhotos(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]domain.TrashedPhoto, error) {
+	photos, err := s.photos.ListTrashed(ctx, tenantID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deleted photos: %w", err)
+	}
+	return photos, nil
+}
+
+// PreviewURL presigns a download link for the deleted photo's thumbnail,
+// or its original when no thumbnail was made.
+func (s *TrashService) PreviewURL(ctx context.Context, photo *domain.TrashedPhoto) (string, error) {
+	key := photo.OriginalKey
+	if photo.ThumbnailKey != nil {
+		key = *photo.ThumbnailKey
+	}
+	url, err := s.store.PresignGet(ctx, key, downloadURLTTL)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign photo url: %w", err)
+	}
+	return url, nil
+}
+
+// RestoreListing takes the listing out of the trash, provided the tenant's
+// plan has room for another listing. Photos deleted on their own before the
+// listing stay in the trash.
+func (s *TrashService) RestoreListing(ctx context.Context, tenantID, actorID, listingID uuid.UUID) (*domain.Listing, error) {
+	var restored *domain.Listing
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		// The tenant lock serialises restores with listing creation, as in
+		// ListingService.Create
+		if err := tenantrepo.NewTenantRepository(tx).Lock(ctx, tenantID); err != nil {
+			return fmt.Errorf("failed to lock tenant: %w", err)
+		}
+		limits, err := subscriptionapp.NewQuotaResolver(tx).Limits(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		listings := tenantrepo.NewListingRepository(tx)
+		count, err := listings.CountByTenant(ctx, tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to count listings: %w", err)
+		}
+		if err := limits.CheckListings(count); err != nil {
+			return err
+		}
+
+		restored, err = listings.Restore(ctx, tenantID, listingID)
+		if err != nil {
+			return err
+		}
+		return logListingEvent(ctx, tx, restored, actorID, audit.ActionUpdate, map[string]any{"restored": true})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// RestorePhoto takes the photo out of the trash, back at its old position,
+// provided its listing is live and has room for another photo. It returns
+// the photo's listing ID.
+func (s *TrashService) RestorePhoto(ctx context.Context, tenantID, photoID uuid.UUID) (uuid.UUID, error) {
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, tenantID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var listingID uuid.UUID
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		photos := tenantrepo.NewPhotoRepository(tx)
+		var err error
+		listingID, err = photos.TrashedListing(ctx, tenantID, photoID)
+		if err != nil {
+			return err
+		}
+		if err := tenantrepo.NewListingRepository(tx).Lock(ctx, tenantID, listingID); err != nil {
+			return err
+		}
+		count, err := photos.CountByListing(ctx, tenantID, listingID)
+		if err != nil {
+			return fmt.Errorf("failed to count photos: %w", err)
+		}
+		if err := limits.CheckListingPhotos(count); err != nil {
+			return err
+		}
+
+		if err := photos.Restore(ctx, tenantID, photoID); err != nil {
+			return err
+		}
+		if err := photos.EnsureCover(ctx, tenantID, listingID); err != nil {
+			return fmt.Errorf("failed to set cover photo: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return listingID, nil
+}
+
+// Purge handles domain.JobPurgeTrash. Photos, then listings, deleted more
+// than domain.TrashRetention ago are removed for good. A file goes with
+// them once no other photo shows it, and its objects are deleted once no
+// other file shares them; the freed bytes come off the tenant's storage
+// usage and the uploader's statistics.
+func (s *TrashService) Purge(ctx context.Context, _ *jobs.Job, _ struct{}) error {
+	cutoff := time.Now().Add(-domain.TrashRetention)
+	if err := s.purgeAll(ctx, cutoff, s.photos.ListPurgeable, s.purgePhoto); err != nil {
+		return fmt.Errorf("failed to purge photos: %w", err)
+	}
+	if err := s.purgeAll(ctx, cutoff, s.listings.ListPurgeable, s.purgeListing); err != nil {
+		return fmt.Errorf("failed to purge listings: %w", err)
+	}
+	return nil
+}
+
+// purgeAll purges everything list returns, a batch at a time, deleting the
+// objects each purge releases. Items restored or purged since they were
+// listed are skipped.
+func (s *TrashService) purgeAll(
+	ctx context.Context,
+	cutoff time.Time,
+	list func(ctx context.Context, deletedBefore time.Time, limit int32) ([]domain.PurgeCandidate, error),
+	purge func(ctx context.Context, item domain.PurgeCandidate, cutoff time.Time) ([]string, error),
+) error {
+	for {
+		items, err := list(ctx, cutoff, purgeBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			keys, err := purge(ctx, item, cutoff)
+			if errors.Is(err, domain.ErrPhotoNotFound) || errors.Is(err, domain.ErrListingNotFound) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("%s: %w", item.ID, err)
+			}
+			for _, key := range keys {
+				if err := s.store.Delete(ctx, key); err != nil {
+					log.Printf("trash purge: failed to remove object %s: %v", key, err)
+				}
+			}
+		}
+		if len(items) < purgeBatchSize {
+			return nil
+		}
+	}
+}
+
+// purgePhoto removes the photo, and its file if no other photo shows it,
+// returning the object keys to delete.
+func (s *TrashService) purgePhoto(ctx context.Context, photo domain.PurgeCandidate, cutoff time.Time) ([]string, error) {
+	var keys []string
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		fileID, err := tenantrepo.NewPhotoRepository(tx).Purge(ctx, photo.TenantID, photo.ID, cutoff)
+		if err != nil {
+			return err
+		}
+		shown, err := tenantrepo.NewFileRepository(tx).HasPhotos(ctx, photo.TenantID, fileID)
+		if err != nil || shown {
+			return err
+		}
+		keys, err = purgeFile(ctx, tx, photo.TenantID, fileID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// purgeListing removes the listing with its photos, share links and
+// download archives, returning the object keys to delete. Files that photos
+// in other listings still show are handed over to one of those listings and
+// kept.
+func (s *TrashService) purgeListing(ctx context.Context, listing domain.PurgeCandidate, cutoff time.Time) ([]string, error) {
+	var keys []string
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		listings := tenantrepo.NewListingRepository(tx)
+		if err := listings.LockPurgeable(ctx, listing.TenantID, listing.ID, cutoff); err != nil {
+			return err
+		}
+		files := tenantrepo.NewFileRepository(tx)
+		if err := files.ReassignShared(ctx, listing.TenantID, listing.ID); err != nil {
+			return fmt.Errorf("failed to keep shared files: %w", err)
+		}
+		owned, err := files.ListByListing(ctx, listing.TenantID, listing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		for _, file := range owned {
+			fileKeys, err := purgeFile(ctx, tx, listing.TenantID, file.ID)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, fileKeys...)
+		}
+		archiveKeys, err := tenantrepo.NewArchiveRepository(tx).ListObjectKeysByListing(ctx, listing.TenantID, listing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list archives: %w", err)
+		}
+		keys = append(keys, archiveKeys...)
+
+		if err := listings.Delete(ctx, listing.TenantID, listing.ID); err != nil {
+			return fmt.Errorf("failed to delete listing: %w", err)
+		}
+		return auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+			TenantID:   listing.TenantID,
+			EntityID:   listing.ID,
+			EntityType: audit.EntityListing,
+			Action:     audit.ActionDelete,
+			Data:       map[string]any{"purged": true, "files": len(owned)},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// purgeFile deletes the file with its variants and metadata in the caller's
+// transaction and returns the object keys to remove once it commits. The
+// original only goes when no other file shares its blob; only then are its
+// bytes taken off the tenant's storage usage and the uploader's statistics.
+func purgeFile(ctx context.Context, tx *sql.Tx, tenantID, fileID uuid.UUID) ([]string, error) {
+	files := tenantrepo.NewFileRepository(tx)
+	file, keys, err := files.Delete(ctx, tenantID, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete file %s: %w", fileID, err)
+	}
+	for _, key := range []*string{file.WatermarkedKey, file.ThumbnailKey} {
+		if key != nil {
+			keys = append(keys, *key)
+		}
+	}
+
+	var freed int64
+	if file.ContentSHA256 == nil {
+		// Uploaded before deduplication, so the file owns its original
+		freed = file.SizeBytes
+		keys = append(keys, file.OriginalKey)
+	} else {
+		blob, err := files.ReleaseBlob(ctx, tenantID, *file.ContentSHA256)
+		if err != nil {
+			return nil, fmt.Errorf("failed to release photo content: %w", err)
+		}
+		if blob.Unreferenced() {
+			freed = blob.SizeBytes
+			keys = append(keys, blob.ObjectKey)
+		}
+	}
+
+	if freed > 0 {
+		if err := tenantrepo.NewTenantRepository(tx).ReleaseStorageUsage(ctx, tenantID, freed); err != nil {
+			return nil, fmt.Errorf("failed to update storage usage: %w", err)
+		}
+	}
+	if err := auditapp.NewUsageRecorder(tx).RecordPurge(ctx, tenantID, file.UserID, freed); err != nil {
+		return nil, err
+	}
+	if err := auditapp.NewEventLogger(tx).Log(ctx, audit.Event{
+		TenantID:   tenantID,
+		EntityID:   file.ID,
+		EntityType: audit.EntityFile,
+		Action:     audit.ActionDelete,
+		Data: map[string]any{
+			"listing_id":  file.ListingID,
+			"purged":      true,
+			"freed_bytes": freed,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres/postgrestest"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+func TestPurgeListingDeletesArchives(t *testing.T) {
+	db := postgrestest.Open(t)
+	ctx := context.Background()
+	store, err := storage.NewLocalBackend(t.TempDir(), "http://localhost/storage", []byte("test-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	listing := createListing(t, db)
+
+	archives := tenantrepo.NewArchiveRepository(db)
+	expiresAt := time.Now().Add(time.Hour)
+	built, err := archives.Create(ctx, &domain.ArchivePlan{
+		TenantID:  listing.TenantID,
+		ListingID: listing.ID,
+		Variant:   domain.ArchiveOriginal,
+	}, expiresAt)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+	key := domain.ArchiveObjectKey(listing.TenantID, built.ID)
+	if _, err := store.Put(ctx, key, strings.NewReader("zip"), "application/zip"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := archives.Complete(ctx, listing.TenantID, built.ID, key, 3, expiresAt); err != nil {
+		t.Fatalf("complete archive: %v", err)
+	}
+	pending, err := archives.Create(ctx, &domain.ArchivePlan{
+		TenantID:  listing.TenantID,
+		ListingID: listing.ID,
+		Variant:   domain.ArchiveOriginal,
+	}, expiresAt)
+	if err != nil {
+		t.Fatalf("create archive: %v", err)
+	}
+
+	if err := tenantrepo.NewListingRepository(db).SoftDelete(ctx, listing.TenantID, listing.ID); err != nil {
+		t.Fatalf("soft delete: %v", err)
+	}
+	s := NewTrashService(db, store)
+	candidate := domain.PurgeCandidate{TenantID: listing.TenantID, ID: listing.ID}
+	keys, err := s.purgeListing(ctx, candidate, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("purgeListing: %v", err)
+	}
+
+	if len(keys) != 1 || keys[0] != key {
+		t.Errorf("keys = %v, want [%s]", keys, key)
+	}
+	for _, id := range []uuid.UUID{built.ID, pending.ID} {
+		if _, err := archives.Get(ctx, listing.TenantID, id); !errors.Is(err, domain.ErrArchiveNotFound) {
+			t.Errorf("Get(%s) error = %v, want ErrArchiveNotFound", id, err)
+		}
+	}
+}
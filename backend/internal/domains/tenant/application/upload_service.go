@@ -0,0 +1,319 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	jobs "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/jobs/domain"
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/google/uuid"
+)
+
+// expireUploadsBatchSize is how many expired uploads one query fetches.
+const expireUploadsBatchSize = 100
+
+// uploadPartContentType is the content type part objects are stored with.
+const uploadPartContentType = "application/octet-stream"
+
+// CreateUploadInput declares a resumable photo upload into a listing.
+type CreateUploadInput struct {
+	TenantID  uuid.UUID
+	ListingID uuid.UUID
+	UserID    uuid.UUID
+	Length    int64
+	// Metadata is free-form client metadata; its "filename" entry, if any,
+	// names the photo.
+	Metadata map[string]string
+}
+
+// UploadService receives photos in several requests so that interrupted
+// uploads can resume. Each request's bytes are stored as a part; once all
+// have arrived they are streamed through PhotoService as one photo.
+type UploadService struct {
+	db       *sql.DB
+	store    storage.Backend
+	photos   *PhotoService
+	listings *tenantrepo.ListingRepository
+	tenants  *tenantrepo.TenantRepository
+	uploads  *tenantrepo.UploadRepository
+}
+
+// NewUploadService creates an UploadService that keeps received bytes in store.
+func NewUploadService(db *sql.DB, store storage.Backend) *UploadService {
+	return &UploadService{
+		db:       db,
+		store:    store,
+		photos:   NewPhotoService(db, store),
+		listings: tenantrepo.NewListingRepository(db),
+		tenants:  tenantrepo.NewTenantRepository(db),
+		uploads:  tenantrepo.NewUploadRepository(db),
+	}
+}
+
+// MaxLength is the largest upload the tenant's plan accepts.
+func (s *UploadService) MaxLength(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	return min(int64(domain.MaxPhotoSizeBytes), limits.MaxUploadBytes), nil
+}
+
+// Create starts an upload of in.Length bytes. The declared length is checked
+// against the plan up front; storage and listing quotas are checked again
+// when the upload completes.
+func (s *UploadService) Create(ctx context.Context, in CreateUploadInput) (*domain.Upload, error) {
+	if in.Length <= 0 {
+		return nil, domain.ErrInvalidUploadLength
+	}
+	if _, err := s.listings.Get(ctx, in.TenantID, in.ListingID); err != nil {
+		return nil, err
+	}
+
+	limits, err := subscriptionapp.NewQuotaResolver(s.db).Limits(ctx, in.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := limits.CheckUpload(in.Length); err != nil {
+		return nil, err
+	}
+	if in.Length > domain.MaxPhotoSizeBytes {
+		return nil, domain.ErrPhotoTooLarge
+	}
+	usage, err := s.tenants.GetStorageUsage(ctx, in.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load storage usage: %w", err)
+	}
+	if err := limits.CheckStorage(usage.UsedStorageBytes, 1); err != nil {
+		return nil, err
+	}
+
+	upload, err := s.uploads.Create(ctx, &domain.Upload{
+		TenantID:  in.TenantID,
+		ListingID: in.ListingID,
+		UserID:    in.UserID,
+		Filename:  in.Metadata["filename"],
+		Metadata:  in.Metadata,
+		Length:    in.Length,
+		ExpiresAt: time.Now().Add(domain.UploadTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create upload: %w", err)
+	}
+	return upload, nil
+}
+
+// Get returns the user's upload, or domain.ErrUploadNotFound once an
+// unfinished upload has expired.
+func (s *UploadService) Get(ctx context.Context, tenantID, userID, uploadID uuid.UUID) (*domain.Upload, error) {
+	upload, err := s.uploads.Get(ctx, tenantID, userID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Expired(time.Now()) {
+		return nil, domain.ErrUploadNotFound
+	}
+	return upload, nil
+}
+
+// Append stores body as the upload's bytes from offset, which must be the
+// upload's current offset. When the last byte arrives the upload is turned
+// into a photo. An upload whose bytes have all arrived but which failed to
+// complete, e.g. over quota, is completed again by an empty append at its
+// length.
+func (s *UploadService) Append(ctx context.Context, tenantID, userID, uploadID uuid.UUID, offset int64, body io.Reader) (*domain.Upload, error) {
+	upload, err := s.Get(ctx, tenantID, userID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Completed() {
+		return nil, domain.ErrUploadComplete
+	}
+	if offset != upload.Offset {
+		return nil, domain.ErrUploadOffsetMismatch
+	}
+	if upload.Received() {
+		return s.complete(ctx, upload)
+	}
+
+	// One byte past the declared length is read to detect oversized bodies
+	remaining := upload.Length - offset
+	key := domain.UploadPartObjectKey(tenantID, uploadID, offset, uuid.New())
+	size, err := s.store.Put(ctx, key, io.LimitReader(body, remaining+1), uploadPartContentType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store upload part: %w", err)
+	}
+	if size > remaining {
+		s.photos.deleteObject(key)
+		return nil, domain.ErrUploadTooLarge
+	}
+	if size == 0 {
+		s.photos.deleteObject(key)
+		return upload, nil
+	}
+
+	err = postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+		upload, err = tenantrepo.NewUploadRepository(tx).Append(ctx, tenantID, domain.UploadPart{
+			UploadID:  uploadID,
+			Offset:    offset,
+			SizeBytes: size,
+			ObjectKey: key,
+		})
+		return err
+	})
+	if err != nil {
+		s.photos.deleteObject(key)
+		return nil, err
+	}
+	if upload.Received() {
+		return s.complete(ctx, upload)
+	}
+	return upload, nil
+}
+
+// complete streams the upload's parts through PhotoService.upload, marking
+// the upload done in the same transaction, then removes the parts.
+func (s *UploadService) complete(ctx context.Context, upload *domain.Upload) (*domain.Upload, error) {
+	parts, err := s.uploads.ListParts(ctx, upload.TenantID, upload.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upload parts: %w", err)
+	}
+	keys := make([]string, 0, len(parts))
+	var received int64
+	for _, part := range parts {
+		if part.Offset != received {
+			return nil, fmt.Errorf("upload %s is missing bytes at offset %d", upload.ID, received)
+		}
+		keys = append(keys, part.ObjectKey)
+		received += part.SizeBytes
+	}
+	if received != upload.Length {
+		return nil, fmt.Errorf("upload %s has %d of %d bytes stored", upload.ID, received, upload.Length)
+	}
+
+	body := &partReader{ctx: ctx, store: s.store, keys: keys}
+	defer body.Close()
+
+	var partKeys []string
+	_, err = s.photos.upload(ctx, UploadPhotoInput{
+		TenantID:  upload.TenantID,
+		ListingID: upload.ListingID,
+		UserID:    upload.UserID,
+		Filename:  upload.Filename,
+		Body:      body,
+	}, func(tx *sql.Tx, photo *domain.Photo) error {
+		var err error
+		upload, partKeys, err = tenantrepo.NewUploadRepository(tx).Complete(ctx, upload.TenantID, upload.ID, photo.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range partKeys {
+		s.photos.deleteObject(key)
+	}
+	return upload, nil
+}
+
+// Terminate discards the user's upload and the bytes received so far.
+func (s *UploadService) Terminate(ctx context.Context, tenantID, userID, uploadID uuid.UUID) error {
+	var keys []string
+	err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		var err error
+		keys, err = tenantrepo.NewUploadRepository(tx).Delete(ctx, tenantID, userID, uploadID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		s.photos.deleteObject(key)
+	}
+	return nil
+}
+
+// ExpireUploads handles domain.JobExpireUploads by deleting every upload
+// past its expiry together with the parts it received.
+func (s *UploadService) ExpireUploads(ctx context.Context, _ *jobs.Job, _ struct{}) error {
+	for {
+		expired, err := s.uploads.ListExpired(ctx, expireUploadsBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list expired uploads: %w", err)
+		}
+		for _, upload := range expired {
+			var keys []string
+			err := postgres.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+				var err error
+				keys, err = tenantrepo.NewUploadRepository(tx).DeleteExpired(ctx, upload.TenantID, upload.ID)
+				return err
+			})
+			if errors.Is(err, domain.ErrUploadNotFound) {
+				// Terminated since it was listed
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to delete upload %s: %w", upload.ID, err)
+			}
+			for _, key := range keys {
+				if err := s.store.Delete(ctx, key); err != nil {
+					log.Printf("upload expiry: failed to remove part %s: %v", key, err)
+				}
+			}
+		}
+		if len(expired) < expireUploadsBatchSize {
+			return nil
+		}
+	}
+}
+
+// partReader reads an upload's parts from storage as one stream, opening
+// each part only once the previous one is exhausted.
+type partReader struct {
+	ctx   context.Context
+	store storage.Backend
+	keys  []string
+	cur   io.ReadCloser
+}
+
+func (r *partReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			rc, _, err := r.store.Get(r.ctx, r.keys[0])
+			if err != nil {
+				return 0, fmt.Errorf("failed to open upload part: %w", err)
+			}
+			r.cur, r.keys = rc, r.keys[1:]
+		}
+		n, err := r.cur.Read(p)
+		if err == io.EOF {
+			r.cur.Close()
+			r.cur = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Close releases the part being read, if any.
+func (r *partReader) Close() error {
+	if r.cur == nil {
+		return nil
+	}
+	return r.cur.Close()
+}
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Archive variants allowed by archives_variant_check: the uploaded originals
+// or the copies shown to viewers, watermarked when the tenant watermarks.
+const (
+	ArchiveOriginal    = "original"
+	ArchiveWatermarked = "watermarked"
+)
+
+// Archive statuses allowed by archives_status_check.
+const (
+	ArchivePending = "pending"
+	ArchiveReady   = "ready"
+	ArchiveFailed  = "failed"
+)
+
+// ArchiveTTL is how long a built archive can be downloaded before the worker
+// removes it.
+const ArchiveTTL = 24 * time.Hour
+
+// Downloads above either limit are built as an Archive by the worker instead
+// of being streamed in the request.
+const (
+	MaxStreamedArchiveBytes  = 1 << 30
+	MaxStreamedArchivePhotos = 200
+)
+
+// JobBuildArchive writes the ZIP of an ArchiveJob to storage.
+// JobPurgeArchives is the scheduled system job that removes expired
+// archives; it carries no payload.
+const (
+	JobBuildArchive  = "archive.build"
+	JobPurgeArchives = "archive.purge"
+)
+
+// Archive errors.
+var (
+	ErrArchiveNotFound       = errors.New("archive not found")
+	ErrInvalidArchiveVariant = errors.New("variant must be original or watermarked")
+	ErrEmptyArchive          = errors.New("there are no photos to download")
+)
+
+// ArchiveJob identifies the archive a JobBuildArchive job builds and the
+// photos it holds. Photos deleted since the archive was asked for are left
+// out.
+type ArchiveJob struct {
+	ArchiveID uuid.UUID   `json:"archive_id"`
+	PhotoIDs  []uuid.UUID `json:"photo_ids"`
+}
+
+// ArchiveEntry is one photo of an archive: the object streamed into it and
+// the unique name it gets there.
+type ArchiveEntry struct {
+	PhotoID uuid.UUID
+	Name    string
+	Key     string
+	Size    int64
+}
+
+// ArchivePlan is what a ZIP download of a listing holds. ShareLinkID is set
+// when a share link visitor asked for it, RequestedBy when a member did.
+// Size is the total of the originals, an upper bound for watermarked copies.
+type ArchivePlan struct {
+	TenantID    uuid.UUID
+	ListingID   uuid.UUID
+	ShareLinkID *uuid.UUID
+	RequestedBy *uuid.UUID
+	Variant     string
+	FileName    string
+	Entries     []ArchiveEntry
+	Size        int64
+}
+
+// NewArchivePlan plans an archive of photos, in their order, from listing.
+// An empty variant means ArchiveOriginal.
+func NewArchivePlan(listing *Listing, photos []Photo, variant string) (*ArchivePlan, error) {
+	if variant == "" {
+		variant = ArchiveOriginal
+	}
+	if variant != ArchiveOriginal && variant != ArchiveWatermarked {
+		return nil, ErrInvalidArchiveVariant
+	}
+	if len(photos) == 0 {
+		return nil, ErrEmptyArchive
+	}
+
+	plan := &ArchivePlan{
+		TenantID:  listing.TenantID,
+		ListingID: listing.ID,
+		Variant:   variant,
+		FileName:  ArchiveFileName(listing.Title),
+		Entries:   make([]ArchiveEntry, 0, len(photos)),
+	}
+	used := make(map[string]bool, len(photos))
+	for i := range photos {
+		photo := &photos[i]
+		key := photo.OriginalKey
+		if variant == ArchiveWatermarked {
+			key = photo.DisplayKey()
+		}
+		plan.Entries = append(plan.Entries, ArchiveEntry{
+			PhotoID: photo.ID,
+			Name:    entryName(photo.Name(), key, used),
+			Key:     key,
+			Size:    photo.SizeBytes,
+		})
+		plan.Size += photo.SizeBytes
+	}
+	return plan, nil
+}
+
+// Large reports whether the archive must be built by the worker.
+func (p *ArchivePlan) Large() bool {
+	return p.Size > MaxStreamedArchiveBytes || len(p.Entries) > MaxStreamedArchivePhotos
+}
+
+// PhotoIDs returns the IDs of the archived photos, in order.
+func (p *ArchivePlan) PhotoIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(p.Entries))
+	for _, e := range p.Entries {
+		ids = append(ids, e.PhotoID)
+	}
+	return ids
+}
+
+// entryName gives a photo a name within the archive that no earlier entry
+// has, with the extension of the object actually stored under it.
+func entryName(name, key string, used map[string]bool) string {
+	if name == "." || name == ".." {
+		name = path.Base(key)
+	}
+	ext := path.Ext(key)
+	base := strings.TrimSuffix(name, path.Ext(name))
+	if base == "" {
+		base = strings.TrimSuffix(path.Base(key), ext)
+	}
+
+	name = base + ext
+	for n := 2; used[strings.ToLower(name)]; n++ {
+		name = fmt.Sprintf("%s (%d)%s", base, n, ext)
+	}
+	used[strings.ToLower(name)] = true
+	return name
+}
+
+// ArchiveFileName is the download name of a listing's archive: its title
+// reduced to ASCII letters, digits and dashes.
+func ArchiveFileName(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range title {
+		switch {
+		case r < 0x80 && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'):
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	if b.Len() == 0 {
+		return "listing.zip"
+	}
+	return b.String() + ".zip"
+}
+
+// Archive is a ZIP of a listing's photos built by the worker. ObjectKey and
+// SizeBytes are set once it is ready.
+type Archive struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	ListingID   uuid.UUID
+	ShareLinkID *uuid.UUID
+	RequestedBy *uuid.UUID
+	Variant     string
+	Status      string
+	PhotoCount  int32
+	ObjectKey   *string
+	SizeBytes   *int64
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Ready reports whether the archive can be downloaded.
+func (a *Archive) Ready() bool {
+	return a.Status == ArchiveReady && a.ObjectKey != nil
+}
+
+// ArchiveObjectKey is where a built archive is stored.
+func ArchiveObjectKey(tenantID, archiveID uuid.UUID) string {
+	return fmt.Sprintf("tenants/%s/archives/%s.zip", tenantID, archiveID)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrBlobNotFound is returned when the tenant holds no blob with the digest.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// Blob is an original upload shared by every file of a tenant with the same
+// content. RefCount is the number of files pointing at it; its object is
+// deleted and its bytes stop counting against the tenant once that reaches zero.
+type Blob struct {
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+	ContentSHA256 string
+	ObjectKey     string
+	SizeBytes     int64
+	MimeType      string
+	RefCount      int32
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Unreferenced reports whether no file points at the blob any more.
+func (b *Blob) Unreferenced() bool {
+	return b.RefCount <= 0
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ErrFileNotFound is returned when the file does not exist in the tenant.
+var ErrFileNotFound = errors.New("file not found")
+
+// maxFilenameLength caps the stored upload name.
+const maxFilenameLength = 255
+
+// File is a stored upload. OriginalKey is the object key of the bytes as uploaded;
+// derived copies are filled in by later processing. ContentSHA256 names the
+// Blob holding the original, and is nil for files uploaded before
+// deduplication, which own their original outright. Filename is the name
+// the file was uploaded under, empty for files stored before names were kept.
+type File struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	ListingID      uuid.UUID
+	UserID         uuid.UUID
+	OriginalKey    string
+	WatermarkedKey *string
+	WatermarkType  *string
+	ThumbnailKey   *string
+	SizeBytes      int64
+	MimeType       string
+	ContentSHA256  *string
+	Filename       string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CleanFilename reduces a client supplied upload name to a plain file name:
+// no directories, control characters or surrounding space, and at most
+// maxFilenameLength bytes.
+func CleanFilename(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(name)
+	for len(name) > maxFilenameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+// FileName names a stored file for people: its upload name, or the base of
+// its object key when no name was kept.
+func FileName(filename, originalKey string) string {
+	if filename != "" {
+		return filename
+	}
+	return path.Base(originalKey)
+}
+
+// DisplayKey is the object shown to viewers and the source of resized
+// renditions: the watermarked copy when there is one, else the original.
+func (f *File) DisplayKey() string {
+	if f.WatermarkedKey != nil {
+		return *f.WatermarkedKey
+	}
+	return f.OriginalKey
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxListingTitleLength mirrors listings.title VARCHAR(200).
+const MaxListingTitleLength = 200
+
+// Listing statuses allowed by listing_status_check. See CanTransition for
+// the moves between them.
+const (
+	ListingStatusDraft     = "draft"
+	ListingStatusReview    = "review"
+	ListingStatusPublished = "published"
+	ListingStatusArchived  = "archived"
+)
+
+// Listing visibilities allowed by listing_visibility_check.
+const (
+	VisibilityPrivate = "private"
+	VisibilityPublic  = "public"
+)
+
+// Listing errors.
+var (
+	ErrListingNotFound      = errors.New("listing not found")
+	ErrInvalidListingTitle  = errors.New("listing title must be between 1 and 200 characters")
+	ErrInvalidListingStatus = errors.New("listing status must be draft, review, published or archived")
+	ErrInvalidVisibility    = errors.New("listing visibility must be private or public")
+)
+
+// Listing is a collection of photos owned by a tenant user.
+type Listing struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	UserID      uuid.UUID
+	Title       string
+	Description *string
+	Status      string
+	Visibility  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+	PublishedAt *time.Time
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
+// NewListing validates the fields and returns a draft listing ready to be persisted.
+// An empty visibility defaults to private.
+func NewListing(tenantID, userID uuid.UUID, title string, description *string, visibility string) (*Listing, error) {
+	title, err := normalizeListingTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	if visibility == "" {
+		visibility = VisibilityPrivate
+	}
+	if err := ValidateVisibility(visibility); err != nil {
+		return nil, err
+	}
+
+	return &Listing{
+		TenantID:    tenantID,
+		UserID:      userID,
+		Title:       title,
+		Description: description,
+		Status:      ListingStatusDraft,
+		Visibility:  visibility,
+	}, nil
+}
+
+// ListingChanges is a partial update of a listing's editable fields. Nil
+// fields are left unchanged; an empty Description clears it.
+type ListingChanges struct {
+	Title       *string
+	Description *string
+	Visibility  *string
+}
+
+// Apply validates and applies changes to the listing.
+func (l *Listing) Apply(changes ListingChanges) error {
+	if changes.Title != nil {
+		title, err := normalizeListingTitle(*changes.Title)
+		if err != nil {
+			return err
+		}
+		l.Title = title
+	}
+	if changes.Description != nil {
+		if *changes.Description == "" {
+			l.Description = nil
+		} else {
+			description := *changes.Description
+			l.Description = &description
+		}
+	}
+	if changes.Visibility != nil {
+		if err := ValidateVisibility(*changes.Visibility); err != nil {
+			return err
+		}
+		l.Visibility = *changes.Visibility
+	}
+	return nil
+}
+
+// ValidateListingStatus returns ErrInvalidListingStatus for unknown statuses.
+func ValidateListingStatus(status string) error {
+	switch status {
+	case ListingStatusDraft, ListingStatusReview, ListingStatusPublished, ListingStatusArchived:
+		return nil
+	}
+	return ErrInvalidListingStatus
+}
+
+// ValidateVisibility returns ErrInvalidVisibility for unknown visibilities.
+func ValidateVisibility(visibility string) error {
+	switch visibility {
+	case VisibilityPrivate, VisibilityPublic:
+		return nil
+	}
+	return ErrInvalidVisibility
+}
+
+// IsPublished reports whether the listing is published.
+func (l *Listing) IsPublished() bool {
+	return l.Status == ListingStatusPublished
+}
+
+func normalizeListingTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || len(title) > MaxListingTitleLength {
+		return "", ErrInvalidListingTitle
+	}
+	return title, nil
+}
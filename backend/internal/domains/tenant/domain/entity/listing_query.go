@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Listing sort orders accepted by the tenant-wide listing list.
+const (
+	ListingSortNewest = "-created_at"
+	ListingSortOldest = "created_at"
+	ListingSortTitle  = "title"
+)
+
+// Listing query errors.
+var (
+	ErrInvalidListingSort = errors.New("sort must be one of -created_at, created_at or title")
+	ErrInvalidCursor      = errors.New("cursor is invalid or does not match the requested sort")
+)
+
+// ListingFilter narrows a listing query. Zero values match everything.
+type ListingFilter struct {
+	Status     string
+	Visibility string
+	UserID     uuid.UUID
+}
+
+// Validate checks the filter values against the listing constraints.
+func (f ListingFilter) Validate() error {
+	if f.Status != "" {
+		if err := ValidateListingStatus(f.Status); err != nil {
+			return err
+		}
+	}
+	if f.Visibility != "" {
+		if err := ValidateVisibility(f.Visibility); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListingQuery selects one keyset page of a tenant's listings.
+type ListingQuery struct {
+	TenantID uuid.UUID
+	Filter   ListingFilter
+	Sort     string
+	After    *ListingCursor
+	Limit    int32
+}
+
+// ListingPage is one page of listings. NextCursor is empty on the last page.
+type ListingPage struct {
+	Listings   []Listing
+	NextCursor string
+}
+
+// ListingCursor marks the last listing of a page. CreatedAt or Title is set
+// depending on Sort.
+type ListingCursor struct {
+	Sort      string     `json:"s"`
+	CreatedAt *time.Time `json:"c,omitempty"`
+	Title     *string    `json:"t,omitempty"`
+	ID        uuid.UUID  `json:"i"`
+}
+
+// ValidateListingSort returns ErrInvalidListingSort for unknown sort orders.
+func ValidateListingSort(sort string) error {
+	switch sort {
+	case ListingSortNewest, ListingSortOldest, ListingSortTitle:
+		return nil
+	}
+	return ErrInvalidListingSort
+}
+
+// CursorAfter returns the cursor pointing just past l in the given sort order.
+func CursorAfter(l *Listing, sort string) *ListingCursor {
+	cursor := &ListingCursor{Sort: sort, ID: l.ID}
+	if sort == ListingSortTitle {
+		title := l.Title
+		cursor.Title = &title
+	} else {
+		createdAt := l.CreatedAt
+		cursor.CreatedAt = &createdAt
+	}
+	return cursor
+}
+
+// Encode returns the opaque form of the cursor handed to clients.
+func (c *ListingCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeListingCursor parses a cursor produced by Encode and checks that it
+// belongs to sort.
+func DecodeListingCursor(s, sort string) (*ListingCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor ListingCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort != sort || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	if sort == ListingSortTitle && cursor.Title == nil {
+		return nil, ErrInvalidCursor
+	}
+	if sort != ListingSortTitle && cursor.CreatedAt == nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
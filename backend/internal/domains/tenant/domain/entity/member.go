@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Membership errors.
+var (
+	ErrLastAdmin           = errors.New("tenant must keep at least one tenant admin")
+	ErrCannotChangeOwnRole = errors.New("members cannot change their own role or remove themselves")
+	ErrRoleNotAssignable   = errors.New("role cannot be assigned by a tenant")
+)
+
+// Member is a user together with their role in a tenant.
+type Member struct {
+	UserID    uuid.UUID
+	Username  string
+	Email     string
+	Role      string
+	JoinedAt  time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrFileMetadataNotFound is returned when a file's metadata has not been extracted yet.
+var ErrFileMetadataNotFound = errors.New("file metadata not found")
+
+// FileMetadata is the capture information read from a file's EXIF and IPTC
+// blocks. Zero values mean the camera did not record the field. Width and
+// Height are of the upright image.
+type FileMetadata struct {
+	FileID       uuid.UUID
+	TenantID     uuid.UUID
+	Width        int32
+	Height       int32
+	Orientation  int32
+	CapturedAt   *time.Time
+	CameraMake   string
+	CameraModel  string
+	LensModel    string
+	FocalLength  float64
+	FNumber      float64
+	ExposureTime float64
+	ISO          int32
+	Artist       string
+	Copyright    string
+	Description  string
+	// GPS is private to the tenant; renditions only carry it when the
+	// tenant's settings allow.
+	GPS       *GPSLocation
+	IPTC      IPTC
+	UpdatedAt time.Time
+}
+
+// GPSLocation is a capture location in decimal degrees.
+type GPSLocation struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// IPTC holds the descriptive IPTC fields of a photo. It is stored as JSON in
+// file_metadata.iptc.
+type IPTC struct {
+	Title     string   `json:"title,omitempty"`
+	Headline  string   `json:"headline,omitempty"`
+	Caption   string   `json:"caption,omitempty"`
+	Keywords  []string `json:"keywords,omitempty"`
+	Creator   string   `json:"creator,omitempty"`
+	Copyright string   `json:"copyright,omitempty"`
+	City      string   `json:"city,omitempty"`
+	Country   string   `json:"country,omitempty"`
+}
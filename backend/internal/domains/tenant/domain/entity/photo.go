@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPhotoSizeBytes caps a single uploaded photo.
+const MaxPhotoSizeBytes = 50 << 20
+
+// MaxBulkPhotos caps how many photos one bulk operation may name.
+const MaxBulkPhotos = 500
+
+// photoExtensions maps the accepted photo MIME types to their file extension.
+var photoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// Photo errors.
+var (
+	ErrPhotoNotFound        = errors.New("photo not found")
+	ErrUnsupportedPhotoType = errors.New("photo must be a JPEG, PNG or WebP image")
+	ErrPhotoTooLarge        = errors.New("photo exceeds the maximum upload size")
+	ErrEmptyPhoto           = errors.New("photo is empty")
+	ErrInvalidPhotoOrder    = errors.New("photo order must name every photo of the listing exactly once")
+	ErrNoPhotosSelected     = errors.New("no photos selected")
+	ErrTooManyPhotos        = errors.New("too many photos selected")
+	ErrDuplicatePhoto       = errors.New("a photo is selected more than once")
+	ErrSameListing          = errors.New("photos are already in the target listing")
+	ErrPhotoInListing       = errors.New("the target listing already holds one of the photos")
+)
+
+// Photo is a file placed in a listing. Metadata is nil until it has been
+// extracted from the upload.
+type Photo struct {
+	ID             uuid.UUID
+	ListingID      uuid.UUID
+	FileID         uuid.UUID
+	Position       int32
+	IsCover        bool
+	IsPublished    bool
+	OriginalKey    string
+	WatermarkedKey *string
+	ThumbnailKey   *string
+	SizeBytes      int64
+	MimeType       string
+	Filename       string
+	Variants       []Variant
+	Metadata       *FileMetadata
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DisplayKey is the object shown to viewers: the watermarked copy when there
+// is one, else the original.
+func (p *Photo) DisplayKey() string {
+	if p.WatermarkedKey != nil {
+		return *p.WatermarkedKey
+	}
+	return p.OriginalKey
+}
+
+// Name is the photo's file name, see FileName.
+func (p *Photo) Name() string {
+	return FileName(p.Filename, p.OriginalKey)
+}
+
+// PhotoExtension returns the file extension for an accepted photo MIME type,
+// or ErrUnsupportedPhotoType.
+func PhotoExtension(mimeType string) (string, error) {
+	ext, ok := photoExtensions[mimeType]
+	if !ok {
+		return "", ErrUnsupportedPhotoType
+	}
+	return ext, nil
+}
+
+// OriginalObjectKey is where the uploaded bytes of a photo are stored.
+func OriginalObjectKey(tenantID, objectID uuid.UUID, ext string) string {
+	return fmt.Sprintf("tenants/%s/originals/%s%s", tenantID, objectID, ext)
+}
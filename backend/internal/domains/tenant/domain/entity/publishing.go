@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobRunListingSchedules is the periodic system job that makes the scheduled
+// publish and unpublish transitions that have come due. It carries no payload.
+const JobRunListingSchedules = "listing.schedules"
+
+// JobListingEvent delivers a ListingEvent to its handlers. It is queued in the
+// transaction that made the transition, so events are only seen once the
+// change commits.
+const JobListingEvent = "listing.event"
+
+// Listing domain event types.
+const (
+	EventListingPublished     = "listing.published"
+	EventListingUnpublished   = "listing.unpublished"
+	EventListingStatusChanged = "listing.status_changed"
+)
+
+// Listing workflow errors.
+var (
+	ErrInvalidTransition = errors.New("listing cannot move to that status")
+	ErrInvalidSchedule   = errors.New("scheduled times must be in the future, with unpublishing after publishing")
+)
+
+// listingTransitions lists the statuses each status may move to. Review is
+// optional: drafts can be published directly.
+var listingTransitions = map[string][]string{
+	ListingStatusDraft:     {ListingStatusReview, ListingStatusPublished, ListingStatusArchived},
+	ListingStatusReview:    {ListingStatusDraft, ListingStatusPublished, ListingStatusArchived},
+	ListingStatusPublished: {ListingStatusDraft, ListingStatusArchived},
+	ListingStatusArchived:  {ListingStatusDraft},
+}
+
+// CanTransition reports whether a listing may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, next := range listingTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ScheduledListing names a listing with a scheduled transition that is due.
+type ScheduledListing struct {
+	TenantID uuid.UUID
+	ID       uuid.UUID
+}
+
+// ListingEvent records a listing's move between statuses. ActorID is uuid.Nil
+// when the move was scheduled.
+type ListingEvent struct {
+	Type       string    `json:"type"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+	ListingID  uuid.UUID `json:"listing_id"`
+	OwnerID    uuid.UUID `json:"owner_id"`
+	ActorID    uuid.UUID `json:"actor_id"`
+	Title      string    `json:"title"`
+	From       string    `json:"from"`
+	To         string    `json:"to"`
+	Scheduled  bool      `json:"scheduled"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Transition moves the listing to status, or returns ErrInvalidTransition.
+// Publishing stamps PublishedAt and consumes the scheduled publish time;
+// leaving published drops the scheduled unpublish time, and archiving drops
+// both.
+func (l *Listing) Transition(status string, actorID uuid.UUID, now time.Time) (*ListingEvent, error) {
+	if err := ValidateListingStatus(status); err != nil {
+		return nil, err
+	}
+	if !CanTransition(l.Status, status) {
+		return nil, ErrInvalidTransition
+	}
+
+	event := &ListingEvent{
+		Type:       EventListingStatusChanged,
+		TenantID:   l.TenantID,
+		ListingID:  l.ID,
+		OwnerID:    l.UserID,
+		ActorID:    actorID,
+		Title:      l.Title,
+		From:       l.Status,
+		To:         status,
+		OccurredAt: now,
+	}
+	switch {
+	case status == ListingStatusPublished:
+		event.Type = EventListingPublished
+		l.PublishedAt = &now
+		l.PublishAt = nil
+	case l.Status == ListingStatusPublished:
+		event.Type = EventListingUnpublished
+		l.UnpublishAt = nil
+	}
+	if status == ListingStatusArchived {
+		l.PublishAt, l.UnpublishAt = nil, nil
+	}
+	l.Status = status
+	return event, nil
+}
+
+// Schedule sets when the listing is published and unpublished automatically;
+// nil clears a time. Only drafts and listings in review can be scheduled for
+// publishing, and only a published listing, or one scheduled for publishing
+// earlier, for unpublishing.
+func (l *Listing) Schedule(publishAt, unpublishAt *time.Time, now time.Time) error {
+	if publishAt != nil {
+		if !publishAt.After(now) {
+			return ErrInvalidSchedule
+		}
+		if !CanTransition(l.Status, ListingStatusPublished) {
+			return ErrInvalidTransition
+		}
+	}
+	if unpublishAt != nil {
+		if !unpublishAt.After(now) || (publishAt != nil && !unpublishAt.After(*publishAt)) {
+			return ErrInvalidSchedule
+		}
+		if publishAt == nil && l.Status != ListingStatusPublished {
+			return ErrInvalidTransition
+		}
+	}
+	l.PublishAt = publishAt
+	l.UnpublishAt = unpublishAt
+	return nil
+}
+
+// DueTransition returns the status the listing's schedule moves it to at
+// now. ok is false when no scheduled time has passed.
+func (l *Listing) DueTransition(now time.Time) (status string, ok bool) {
+	if l.PublishAt != nil && !l.PublishAt.After(now) {
+		return ListingStatusPublished, true
+	}
+	if l.UnpublishAt != nil && !l.UnpublishAt.After(now) {
+		return ListingStatusDraft, true
+	}
+	return "", false
+}
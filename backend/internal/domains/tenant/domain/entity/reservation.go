@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReservationTTL is how long a client has to complete a reservation once
+// the photo is uploaded.
+const ReservationTTL = time.Hour
+
+// UploadURLTTL is how long the presigned URL of a reservation accepts the
+// upload. It is shorter than ReservationTTL so a leaked URL is soon useless.
+const UploadURLTTL = 15 * time.Minute
+
+// JobExpireReservations is the scheduled system job that removes expired
+// upload reservations and releases the storage held by unfinished ones. It
+// carries no payload.
+const JobExpireReservations = "uploads.expire_reservations"
+
+// Upload reservation errors.
+var (
+	ErrReservationNotFound  = errors.New("upload reservation not found")
+	ErrReservationComplete  = errors.New("upload reservation is already complete")
+	ErrReservedObjectAbsent = errors.New("nothing has been uploaded to the reserved object")
+	ErrReservedSizeMismatch = errors.New("uploaded size does not match the reserved size")
+	ErrReservedTypeMismatch = errors.New("uploaded content does not match the reserved content type")
+)
+
+// UploadReservation is an object key handed to a client to upload a photo
+// straight to storage. ObjectKey is a staging key: completing the
+// reservation copies the verified bytes to a fresh original key, so the
+// presigned URL can never overwrite a photo. SizeBytes counts against the
+// tenant's storage until the reservation completes or expires.
+type UploadReservation struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	ListingID   uuid.UUID
+	UserID      uuid.UUID
+	Filename    string
+	ObjectKey   string
+	MimeType    string
+	SizeBytes   int64
+	PhotoID     *uuid.UUID
+	CompletedAt *time.Time
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Completed reports whether the uploaded object has been added to the listing.
+func (r *UploadReservation) Completed() bool {
+	return r.CompletedAt != nil
+}
+
+// Expired reports whether an unfinished reservation can no longer be completed.
+func (r *UploadReservation) Expired(now time.Time) bool {
+	return !r.Completed() && !now.Before(r.ExpiresAt)
+}
+
+// StagingObjectKey is where a directly uploaded photo waits until its
+// reservation is completed.
+func StagingObjectKey(tenantID, objectID uuid.UUID) string {
+	return fmt.Sprintf("tenants/%s/staging/%s", tenantID, objectID)
+}
+
+// IsStagingObjectKey reports whether key was made by StagingObjectKey.
+// Reservations from before staging keys point at the photo's original.
+func IsStagingObjectKey(key string) bool {
+	parts := strings.Split(key, "/")
+	return len(parts) == 4 && parts[0] == "tenants" && parts[2] == "staging"
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Themes allowed by tenant_settings_theme_check.
+const (
+	ThemeLight  = "light"
+	ThemeDark   = "dark"
+	ThemeSystem = "system"
+)
+
+// Watermark types allowed by tenant_settings_watermark_type_check and files_watermark_type_check.
+const (
+	WatermarkTypeText  = "text"
+	WatermarkTypeImage = "image"
+)
+
+// Watermark positions allowed by tenant_settings_watermark_position_check.
+const (
+	WatermarkTopLeft     = "top_left"
+	WatermarkTopRight    = "top_right"
+	WatermarkBottomLeft  = "bottom_left"
+	WatermarkBottomRight = "bottom_right"
+	WatermarkCenter      = "center"
+)
+
+// MaxWatermarkTextLength bounds the watermark text drawn on photos.
+const MaxWatermarkTextLength = 100
+
+// MaxWatermarkImageBytes caps the uploaded watermark logo.
+const MaxWatermarkImageBytes = 2 << 20
+
+// Settings errors.
+var (
+	ErrInvalidTheme             = errors.New("theme must be one of light, dark or system")
+	ErrInvalidWatermarkText     = errors.New("watermark text must be at most 100 characters")
+	ErrInvalidWatermarkType     = errors.New("watermark type must be text or image")
+	ErrInvalidWatermarkPosition = errors.New("watermark position must be one of top_left, top_right, bottom_left, bottom_right or center")
+	ErrInvalidWatermarkOpacity  = errors.New("watermark opacity must be greater than 0 and at most 1")
+	ErrInvalidWatermarkScale    = errors.New("watermark scale must be greater than 0 and at most 1")
+	ErrWatermarkImageRequired   = errors.New("upload a watermark image before enabling image watermarks")
+	ErrWatermarkImageTooLarge   = errors.New("watermark image exceeds the maximum size")
+	ErrEmptyWatermarkImage      = errors.New("watermark image is empty")
+)
+
+// Settings are a tenant's branding, watermark and photo privacy preferences.
+// WatermarkText defaults to the tenant name when unset; WatermarkScale is the
+// watermark width as a fraction of the photo's. StripGPS keeps the capture
+// location out of the renditions shown to viewers.
+type Settings struct {
+	TenantID          uuid.UUID
+	Theme             string
+	WatermarkEnabled  bool
+	WatermarkText     *string
+	WatermarkType     string
+	WatermarkImageKey *string
+	WatermarkPosition string
+	WatermarkOpacity  float64
+	WatermarkScale    float64
+	WatermarkTiled    bool
+	StripGPS          bool
+	UpdatedAt         time.Time
+}
+
+// Validate checks the settings against the tenant_settings constraints.
+func (s *Settings) Validate() error {
+	switch s.Theme {
+	case ThemeLight, ThemeDark, ThemeSystem:
+	default:
+		return ErrInvalidTheme
+	}
+	if s.WatermarkText != nil && len(*s.WatermarkText) > MaxWatermarkTextLength {
+		return ErrInvalidWatermarkText
+	}
+	switch s.WatermarkType {
+	case WatermarkTypeText, WatermarkTypeImage:
+	default:
+		return ErrInvalidWatermarkType
+	}
+	switch s.WatermarkPosition {
+	case WatermarkTopLeft, WatermarkTopRight, WatermarkBottomLeft, WatermarkBottomRight, WatermarkCenter:
+	default:
+		return ErrInvalidWatermarkPosition
+	}
+	if !(s.WatermarkOpacity > 0 && s.WatermarkOpacity <= 1) {
+		return ErrInvalidWatermarkOpacity
+	}
+	if !(s.WatermarkScale > 0 && s.WatermarkScale <= 1) {
+		return ErrInvalidWatermarkScale
+	}
+	if s.WatermarkEnabled && s.WatermarkType == WatermarkTypeImage && s.WatermarkImageKey == nil {
+		return ErrWatermarkImageRequired
+	}
+	return nil
+}
+
+// WatermarkChanged reports whether photos watermarked under prev would look
+// different under s, so they need to be rendered again.
+func (s *Settings) WatermarkChanged(prev *Settings) bool {
+	if s.WatermarkEnabled != prev.WatermarkEnabled {
+		return true
+	}
+	if !s.WatermarkEnabled {
+		return false
+	}
+	return s.WatermarkType != prev.WatermarkType ||
+		!equalStrings(s.WatermarkText, prev.WatermarkText) ||
+		!equalStrings(s.WatermarkImageKey, prev.WatermarkImageKey) ||
+		s.WatermarkPosition != prev.WatermarkPosition ||
+		s.WatermarkOpacity != prev.WatermarkOpacity ||
+		s.WatermarkScale != prev.WatermarkScale ||
+		s.WatermarkTiled != prev.WatermarkTiled
+}
+
+// RenditionsChanged reports whether the watermarked copies and variants
+// rendered under prev are stale under s.
+func (s *Settings) RenditionsChanged(prev *Settings) bool {
+	return s.WatermarkChanged(prev) || s.StripGPS != prev.StripGPS
+}
+
+func equalStrings(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// WatermarkImageObjectKey is where the tenant's watermark logo is stored.
+// The object ID changes on every upload so renders in flight keep their logo.
+func WatermarkImageObjectKey(tenantID, objectID uuid.UUID, ext string) string {
+	return fmt.Sprintf("tenants/%s/branding/watermark-%s%s", tenantID, objectID, ext)
+}
+
+// StorageUsage is the number of bytes a tenant currently stores.
+type StorageUsage struct {
+	TenantID         uuid.UUID
+	UsedStorageBytes int64
+	UpdatedAt        time.Time
+}
@@ -1 +1,43 @@
-package domain
\ No newline at end of file
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxTenantNameLength mirrors tenants.name VARCHAR(100).
+const MaxTenantNameLength = 100
+
+// Defaults applied to tenant_settings when a tenant is provisioned.
+const (
+	DefaultTheme            = ThemeLight
+	DefaultWatermarkEnabled = true
+)
+
+// Tenant errors.
+var (
+	ErrInvalidTenantName = errors.New("tenant name must be between 1 and 100 characters")
+	ErrNotMember         = errors.New("user is not a member of the tenant")
+	ErrTenantNotFound    = errors.New("tenant not found")
+	ErrTenantNameTaken   = errors.New("tenant name is already taken")
+)
+
+// Tenant is a photography business using the platform.
+type Tenant struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewTenant validates the tenant name and returns a tenant ready to be persisted.
+func NewTenant(name string) (*Tenant, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > MaxTenantNameLength {
+		return nil, ErrInvalidTenantName
+	}
+	return &Tenant{Name: name}, nil
+}
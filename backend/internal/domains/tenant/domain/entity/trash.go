@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrashRetention is how long deleted listings and photos stay restorable
+// before the worker purges them for good.
+const TrashRetention = 30 * 24 * time.Hour
+
+// JobPurgeTrash is the scheduled system job that purges listings and photos
+// deleted more than TrashRetention ago, with the files and stored objects
+// nothing else uses. It carries no payload.
+const JobPurgeTrash = "trash.purge"
+
+// PurgeAt is when an item deleted at deletedAt is purged.
+func PurgeAt(deletedAt time.Time) time.Time {
+	return deletedAt.Add(TrashRetention)
+}
+
+// TrashedPhoto is a deleted photo of a live listing. Photos of deleted
+// listings come back with their listing instead.
+type TrashedPhoto struct {
+	ID           uuid.UUID
+	ListingID    uuid.UUID
+	ListingTitle string
+	FileID       uuid.UUID
+	Position     int32
+	IsPublished  bool
+	OriginalKey  string
+	ThumbnailKey *string
+	SizeBytes    int64
+	MimeType     string
+	DeletedAt    time.Time
+}
+
+// PurgeCandidate names a listing or photo that is due to be purged.
+type PurgeCandidate struct {
+	TenantID uuid.UUID
+	ID       uuid.UUID
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UploadTTL is how long a resumable upload may take before it expires and
+// its received bytes are discarded.
+const UploadTTL = 24 * time.Hour
+
+// JobExpireUploads is the scheduled system job that discards expired
+// resumable uploads. It carries no payload.
+const JobExpireUploads = "uploads.expire"
+
+// Resumable upload errors.
+var (
+	ErrUploadNotFound       = errors.New("upload not found")
+	ErrUploadOffsetMismatch = errors.New("upload offset does not match the bytes received")
+	ErrUploadTooLarge       = errors.New("upload exceeds its declared length")
+	ErrUploadComplete       = errors.New("upload is already complete")
+	ErrInvalidUploadLength  = errors.New("upload length must be a positive number of bytes")
+)
+
+// Upload is a photo upload received in several requests. Offset is how many
+// of its Length bytes have arrived; PhotoID is set once they all have and the
+// photo was added to the listing.
+type Upload struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	ListingID   uuid.UUID
+	UserID      uuid.UUID
+	Filename    string
+	Metadata    map[string]string
+	Length      int64
+	Offset      int64
+	PhotoID     *uuid.UUID
+	CompletedAt *time.Time
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Received reports whether every declared byte of the upload has arrived.
+func (u *Upload) Received() bool {
+	return u.Offset == u.Length
+}
+
+// Completed reports whether the upload has been turned into a photo.
+func (u *Upload) Completed() bool {
+	return u.CompletedAt != nil
+}
+
+// Expired reports whether an unfinished upload can no longer be resumed.
+func (u *Upload) Expired(now time.Time) bool {
+	return !u.Completed() && !now.Before(u.ExpiresAt)
+}
+
+// UploadPart is the bytes of an upload received by one request, stored as
+// a separate object starting at Offset.
+type UploadPart struct {
+	UploadID  uuid.UUID
+	Offset    int64
+	SizeBytes int64
+	ObjectKey string
+}
+
+// UploadPartObjectKey is where a part of a resumable upload is stored until
+// the upload completes. partID keeps retried requests from overwriting a
+// part that was kept.
+func UploadPartObjectKey(tenantID, uploadID uuid.UUID, offset int64, partID uuid.UUID) string {
+	return fmt.Sprintf("tenants/%s/uploads/%s/%020d-%s", tenantID, uploadID, offset, partID)
+}
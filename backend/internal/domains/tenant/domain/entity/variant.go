@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Background job types for processing uploaded photos. Photo jobs carry a
+// PhotoJob payload and run in order metadata, watermark, variants;
+// JobRewatermarkTenant carries none and queues JobPhotoWatermark for every
+// file of the job's tenant.
+const (
+	JobPhotoMetadata     = "photo.metadata"
+	JobPhotoWatermark    = "photo.watermark"
+	JobPhotoVariants     = "photo.variants"
+	JobRewatermarkTenant = "tenant.rewatermark"
+)
+
+// PhotoJob identifies the file a photo processing job works on.
+type PhotoJob struct {
+	FileID uuid.UUID `json:"file_id"`
+}
+
+// Variant is a resized rendition of a file, listed in a photo's srcset.
+type Variant struct {
+	FileID    uuid.UUID
+	Width     int32
+	Height    int32
+	ObjectKey string
+	SizeBytes int64
+	MimeType  string
+}
+
+// VariantObjectKey is where the rendition of a file at width pixels is stored.
+func VariantObjectKey(tenantID, fileID uuid.UUID, width int, ext string) string {
+	return fmt.Sprintf("tenants/%s/variants/%s/w%d%s", tenantID, fileID, width, ext)
+}
+
+// WatermarkedObjectKey is where the watermarked copy of a file is stored.
+func WatermarkedObjectKey(tenantID, fileID uuid.UUID, ext string) string {
+	return fmt.Sprintf("tenants/%s/watermarked/%s%s", tenantID, fileID, ext)
+}
+
+// ThumbnailObjectKey is where the square thumbnail of a file is stored.
+func ThumbnailObjectKey(tenantID, fileID uuid.UUID, ext string) string {
+	return fmt.Sprintf("tenants/%s/thumbnails/%s%s", tenantID, fileID, ext)
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// ArchiveRepository persists the ZIP archives built by the worker.
+type ArchiveRepository struct {
+	q *sqlc.Queries
+}
+
+// NewArchiveRepository creates an ArchiveRepository using the given connection or transaction.
+func NewArchiveRepository(db sqlc.DBTX) *ArchiveRepository {
+	return &ArchiveRepository{q: sqlc.New(db)}
+}
+
+// Create inserts a pending archive of plan, expiring at expiresAt unless it
+// is built first.
+func (r *ArchiveRepository) Create(ctx context.Context, plan *domain.ArchivePlan, expiresAt time.Time) (*domain.Archive, error) {
+	row, err := r.q.CreateArchive(ctx, sqlc.CreateArchiveParams{
+		TenantID:    plan.TenantID,
+		ListingID:   plan.ListingID,
+		ShareLinkID: nullUUID(plan.ShareLinkID),
+		RequestedBy: nullUUID(plan.RequestedBy),
+		Variant:     plan.Variant,
+		PhotoCount:  int32(len(plan.Entries)),
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toArchive(row), nil
+}
+
+// Get returns the tenant's archive, or domain.ErrArchiveNotFound.
+func (r *ArchiveRepository) Get(ctx context.Context, tenantID, archiveID uuid.UUID) (*domain.Archive, error) {
+	row, err := r.q.GetArchive(ctx, sqlc.GetArchiveParams{TenantID: tenantID, ID: archiveID})
+	if err != nil {
+		return nil, mapArchiveErr(err)
+	}
+	return toArchive(row), nil
+}
+
+// Complete marks a pending archive as ready, stored at key, until expiresAt.
+// It returns domain.ErrArchiveNotFound when the archive is gone or no
+// longer pending.
+func (r *ArchiveRepository) Complete(ctx context.Context, tenantID, archiveID uuid.UUID, key string, size int64, expiresAt time.Time) (*domain.Archive, error) {
+	row, err := r.q.CompleteArchive(ctx, sqlc.CompleteArchiveParams{
+		TenantID:  tenantID,
+		ID:        archiveID,
+		ObjectKey: sql.NullString{String: key, Valid: true},
+		SizeBytes: sql.NullInt64{Int64: size, Valid: true},
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, mapArchiveErr(err)
+	}
+	return toArchive(row), nil
+}
+
+// Fail marks a pending archive as failed.
+func (r *ArchiveRepository) Fail(ctx context.Context, tenantID, archiveID uuid.UUID) error {
+	return r.q.FailArchive(ctx, sqlc.FailArchiveParams{TenantID: tenantID, ID: archiveID})
+}
+
+// ListExpired returns up to limit archives of any tenant that are past
+// their expiry, oldest first. Only ID, TenantID and ObjectKey are set.
+func (r *ArchiveRepository) ListExpired(ctx context.Context, limit int32) ([]domain.Archive, error) {
+	rows, err := r.q.ListExpiredArchives(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	archives := make([]domain.Archive, 0, len(rows))
+	for _, row := range rows {
+		archives = append(archives, domain.Archive{
+			ID:        row.ID,
+			TenantID:  row.TenantID,
+			ObjectKey: nullStringPtr(row.ObjectKey),
+		})
+	}
+	return archives, nil
+}
+
+// ListObjectKeysByListing returns the object keys of the listing's built
+// archives, so they can be deleted along with the listing.
+func (r *ArchiveRepository) ListObjectKeysByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]string, error) {
+	return r.q.ListListingArchiveKeys(ctx, sqlc.ListListingArchiveKeysParams{TenantID: tenantID, ListingID: listingID})
+}
+
+// Delete removes the archive's row. Its object is the caller's to delete.
+func (r *ArchiveRepository) Delete(ctx context.Context, tenantID, archiveID uuid.UUID) error {
+	return r.q.DeleteArchive(ctx, sqlc.DeleteArchiveParams{TenantID: tenantID, ID: archiveID})
+}
+
+func mapArchiveErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrArchiveNotFound
+	}
+	return err
+}
+
+func toArchive(row sqlc.Archive) *domain.Archive {
+	a := &domain.Archive{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		ListingID:   row.ListingID,
+		ShareLinkID: nullUUIDPtr(row.ShareLinkID),
+		RequestedBy: nullUUIDPtr(row.RequestedBy),
+		Variant:     row.Variant,
+		Status:      row.Status,
+		PhotoCount:  row.PhotoCount,
+		ObjectKey:   nullStringPtr(row.ObjectKey),
+		ExpiresAt:   row.ExpiresAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.SizeBytes.Valid {
+		a.SizeBytes = &row.SizeBytes.Int64
+	}
+	return a
+}
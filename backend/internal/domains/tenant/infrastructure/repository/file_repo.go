@@ -0,0 +1,312 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// FileRepository persists uploaded files.
+type FileRepository struct {
+	q *sqlc.Queries
+}
+
+// NewFileRepository creates a FileRepository using the given connection or transaction.
+func NewFileRepository(db sqlc.DBTX) *FileRepository {
+	return &FileRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the file and returns it with its generated ID and timestamps.
+func (r *FileRepository) Create(ctx context.Context, f *domain.File) (*domain.File, error) {
+	row, err := r.q.CreateFile(ctx, sqlc.CreateFileParams{
+		TenantID:       f.TenantID,
+		ListingID:      f.ListingID,
+		UserID:         f.UserID,
+		OriginalKey:    f.OriginalKey,
+		WatermarkedKey: nullString(f.WatermarkedKey),
+		WatermarkType:  nullString(f.WatermarkType),
+		ThumbnailKey:   nullString(f.ThumbnailKey),
+		FileSizeBytes:  f.SizeBytes,
+		MimeType:       f.MimeType,
+		ContentSha256:  nullString(f.ContentSHA256),
+		Filename:       f.Filename,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFile(row), nil
+}
+
+// Get returns the file, or domain.ErrFileNotFound.
+func (r *FileRepository) Get(ctx context.Context, tenantID, fileID uuid.UUID) (*domain.File, error) {
+	row, err := r.q.GetFile(ctx, sqlc.GetFileParams{TenantID: tenantID, ID: fileID})
+	if err != nil {
+		return nil, mapFileErr(err)
+	}
+	return toFile(row), nil
+}
+
+// Delete removes the file together with its listing photos, variants and
+// metadata. It returns the deleted file and the object keys of its variants,
+// or domain.ErrFileNotFound. The original stays with the file's blob.
+func (r *FileRepository) Delete(ctx context.Context, tenantID, fileID uuid.UUID) (*domain.File, []string, error) {
+	variants, err := r.q.DeleteFileVariants(ctx, sqlc.DeleteFileVariantsParams{TenantID: tenantID, FileID: fileID})
+	if err != nil {
+		return nil, nil, err
+	}
+	row, err := r.q.DeleteFile(ctx, sqlc.DeleteFileParams{TenantID: tenantID, ID: fileID})
+	if err != nil {
+		return nil, nil, mapFileErr(err)
+	}
+	return toFile(row), variants, nil
+}
+
+// ListByListing returns the files uploaded to the listing, newest first.
+func (r *FileRepository) ListByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.File, error) {
+	rows, err := r.q.ListFilesByListing(ctx, sqlc.ListFilesByListingParams{TenantID: tenantID, ListingID: listingID})
+	if err != nil {
+		return nil, err
+	}
+	files := make([]domain.File, 0, len(rows))
+	for _, row := range rows {
+		files = append(files, *toFile(row))
+	}
+	return files, nil
+}
+
+// HasPhotos reports whether any photo, deleted or not, still shows the file.
+func (r *FileRepository) HasPhotos(ctx context.Context, tenantID, fileID uuid.UUID) (bool, error) {
+	return r.q.FileHasPhotos(ctx, sqlc.FileHasPhotosParams{TenantID: tenantID, FileID: fileID})
+}
+
+// ReassignShared hands each of the listing's files that photos in other
+// listings show over to one of those listings, so the files outlive it.
+func (r *FileRepository) ReassignShared(ctx context.Context, tenantID, listingID uuid.UUID) error {
+	return r.q.ReassignSharedListingFiles(ctx, sqlc.ReassignSharedListingFilesParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+}
+
+// AcquireBlob takes a reference on the tenant's blob with b's digest,
+// creating it from b when the content is new. The returned blob has a
+// different ObjectKey than b when an existing one was reused.
+func (r *FileRepository) AcquireBlob(ctx context.Context, b *domain.Blob) (*domain.Blob, error) {
+	row, err := r.q.AcquireFileBlob(ctx, sqlc.AcquireFileBlobParams{
+		TenantID:      b.TenantID,
+		ContentSha256: b.ContentSHA256,
+		ObjectKey:     b.ObjectKey,
+		FileSizeBytes: b.SizeBytes,
+		MimeType:      b.MimeType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toBlob(row), nil
+}
+
+// ReleaseBlob drops one reference to the tenant's blob with the digest and
+// returns what is left of it, or domain.ErrBlobNotFound. A blob that is no
+// longer referenced is deleted; the caller then removes its object and stops
+// counting its bytes.
+func (r *FileRepository) ReleaseBlob(ctx context.Context, tenantID uuid.UUID, contentSHA256 string) (*domain.Blob, error) {
+	row, err := r.q.ReleaseFileBlob(ctx, sqlc.ReleaseFileBlobParams{
+		TenantID:      tenantID,
+		ContentSha256: contentSHA256,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	blob := toBlob(row)
+	if blob.Unreferenced() {
+		if _, err := r.q.DeleteUnreferencedFileBlob(ctx, sqlc.DeleteUnreferencedFileBlobParams{
+			TenantID: tenantID,
+			ID:       blob.ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return blob, nil
+}
+
+// SetThumbnail records the object key of the file's thumbnail, or returns domain.ErrFileNotFound.
+func (r *FileRepository) SetThumbnail(ctx context.Context, tenantID, fileID uuid.UUID, key string) error {
+	_, err := r.q.SetFileThumbnailKey(ctx, sqlc.SetFileThumbnailKeyParams{
+		TenantID:     tenantID,
+		ID:           fileID,
+		ThumbnailKey: nullString(&key),
+	})
+	return mapFileErr(err)
+}
+
+// SetWatermark records the file's watermarked copy and how it was made.
+// Nil key and watermarkType clear both, or domain.ErrFileNotFound is returned.
+func (r *FileRepository) SetWatermark(ctx context.Context, tenantID, fileID uuid.UUID, key, watermarkType *string) error {
+	_, err := r.q.SetFileWatermark(ctx, sqlc.SetFileWatermarkParams{
+		TenantID:       tenantID,
+		ID:             fileID,
+		WatermarkedKey: nullString(key),
+		WatermarkType:  nullString(watermarkType),
+	})
+	return mapFileErr(err)
+}
+
+// ListIDs returns up to limit IDs of the tenant's files ordered by ID, starting after afterID.
+func (r *FileRepository) ListIDs(ctx context.Context, tenantID, afterID uuid.UUID, limit int32) ([]uuid.UUID, error) {
+	return r.q.ListTenantFileIDs(ctx, sqlc.ListTenantFileIDsParams{
+		TenantID:  tenantID,
+		AfterID:   afterID,
+		PageLimit: limit,
+	})
+}
+
+// ReplaceVariants swaps the file's renditions for variants and returns the
+// object keys of the rows it removed.
+func (r *FileRepository) ReplaceVariants(ctx context.Context, tenantID, fileID uuid.UUID, variants []domain.Variant) ([]string, error) {
+	removed, err := r.q.DeleteFileVariants(ctx, sqlc.DeleteFileVariantsParams{TenantID: tenantID, FileID: fileID})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variants {
+		if _, err := r.q.CreateFileVariant(ctx, sqlc.CreateFileVariantParams{
+			TenantID:      tenantID,
+			FileID:        fileID,
+			Width:         v.Width,
+			Height:        v.Height,
+			ObjectKey:     v.ObjectKey,
+			FileSizeBytes: v.SizeBytes,
+			MimeType:      v.MimeType,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return removed, nil
+}
+
+// SaveMetadata stores the metadata extracted from a file, replacing any
+// earlier extraction.
+func (r *FileRepository) SaveMetadata(ctx context.Context, m *domain.FileMetadata) (*domain.FileMetadata, error) {
+	iptc, err := json.Marshal(m.IPTC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode iptc: %w", err)
+	}
+	params := sqlc.UpsertFileMetadataParams{
+		FileID:              m.FileID,
+		TenantID:            m.TenantID,
+		Width:               m.Width,
+		Height:              m.Height,
+		Orientation:         m.Orientation,
+		CapturedAt:          nullTime(m.CapturedAt),
+		CameraMake:          nullString(optional(m.CameraMake)),
+		CameraModel:         nullString(optional(m.CameraModel)),
+		LensModel:           nullString(optional(m.LensModel)),
+		FocalLengthMm:       nonZeroFloat(m.FocalLength),
+		FNumber:             nonZeroFloat(m.FNumber),
+		ExposureTimeSeconds: nonZeroFloat(m.ExposureTime),
+		Iso:                 nonZeroInt32(m.ISO),
+		Artist:              nullString(optional(m.Artist)),
+		Copyright:           nullString(optional(m.Copyright)),
+		Description:         nullString(optional(m.Description)),
+		Iptc:                iptc,
+	}
+	if m.GPS != nil {
+		params.GpsLatitude = sql.NullFloat64{Float64: m.GPS.Latitude, Valid: true}
+		params.GpsLongitude = sql.NullFloat64{Float64: m.GPS.Longitude, Valid: true}
+	}
+
+	row, err := r.q.UpsertFileMetadata(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return toFileMetadata(row), nil
+}
+
+// GetMetadata returns the file's extracted metadata, or domain.ErrFileMetadataNotFound.
+func (r *FileRepository) GetMetadata(ctx context.Context, tenantID, fileID uuid.UUID) (*domain.FileMetadata, error) {
+	row, err := r.q.GetFileMetadata(ctx, sqlc.GetFileMetadataParams{TenantID: tenantID, FileID: fileID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrFileMetadataNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toFileMetadata(row), nil
+}
+
+func mapFileErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrFileNotFound
+	}
+	return err
+}
+
+func toFile(row sqlc.File) *domain.File {
+	return &domain.File{
+		ID:             row.ID,
+		TenantID:       row.TenantID,
+		ListingID:      row.ListingID,
+		UserID:         row.UserID,
+		OriginalKey:    row.OriginalKey,
+		WatermarkedKey: nullStringPtr(row.WatermarkedKey),
+		WatermarkType:  nullStringPtr(row.WatermarkType),
+		ThumbnailKey:   nullStringPtr(row.ThumbnailKey),
+		SizeBytes:      row.FileSizeBytes,
+		MimeType:       row.MimeType,
+		ContentSHA256:  nullStringPtr(row.ContentSha256),
+		Filename:       row.Filename,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
+func toBlob(row sqlc.FileBlob) *domain.Blob {
+	return &domain.Blob{
+		ID:            row.ID,
+		TenantID:      row.TenantID,
+		ContentSHA256: row.ContentSha256,
+		ObjectKey:     row.ObjectKey,
+		SizeBytes:     row.FileSizeBytes,
+		MimeType:      row.MimeType,
+		RefCount:      row.RefCount,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+func toFileMetadata(row sqlc.FileMetadatum) *domain.FileMetadata {
+	m := &domain.FileMetadata{
+		FileID:       row.FileID,
+		TenantID:     row.TenantID,
+		Width:        row.Width,
+		Height:       row.Height,
+		Orientation:  row.Orientation,
+		CapturedAt:   nullTimePtr(row.CapturedAt),
+		CameraMake:   row.CameraMake.String,
+		CameraModel:  row.CameraModel.String,
+		LensModel:    row.LensModel.String,
+		FocalLength:  row.FocalLengthMm.Float64,
+		FNumber:      row.FNumber.Float64,
+		ExposureTime: row.ExposureTimeSeconds.Float64,
+		ISO:          row.Iso.Int32,
+		Artist:       row.Artist.String,
+		Copyright:    row.Copyright.String,
+		Description:  row.Description.String,
+		UpdatedAt:    row.UpdatedAt,
+	}
+	if row.GpsLatitude.Valid && row.GpsLongitude.Valid {
+		m.GPS = &domain.GPSLocation{Latitude: row.GpsLatitude.Float64, Longitude: row.GpsLongitude.Float64}
+	}
+	// The column is only ever written from domain.IPTC, so a decode failure
+	// just leaves the fields empty
+	_ = json.Unmarshal(row.Iptc, &m.IPTC)
+	return m
+}
@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// ListingRepository persists listings. Every query is scoped by tenant.
+type ListingRepository struct {
+	q *sqlc.Queries
+}
+
+// NewListingRepository creates a ListingRepository using the given connection or transaction.
+func NewListingRepository(db sqlc.DBTX) *ListingRepository {
+	return &ListingRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the listing and returns it with its generated ID and timestamps.
+func (r *ListingRepository) Create(ctx context.Context, l *domain.Listing) (*domain.Listing, error) {
+	row, err := r.q.CreateListing(ctx, sqlc.CreateListingParams{
+		TenantID:    l.TenantID,
+		UserID:      l.UserID,
+		Title:       l.Title,
+		Description: nullString(l.Description),
+		Status:      l.Status,
+		Visibility:  l.Visibility,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toListing(row), nil
+}
+
+// Get returns a listing that has not been deleted, or domain.ErrListingNotFound.
+func (r *ListingRepository) Get(ctx context.Context, tenantID, listingID uuid.UUID) (*domain.Listing, error) {
+	row, err := r.q.GetListingByID(ctx, sqlc.GetListingByIDParams{TenantID: tenantID, ID: listingID})
+	if err != nil {
+		return nil, mapListingErr(err)
+	}
+	return toListing(row), nil
+}
+
+// List returns one keyset page of the tenant's listings matching q.
+func (r *ListingRepository) List(ctx context.Context, q domain.ListingQuery) ([]domain.Listing, error) {
+	status := nullString(optional(q.Filter.Status))
+	visibility := nullString(optional(q.Filter.Visibility))
+	userID := uuid.NullUUID{UUID: q.Filter.UserID, Valid: q.Filter.UserID != uuid.Nil}
+
+	var cursorID uuid.NullUUID
+	var cursorCreatedAt sql.NullTime
+	var cursorTitle sql.NullString
+	if q.After != nil {
+		cursorID = uuid.NullUUID{UUID: q.After.ID, Valid: true}
+		if q.After.CreatedAt != nil {
+			cursorCreatedAt = sql.NullTime{Time: *q.After.CreatedAt, Valid: true}
+		}
+		cursorTitle = nullString(q.After.Title)
+	}
+
+	var rows []sqlc.Listing
+	var err error
+	switch q.Sort {
+	case domain.ListingSortOldest:
+		rows, err = r.q.ListTenantListingsOldest(ctx, sqlc.ListTenantListingsOldestParams{
+			TenantID:        q.TenantID,
+			Status:          status,
+			Visibility:      visibility,
+			UserID:          userID,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageLimit:       q.Limit,
+		})
+	case domain.ListingSortTitle:
+		rows, err = r.q.ListTenantListingsByTitle(ctx, sqlc.ListTenantListingsByTitleParams{
+			TenantID:    q.TenantID,
+			Status:      status,
+			Visibility:  visibility,
+			UserID:      userID,
+			CursorTitle: cursorTitle,
+			CursorID:    cursorID,
+			PageLimit:   q.Limit,
+		})
+	default:
+		rows, err = r.q.ListTenantListingsNewest(ctx, sqlc.ListTenantListingsNewestParams{
+			TenantID:        q.TenantID,
+			Status:          status,
+			Visibility:      visibility,
+			UserID:          userID,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			PageLimit:       q.Limit,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	listings := make([]domain.Listing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, *toListing(row))
+	}
+	return listings, nil
+}
+
+// UpdateDetails writes the listing's title, description and visibility.
+func (r *ListingRepository) UpdateDetails(ctx context.Context, l *domain.Listing) (*domain.Listing, error) {
+	row, err := r.q.UpdateListingDetails(ctx, sqlc.UpdateListingDetailsParams{
+		TenantID:    l.TenantID,
+		ID:          l.ID,
+		Title:       l.Title,
+		Description: nullString(l.Description),
+		Visibility:  l.Visibility,
+	})
+	if err != nil {
+		return nil, mapListingErr(err)
+	}
+	return toListing(row), nil
+}
+
+// GetForUpdate returns a live listing locked for the rest of the
+// transaction, or domain.ErrListingNotFound.
+func (r *ListingRepository) GetForUpdate(ctx context.Context, tenantID, listingID uuid.UUID) (*domain.Listing, error) {
+	row, err := r.q.GetListingForUpdate(ctx, sqlc.GetListingForUpdateParams{TenantID: tenantID, ID: listingID})
+	if err != nil {
+		return nil, mapListingErr(err)
+	}
+	return toListing(row), nil
+}
+
+// UpdateStatus writes the listing's status, publication time and schedule.
+func (r *ListingRepository) UpdateStatus(ctx context.Context, l *domain.Listing) (*domain.Listing, error) {
+	row, err := r.q.UpdateListingStatus(ctx, sqlc.UpdateListingStatusParams{
+		Status:      l.Status,
+		PublishedAt: nullTime(l.PublishedAt),
+		PublishAt:   nullTime(l.PublishAt),
+		UnpublishAt: nullTime(l.UnpublishAt),
+		TenantID:    l.TenantID,
+		ID:          l.ID,
+	})
+	if err != nil {
+		return nil, mapListingErr(err)
+	}
+	return toListing(row), nil
+}
+
+// ListDueScheduled returns up to limit live listings of any tenant whose
+// scheduled publish or unpublish time is at or before now, soonest first.
+func (r *ListingRepository) ListDueScheduled(ctx context.Context, now time.Time, limit int32) ([]domain.ScheduledListing, error) {
+	rows, err := r.q.ListDueScheduledListings(ctx, sqlc.ListDueScheduledListingsParams{
+		DueBefore: now,
+		PageLimit: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	listings := make([]domain.ScheduledListing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, domain.ScheduledListing{TenantID: row.TenantID, ID: row.ID})
+	}
+	return listings, nil
+}
+
+// SoftDelete marks the listing deleted, or returns domain.ErrListingNotFound.
+func (r *ListingRepository) SoftDelete(ctx context.Context, tenantID, listingID uuid.UUID) error {
+	_, err := r.q.SoftDeleteListing(ctx, sqlc.SoftDeleteListingParams{TenantID: tenantID, ID: listingID})
+	return mapListingErr(err)
+}
+
+// CountByTenant returns how many live listings the tenant has.
+func (r *ListingRepository) CountByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	return r.q.CountTenantListings(ctx, tenantID)
+}
+
+// Lock takes a row lock on the listing for the rest of the transaction, or
+// returns domain.ErrListingNotFound.
+func (r *ListingRepository) Lock(ctx context.Context, tenantID, listingID uuid.UUID) error {
+	_, err := r.q.LockListing(ctx, sqlc.LockListingParams{TenantID: tenantID, ID: listingID})
+	return mapListingErr(err)
+}
+
+// ListTrashed returns a page of the tenant's deleted listings, most recently
+// deleted first.
+func (r *ListingRepository) ListTrashed(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]domain.Listing, error) {
+	rows, err := r.q.ListTenantTrashedListings(ctx, sqlc.ListTenantTrashedListingsParams{
+		TenantID: tenantID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	listings := make([]domain.Listing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, *toListing(row))
+	}
+	return listings, nil
+}
+
+// Restore undeletes the listing, or returns domain.ErrListingNotFound when
+// it is not in the trash.
+func (r *ListingRepository) Restore(ctx context.Context, tenantID, listingID uuid.UUID) (*domain.Listing, error) {
+	row, err := r.q.RestoreListing(ctx, sqlc.RestoreListingParams{TenantID: tenantID, ID: listingID})
+	if err != nil {
+		return nil, mapListingErr(err)
+	}
+	return toListing(row), nil
+}
+
+// ListPurgeable returns up to limit listings of any tenant deleted before
+// the cutoff, oldest first.
+func (r *ListingRepository) ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int32) ([]domain.PurgeCandidate, error) {
+	rows, err := r.q.ListPurgeableListings(ctx, sqlc.ListPurgeableListingsParams{
+		DeletedBefore: deletedBefore,
+		PageLimit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	listings := make([]domain.PurgeCandidate, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, domain.PurgeCandidate{TenantID: row.TenantID, ID: row.ID})
+	}
+	return listings, nil
+}
+
+// LockPurgeable locks a listing deleted before the cutoff for the rest of
+// the transaction, or returns domain.ErrListingNotFound once it has been
+// restored or purged.
+func (r *ListingRepository) LockPurgeable(ctx context.Context, tenantID, listingID uuid.UUID, deletedBefore time.Time) error {
+	_, err := r.q.LockPurgeableListing(ctx, sqlc.LockPurgeableListingParams{
+		TenantID:      tenantID,
+		ID:            listingID,
+		DeletedBefore: deletedBefore,
+	})
+	return mapListingErr(err)
+}
+
+// Delete removes the listing for good, together with its share links and
+// the photos and files still attached to it.
+func (r *ListingRepository) Delete(ctx context.Context, tenantID, listingID uuid.UUID) error {
+	return r.q.DeleteListing(ctx, sqlc.DeleteListingParams{TenantID: tenantID, ID: listingID})
+}
+
+func mapListingErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrListingNotFound
+	}
+	return err
+}
+
+func toListing(row sqlc.Listing) *domain.Listing {
+	return &domain.Listing{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		UserID:      row.UserID,
+		Title:       row.Title,
+		Description: nullStringPtr(row.Description),
+		Status:      row.Status,
+		Visibility:  row.Visibility,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+		DeletedAt:   nullTimePtr(row.DeletedAt),
+		PublishedAt: nullTimePtr(row.PublishedAt),
+		PublishAt:   nullTimePtr(row.PublishAt),
+		UnpublishAt: nullTimePtr(row.UnpublishAt),
+	}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// nullString converts an optional string to its SQL form.
+func nullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+// optional returns nil for an empty string, so it can be passed to nullString.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// nullStringPtr converts a nullable column to an optional string.
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// nullTimePtr converts a nullable timestamp to an optional time.
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// nullTime converts an optional time to its SQL form.
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// nullUUID converts an optional ID to its SQL form.
+func nullUUID(id *uuid.UUID) uuid.NullUUID {
+	if id == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *id, Valid: true}
+}
+
+// nullUUIDPtr converts a nullable ID column to an optional ID.
+func nullUUIDPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
+
+// nonZeroFloat stores a zero measurement as NULL, meaning not recorded.
+func nonZeroFloat(f float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: f, Valid: f != 0}
+}
+
+// nonZeroInt32 stores a zero count as NULL, meaning not recorded.
+func nonZeroInt32(n int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: n, Valid: n != 0}
+}
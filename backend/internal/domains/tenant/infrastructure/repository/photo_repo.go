@@ -0,0 +1,392 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// PhotoRepository persists listing photos. Every query is scoped by tenant.
+type PhotoRepository struct {
+	q *sqlc.Queries
+}
+
+// NewPhotoRepository creates a PhotoRepository using the given connection or transaction.
+func NewPhotoRepository(db sqlc.DBTX) *PhotoRepository {
+	return &PhotoRepository{q: sqlc.New(db)}
+}
+
+// ListByListing returns the listing's photos in display order.
+func (r *PhotoRepository) ListByListing(ctx context.Context, tenantID, listingID uuid.UUID) ([]domain.Photo, error) {
+	rows, err := r.q.ListListingPhotosWithFiles(ctx, sqlc.ListListingPhotosWithFilesParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	variants, err := r.listingVariants(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := r.listingMetadata(ctx, tenantID, listingID)
+	if err != nil {
+		return nil, err
+	}
+
+	photos := make([]domain.Photo, 0, len(rows))
+	for _, row := range rows {
+		photos = append(photos, domain.Photo{
+			ID:             row.ID,
+			ListingID:      row.ListingID,
+			FileID:         row.FileID,
+			Position:       row.Position,
+			IsCover:        row.IsCover,
+			IsPublished:    row.IsPublished,
+			OriginalKey:    row.OriginalKey,
+			WatermarkedKey: nullStringPtr(row.WatermarkedKey),
+			ThumbnailKey:   nullStringPtr(row.ThumbnailKey),
+			SizeBytes:      row.FileSizeBytes,
+			MimeType:       row.MimeType,
+			Filename:       row.Filename,
+			Variants:       variants[row.FileID],
+			Metadata:       metadata[row.FileID],
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		})
+	}
+	return photos, nil
+}
+
+// listingVariants returns the renditions of the listing's photos keyed by file, narrowest first.
+func (r *PhotoRepository) listingVariants(ctx context.Context, tenantID, listingID uuid.UUID) (map[uuid.UUID][]domain.Variant, error) {
+	rows, err := r.q.ListListingPhotoVariants(ctx, sqlc.ListListingPhotoVariantsParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	variants := make(map[uuid.UUID][]domain.Variant)
+	for _, row := range rows {
+		variants[row.FileID] = append(variants[row.FileID], domain.Variant{
+			FileID:    row.FileID,
+			Width:     row.Width,
+			Height:    row.Height,
+			ObjectKey: row.ObjectKey,
+			SizeBytes: row.FileSizeBytes,
+			MimeType:  row.MimeType,
+		})
+	}
+	return variants, nil
+}
+
+// listingMetadata returns the extracted metadata of the listing's photos keyed by file.
+func (r *PhotoRepository) listingMetadata(ctx context.Context, tenantID, listingID uuid.UUID) (map[uuid.UUID]*domain.FileMetadata, error) {
+	rows, err := r.q.ListListingPhotoMetadata(ctx, sqlc.ListListingPhotoMetadataParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[uuid.UUID]*domain.FileMetadata, len(rows))
+	for _, row := range rows {
+		metadata[row.FileID] = toFileMetadata(row)
+	}
+	return metadata, nil
+}
+
+// Add places file in the listing at the next free position. The caller must
+// hold the listing lock so concurrent uploads cannot pick the same position.
+// The first live photo of a listing becomes its cover.
+func (r *PhotoRepository) Add(ctx context.Context, file *domain.File) (*domain.Photo, error) {
+	position, err := r.q.NextListingPhotoPosition(ctx, file.ListingID)
+	if err != nil {
+		return nil, err
+	}
+	hasCover, err := r.q.ListingHasCoverPhoto(ctx, sqlc.ListingHasCoverPhotoParams{
+		TenantID:  file.TenantID,
+		ListingID: file.ListingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.q.AddListingPhoto(ctx, sqlc.AddListingPhotoParams{
+		TenantID:    file.TenantID,
+		ListingID:   file.ListingID,
+		FileID:      file.ID,
+		Position:    position,
+		IsCover:     !hasCover,
+		IsPublished: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Photo{
+		ID:             row.ID,
+		ListingID:      row.ListingID,
+		FileID:         row.FileID,
+		Position:       row.Position,
+		IsCover:        row.IsCover,
+		IsPublished:    row.IsPublished,
+		OriginalKey:    file.OriginalKey,
+		WatermarkedKey: file.WatermarkedKey,
+		ThumbnailKey:   file.ThumbnailKey,
+		SizeBytes:      file.SizeBytes,
+		MimeType:       file.MimeType,
+		Filename:       file.Filename,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}, nil
+}
+
+// CountByListing returns how many live photos the listing has.
+func (r *PhotoRepository) CountByListing(ctx context.Context, tenantID, listingID uuid.UUID) (int64, error) {
+	return r.q.CountListingPhotos(ctx, sqlc.CountListingPhotosParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+}
+
+// Exists returns domain.ErrPhotoNotFound unless the photo is live in the listing.
+func (r *PhotoRepository) Exists(ctx context.Context, tenantID, listingID, photoID uuid.UUID) error {
+	_, err := r.q.GetListingPhoto(ctx, sqlc.GetListingPhotoParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		ID:        photoID,
+	})
+	return mapPhotoErr(err)
+}
+
+// SetCover makes photoID the listing's only cover photo.
+func (r *PhotoRepository) SetCover(ctx context.Context, tenantID, listingID, photoID uuid.UUID) error {
+	return r.q.SetCoverPhoto(ctx, sqlc.SetCoverPhotoParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		ID:        photoID,
+	})
+}
+
+// SoftDelete marks the photo deleted, or returns domain.ErrPhotoNotFound.
+func (r *PhotoRepository) SoftDelete(ctx context.Context, tenantID, listingID, photoID uuid.UUID) error {
+	_, err := r.q.SoftDeleteListingPhoto(ctx, sqlc.SoftDeleteListingPhotoParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		ID:        photoID,
+	})
+	return mapPhotoErr(err)
+}
+
+// IDs returns the IDs of the listing's live photos in display order.
+func (r *PhotoRepository) IDs(ctx context.Context, tenantID, listingID uuid.UUID) ([]uuid.UUID, error) {
+	return r.q.ListListingPhotoIDs(ctx, sqlc.ListListingPhotoIDsParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+}
+
+// Reorder renumbers the listing's photos so the live ones follow photoIDs,
+// which the caller checks names each of them once. Soft-deleted photos keep
+// their relative order after the live ones. It must run inside a transaction
+// holding the listing lock.
+func (r *PhotoRepository) Reorder(ctx context.Context, tenantID, listingID uuid.UUID, photoIDs []uuid.UUID) error {
+	if err := r.q.ParkListingPhotoPositions(ctx, sqlc.ParkListingPhotoPositionsParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	}); err != nil {
+		return err
+	}
+	_, err := r.q.RenumberListingPhotos(ctx, sqlc.RenumberListingPhotosParams{
+		PhotoIds:  joinIDs(photoIDs),
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+	return err
+}
+
+// SetPublished publishes or unpublishes the given live photos of the listing
+// and returns the IDs of those it found.
+func (r *PhotoRepository) SetPublished(ctx context.Context, tenantID, listingID uuid.UUID, photoIDs []uuid.UUID, published bool) ([]uuid.UUID, error) {
+	return r.q.SetListingPhotosPublished(ctx, sqlc.SetListingPhotosPublishedParams{
+		IsPublished: published,
+		TenantID:    tenantID,
+		ListingID:   listingID,
+		PhotoIds:    joinIDs(photoIDs),
+	})
+}
+
+// SoftDeleteMany marks the given live photos of the listing deleted and
+// returns the IDs of those it found. A deleted cover stops being the cover;
+// see EnsureCover.
+func (r *PhotoRepository) SoftDeleteMany(ctx context.Context, tenantID, listingID uuid.UUID, photoIDs []uuid.UUID) ([]uuid.UUID, error) {
+	return r.q.SoftDeleteListingPhotos(ctx, sqlc.SoftDeleteListingPhotosParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		PhotoIds:  joinIDs(photoIDs),
+	})
+}
+
+// EnsureCover makes the listing's first live photo its cover if it has none.
+func (r *PhotoRepository) EnsureCover(ctx context.Context, tenantID, listingID uuid.UUID) error {
+	return r.q.EnsureListingCoverPhoto(ctx, sqlc.EnsureListingCoverPhotoParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+	})
+}
+
+// Move appends the live photo to the end of targetListingID, together with
+// its file, or returns domain.ErrPhotoNotFound. The photo never arrives as
+// the cover. The caller must hold both listing locks.
+func (r *PhotoRepository) Move(ctx context.Context, tenantID, listingID, targetListingID, photoID uuid.UUID) error {
+	position, err := r.q.NextListingPhotoPosition(ctx, targetListingID)
+	if err != nil {
+		return err
+	}
+	row, err := r.q.MoveListingPhoto(ctx, sqlc.MoveListingPhotoParams{
+		TargetListingID: targetListingID,
+		Position:        position,
+		TenantID:        tenantID,
+		ListingID:       listingID,
+		ID:              photoID,
+	})
+	if err != nil {
+		return mapPhotoErr(err)
+	}
+	return r.q.MoveFileToListing(ctx, sqlc.MoveFileToListingParams{
+		TargetListingID: targetListingID,
+		TenantID:        tenantID,
+		ID:              row.FileID,
+		ListingID:       listingID,
+	})
+}
+
+// Copy adds a new photo of the live photo's file to the end of
+// targetListingID, published if the photo is, or returns
+// domain.ErrPhotoNotFound. The caller must hold the target listing lock.
+func (r *PhotoRepository) Copy(ctx context.Context, tenantID, listingID, targetListingID, photoID uuid.UUID) error {
+	src, err := r.q.GetListingPhoto(ctx, sqlc.GetListingPhotoParams{
+		TenantID:  tenantID,
+		ListingID: listingID,
+		ID:        photoID,
+	})
+	if err != nil {
+		return mapPhotoErr(err)
+	}
+	position, err := r.q.NextListingPhotoPosition(ctx, targetListingID)
+	if err != nil {
+		return err
+	}
+	_, err = r.q.AddListingPhoto(ctx, sqlc.AddListingPhotoParams{
+		TenantID:    tenantID,
+		ListingID:   targetListingID,
+		FileID:      src.FileID,
+		Position:    position,
+		IsPublished: src.IsPublished,
+	})
+	return err
+}
+
+// ListTrashed returns a page of the tenant's deleted photos in live
+// listings, most recently deleted first.
+func (r *PhotoRepository) ListTrashed(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]domain.TrashedPhoto, error) {
+	rows, err := r.q.ListTenantTrashedListingPhotos(ctx, sqlc.ListTenantTrashedListingPhotosParams{
+		TenantID: tenantID,
+		Limit:    limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	photos := make([]domain.TrashedPhoto, 0, len(rows))
+	for _, row := range rows {
+		photos = append(photos, domain.TrashedPhoto{
+			ID:           row.ID,
+			ListingID:    row.ListingID,
+			ListingTitle: row.ListingTitle,
+			FileID:       row.FileID,
+			Position:     row.Position,
+			IsPublished:  row.IsPublished,
+			OriginalKey:  row.OriginalKey,
+			ThumbnailKey: nullStringPtr(row.ThumbnailKey),
+			SizeBytes:    row.FileSizeBytes,
+			MimeType:     row.MimeType,
+			DeletedAt:    row.DeletedAt.Time,
+		})
+	}
+	return photos, nil
+}
+
+// TrashedListing returns the listing of a deleted photo, or
+// domain.ErrPhotoNotFound when the photo is not in the trash.
+func (r *PhotoRepository) TrashedListing(ctx context.Context, tenantID, photoID uuid.UUID) (uuid.UUID, error) {
+	row, err := r.q.GetTrashedListingPhoto(ctx, sqlc.GetTrashedListingPhotoParams{TenantID: tenantID, ID: photoID})
+	if err != nil {
+		return uuid.Nil, mapPhotoErr(err)
+	}
+	return row.ListingID, nil
+}
+
+// Restore undeletes the photo at its old position, or returns
+// domain.ErrPhotoNotFound when it is not in the trash. It never comes back
+// as the cover; see EnsureCover.
+func (r *PhotoRepository) Restore(ctx context.Context, tenantID, photoID uuid.UUID) error {
+	_, err := r.q.RestoreListingPhoto(ctx, sqlc.RestoreListingPhotoParams{TenantID: tenantID, ID: photoID})
+	return mapPhotoErr(err)
+}
+
+// ListPurgeable returns up to limit photos of any tenant deleted before the
+// cutoff, oldest first.
+func (r *PhotoRepository) ListPurgeable(ctx context.Context, deletedBefore time.Time, limit int32) ([]domain.PurgeCandidate, error) {
+	rows, err := r.q.ListPurgeableListingPhotos(ctx, sqlc.ListPurgeableListingPhotosParams{
+		DeletedBefore: deletedBefore,
+		PageLimit:     limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	photos := make([]domain.PurgeCandidate, 0, len(rows))
+	for _, row := range rows {
+		photos = append(photos, domain.PurgeCandidate{TenantID: row.TenantID, ID: row.ID})
+	}
+	return photos, nil
+}
+
+// Purge removes a photo deleted before the cutoff for good and returns its
+// file ID, or domain.ErrPhotoNotFound once it has been restored or purged.
+// The file stays; see FileRepository.HasPhotos.
+func (r *PhotoRepository) Purge(ctx context.Context, tenantID, photoID uuid.UUID, deletedBefore time.Time) (uuid.UUID, error) {
+	fileID, err := r.q.DeletePurgeableListingPhoto(ctx, sqlc.DeletePurgeableListingPhotoParams{
+		TenantID:      tenantID,
+		ID:            photoID,
+		DeletedBefore: deletedBefore,
+	})
+	if err != nil {
+		return uuid.Nil, mapPhotoErr(err)
+	}
+	return fileID, nil
+}
+
+// joinIDs encodes IDs for the string_to_array parameters of the photo queries.
+func joinIDs(ids []uuid.UUID) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	return strings.Join(s, ",")
+}
+
+func mapPhotoErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrPhotoNotFound
+	}
+	return err
+}
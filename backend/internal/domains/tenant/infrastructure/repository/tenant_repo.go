@@ -1 +1,280 @@
-package infrasructure
\ No newline at end of file
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// TenantRepository persists tenants and the per-tenant rows that hang off them.
+type TenantRepository struct {
+	q *sqlc.Queries
+}
+
+// NewTenantRepository creates a TenantRepository using the given connection or transaction.
+func NewTenantRepository(db sqlc.DBTX) *TenantRepository {
+	return &TenantRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the tenant and returns it with its generated ID and timestamps.
+func (r *TenantRepository) Create(ctx context.Context, t *domain.Tenant) (*domain.Tenant, error) {
+	row, err := r.q.CreateTenant(ctx, t.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Tenant{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// AddMember adds the user to the tenant with the given role.
+func (r *TenantRepository) AddMember(ctx context.Context, tenantID, userID uuid.UUID, role string) error {
+	_, err := r.q.AddTenantUser(ctx, sqlc.AddTenantUserParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     role,
+	})
+	return err
+}
+
+// CreateDefaultSettings inserts the tenant_settings row with default values.
+func (r *TenantRepository) CreateDefaultSettings(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := r.q.CreateTenantSettings(ctx, sqlc.CreateTenantSettingsParams{
+		TenantID:         tenantID,
+		Theme:            domain.DefaultTheme,
+		WatermarkEnabled: domain.DefaultWatermarkEnabled,
+		WatermarkText:    sql.NullString{},
+	})
+	return err
+}
+
+// CreateStorageUsage inserts the zeroed tenant_storage_usage row.
+func (r *TenantRepository) CreateStorageUsage(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := r.q.CreateTenantStorageUsage(ctx, tenantID)
+	return err
+}
+
+// GetMemberRole returns the user's role in the tenant, or domain.ErrNotMember.
+func (r *TenantRepository) GetMemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error) {
+	row, err := r.q.GetTenantUser(ctx, sqlc.GetTenantUserParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrNotMember
+		}
+		return "", err
+	}
+	return row.Role, nil
+}
+
+// Get returns the tenant by ID, or domain.ErrTenantNotFound.
+func (r *TenantRepository) Get(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	row, err := r.q.GetTenantByID(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTenantNotFound
+		}
+		return nil, err
+	}
+	return &domain.Tenant{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// Rename updates the tenant's name.
+func (r *TenantRepository) Rename(ctx context.Context, tenantID uuid.UUID, name string) (*domain.Tenant, error) {
+	row, err := r.q.UpdateTenantName(ctx, sqlc.UpdateTenantNameParams{ID: tenantID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Tenant{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// GetSettings returns the tenant's settings.
+func (r *TenantRepository) GetSettings(ctx context.Context, tenantID uuid.UUID) (*domain.Settings, error) {
+	row, err := r.q.GetTenantSettings(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toSettings(row), nil
+}
+
+// UpdateSettings overwrites the tenant's settings.
+func (r *TenantRepository) UpdateSettings(ctx context.Context, s *domain.Settings) (*domain.Settings, error) {
+	row, err := r.q.UpdateTenantSettings(ctx, sqlc.UpdateTenantSettingsParams{
+		TenantID:          s.TenantID,
+		Theme:             s.Theme,
+		WatermarkEnabled:  s.WatermarkEnabled,
+		WatermarkText:     nullString(s.WatermarkText),
+		WatermarkType:     s.WatermarkType,
+		WatermarkPosition: s.WatermarkPosition,
+		WatermarkOpacity:  s.WatermarkOpacity,
+		WatermarkScale:    s.WatermarkScale,
+		WatermarkTiled:    s.WatermarkTiled,
+		StripGps:          s.StripGPS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSettings(row), nil
+}
+
+// SetWatermarkImage records the object key of the tenant's watermark logo; nil removes it.
+func (r *TenantRepository) SetWatermarkImage(ctx context.Context, tenantID uuid.UUID, key *string) (*domain.Settings, error) {
+	row, err := r.q.SetTenantWatermarkImage(ctx, sqlc.SetTenantWatermarkImageParams{
+		TenantID:          tenantID,
+		WatermarkImageKey: nullString(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toSettings(row), nil
+}
+
+// AddStorageUsageWithinLimit adds delta bytes to the tenant's usage unless
+// the total would exceed maxBytes, in which case ok is false and nothing changes.
+func (r *TenantRepository) AddStorageUsageWithinLimit(ctx context.Context, tenantID uuid.UUID, delta, maxBytes int64) (total int64, ok bool, err error) {
+	total, err = r.q.AddTenantStorageUsageWithinLimit(ctx, sqlc.AddTenantStorageUsageWithinLimitParams{
+		TenantID: tenantID,
+		Delta:    delta,
+		MaxBytes: maxBytes,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return total, true, nil
+}
+
+// ReleaseStorageUsage subtracts delta bytes from the tenant's usage, never
+// going below zero.
+func (r *TenantRepository) ReleaseStorageUsage(ctx context.Context, tenantID uuid.UUID, delta int64) error {
+	_, err := r.q.DecrementTenantStorageUsage(ctx, sqlc.DecrementTenantStorageUsageParams{
+		TenantID:         tenantID,
+		UsedStorageBytes: delta,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Nothing was ever counted for the tenant
+		return nil
+	}
+	return err
+}
+
+// GetStorageUsage returns the bytes currently stored by the tenant.
+func (r *TenantRepository) GetStorageUsage(ctx context.Context, tenantID uuid.UUID) (*domain.StorageUsage, error) {
+	row, err := r.q.GetTenantStorageUsage(ctx, tenantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The row is created lazily by the first upload
+		return &domain.StorageUsage{TenantID: tenantID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.StorageUsage{
+		TenantID:         tenantID,
+		UsedStorageBytes: row.UsedStorageBytes,
+		UpdatedAt:        row.UpdatedAt,
+	}, nil
+}
+
+// ListMembers returns every member of the tenant, oldest first.
+func (r *TenantRepository) ListMembers(ctx context.Context, tenantID uuid.UUID) ([]domain.Member, error) {
+	rows, err := r.q.ListTenantMembers(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]domain.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, domain.Member{
+			UserID:    row.ID,
+			Username:  row.Username,
+			Email:     row.Email,
+			Role:      row.Role,
+			JoinedAt:  row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	return members, nil
+}
+
+// GetMember returns a single member, or domain.ErrNotMember.
+func (r *TenantRepository) GetMember(ctx context.Context, tenantID, userID uuid.UUID) (*domain.Member, error) {
+	row, err := r.q.GetTenantMember(ctx, sqlc.GetTenantMemberParams{TenantID: tenantID, UserID: userID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNotMember
+		}
+		return nil, err
+	}
+	return &domain.Member{
+		UserID:    row.ID,
+		Username:  row.Username,
+		Email:     row.Email,
+		Role:      row.Role,
+		JoinedAt:  row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}, nil
+}
+
+// UpdateMemberRole changes the member's role, or returns domain.ErrNotMember.
+func (r *TenantRepository) UpdateMemberRole(ctx context.Context, tenantID, userID uuid.UUID, role string) error {
+	_, err := r.q.UpdateTenantUserRole(ctx, sqlc.UpdateTenantUserRoleParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     role,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrNotMember
+	}
+	return err
+}
+
+// RemoveMember removes the user from the tenant.
+func (r *TenantRepository) RemoveMember(ctx context.Context, tenantID, userID uuid.UUID) error {
+	return r.q.RemoveTenantUser(ctx, sqlc.RemoveTenantUserParams{TenantID: tenantID, UserID: userID})
+}
+
+// CountMembersWithRole returns how many members hold role in the tenant.
+func (r *TenantRepository) CountMembersWithRole(ctx context.Context, tenantID uuid.UUID, role string) (int64, error) {
+	return r.q.CountTenantUsersByRole(ctx, sqlc.CountTenantUsersByRoleParams{TenantID: tenantID, Role: role})
+}
+
+func toSettings(row sqlc.TenantSetting) *domain.Settings {
+	return &domain.Settings{
+		TenantID:          row.TenantID,
+		Theme:             row.Theme,
+		WatermarkEnabled:  row.WatermarkEnabled,
+		WatermarkText:     nullStringPtr(row.WatermarkText),
+		WatermarkType:     row.WatermarkType,
+		WatermarkImageKey: nullStringPtr(row.WatermarkImageKey),
+		WatermarkPosition: row.WatermarkPosition,
+		WatermarkOpacity:  row.WatermarkOpacity,
+		WatermarkScale:    row.WatermarkScale,
+		WatermarkTiled:    row.WatermarkTiled,
+		StripGPS:          row.StripGps,
+		UpdatedAt:         row.UpdatedAt,
+	}
+}
+
+// Lock takes a row lock on the tenant for the rest of the transaction so that
+// membership changes in the same tenant are serialized.
+func (r *TenantRepository) Lock(ctx context.Context, tenantID uuid.UUID) error {
+	_, err := r.q.LockTenant(ctx, tenantID)
+	return err
+}
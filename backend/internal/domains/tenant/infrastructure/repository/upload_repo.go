@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	domain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository/sqlc"
+	"github.com/google/uuid"
+)
+
+// UploadRepository persists resumable uploads with their received parts, and
+// reservations for uploads made straight to storage.
+type UploadRepository struct {
+	q *sqlc.Queries
+}
+
+// NewUploadRepository creates an UploadRepository using the given connection or transaction.
+func NewUploadRepository(db sqlc.DBTX) *UploadRepository {
+	return &UploadRepository{q: sqlc.New(db)}
+}
+
+// Create inserts the upload and returns it with its generated ID and timestamps.
+func (r *UploadRepository) Create(ctx context.Context, u *domain.Upload) (*domain.Upload, error) {
+	metadata, err := json.Marshal(u.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode upload metadata: %w", err)
+	}
+	row, err := r.q.CreateUpload(ctx, sqlc.CreateUploadParams{
+		TenantID:     u.TenantID,
+		ListingID:    u.ListingID,
+		UserID:       u.UserID,
+		Filename:     u.Filename,
+		Metadata:     metadata,
+		UploadLength: u.Length,
+		ExpiresAt:    u.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toUpload(row), nil
+}
+
+// Get returns the user's upload, or domain.ErrUploadNotFound.
+func (r *UploadRepository) Get(ctx context.Context, tenantID, userID, uploadID uuid.UUID) (*domain.Upload, error) {
+	row, err := r.q.GetUpload(ctx, sqlc.GetUploadParams{TenantID: tenantID, UserID: userID, ID: uploadID})
+	if err != nil {
+		return nil, mapUploadErr(err)
+	}
+	return toUpload(row), nil
+}
+
+// Append records part as received and moves the upload's offset past it.
+// It returns domain.ErrUploadOffsetMismatch when the upload is no longer at
+// part.Offset, has completed or has expired. It must run inside a transaction.
+func (r *UploadRepository) Append(ctx context.Context, tenantID uuid.UUID, part domain.UploadPart) (*domain.Upload, error) {
+	row, err := r.q.AdvanceUploadOffset(ctx, sqlc.AdvanceUploadOffsetParams{
+		NewOffset:      part.Offset + part.SizeBytes,
+		TenantID:       tenantID,
+		ID:             part.UploadID,
+		ExpectedOffset: part.Offset,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrUploadOffsetMismatch
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.q.AddUploadPart(ctx, sqlc.AddUploadPartParams{
+		UploadID:   part.UploadID,
+		TenantID:   tenantID,
+		PartOffset: part.Offset,
+		SizeBytes:  part.SizeBytes,
+		ObjectKey:  part.ObjectKey,
+	}); err != nil {
+		return nil, err
+	}
+	return toUpload(row), nil
+}
+
+// ListParts returns the upload's parts in offset order.
+func (r *UploadRepository) ListParts(ctx context.Context, tenantID, uploadID uuid.UUID) ([]domain.UploadPart, error) {
+	rows, err := r.q.ListUploadParts(ctx, sqlc.ListUploadPartsParams{TenantID: tenantID, UploadID: uploadID})
+	if err != nil {
+		return nil, err
+	}
+	parts := make([]domain.UploadPart, 0, len(rows))
+	for _, row := range rows {
+		parts = append(parts, domain.UploadPart{
+			UploadID:  row.UploadID,
+			Offset:    row.PartOffset,
+			SizeBytes: row.SizeBytes,
+			ObjectKey: row.ObjectKey,
+		})
+	}
+	return parts, nil
+}
+
+// Complete marks the upload as turned into the photo and drops its parts,
+// returning their object keys. It returns domain.ErrUploadComplete when
+// another request completed it first.
+func (r *UploadRepository) Complete(ctx context.Context, tenantID, uploadID, photoID uuid.UUID) (*domain.Upload, []string, error) {
+	row, err := r.q.CompleteUpload(ctx, sqlc.CompleteUploadParams{
+		TenantID: tenantID,
+		ID:       uploadID,
+		PhotoID:  uuid.NullUUID{UUID: photoID, Valid: true},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, domain.ErrUploadComplete
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, err := r.q.DeleteUploadParts(ctx, sqlc.DeleteUploadPartsParams{TenantID: tenantID, UploadID: uploadID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return toUpload(row), keys, nil
+}
+
+// Delete removes the user's upload and returns the object keys of its
+// parts, or domain.ErrUploadNotFound. It must run inside a transaction.
+func (r *UploadRepository) Delete(ctx context.Context, tenantID, userID, uploadID uuid.UUID) ([]string, error) {
+	keys, err := r.q.DeleteUploadParts(ctx, sqlc.DeleteUploadPartsParams{TenantID: tenantID, UploadID: uploadID})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.q.DeleteUpload(ctx, sqlc.DeleteUploadParams{TenantID: tenantID, UserID: userID, ID: uploadID}); err != nil {
+		return nil, mapUploadErr(err)
+	}
+	return keys, nil
+}
+
+// ListExpired returns up to limit uploads of any tenant that are past their
+// expiry, oldest first. Only ID and TenantID are set.
+func (r *UploadRepository) ListExpired(ctx context.Context, limit int32) ([]domain.Upload, error) {
+	rows, err := r.q.ListExpiredUploads(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	uploads := make([]domain.Upload, 0, len(rows))
+	for _, row := range rows {
+		uploads = append(uploads, domain.Upload{ID: row.ID, TenantID: row.TenantID})
+	}
+	return uploads, nil
+}
+
+// DeleteExpired removes an upload that is past its expiry and returns the
+// object keys of its parts, or domain.ErrUploadNotFound. It must run inside
+// a transaction.
+func (r *UploadRepository) DeleteExpired(ctx context.Context, tenantID, uploadID uuid.UUID) ([]string, error) {
+	keys, err := r.q.DeleteUploadParts(ctx, sqlc.DeleteUploadPartsParams{TenantID: tenantID, UploadID: uploadID})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.q.DeleteExpiredUpload(ctx, sqlc.DeleteExpiredUploadParams{TenantID: tenantID, ID: uploadID}); err != nil {
+		return nil, mapUploadErr(err)
+	}
+	return keys, nil
+}
+
+// CreateReservation inserts the upload reservation and returns it with its
+// generated ID and timestamps.
+func (r *UploadRepository) CreateReservation(ctx context.Context, res *domain.UploadReservation) (*domain.UploadReservation, error) {
+	row, err := r.q.CreateUploadReservation(ctx, sqlc.CreateUploadReservationParams{
+		TenantID:      res.TenantID,
+		ListingID:     res.ListingID,
+		UserID:        res.UserID,
+		Filename:      res.Filename,
+		ObjectKey:     res.ObjectKey,
+		MimeType:      res.MimeType,
+		FileSizeBytes: res.SizeBytes,
+		ExpiresAt:     res.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toReservation(row), nil
+}
+
+// GetReservation returns the user's upload reservation, or domain.ErrReservationNotFound.
+func (r *UploadRepository) GetReservation(ctx context.Context, tenantID, userID, reservationID uuid.UUID) (*domain.UploadReservation, error) {
+	row, err := r.q.GetUploadReservation(ctx, sqlc.GetUploadReservationParams{TenantID: tenantID, UserID: userID, ID: reservationID})
+	if err != nil {
+		return nil, mapReservationErr(err)
+	}
+	return toReservation(row), nil
+}
+
+// CompleteReservation marks the reservation as turned into the photo. It
+// returns domain.ErrReservationComplete when the reservation was completed
+// by another request or has expired in the meantime.
+func (r *UploadRepository) CompleteReservation(ctx context.Context, tenantID, reservationID, photoID uuid.UUID) (*domain.UploadReservation, error) {
+	row, err := r.q.CompleteUploadReservation(ctx, sqlc.CompleteUploadReservationParams{
+		TenantID: tenantID,
+		ID:       reservationID,
+		PhotoID:  uuid.NullUUID{UUID: photoID, Valid: true},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrReservationComplete
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toReservation(row), nil
+}
+
+// ListExpiredReservations returns up to limit reservations of any tenant
+// that are past their expiry, oldest first. Only ID and TenantID are set.
+func (r *UploadRepository) ListExpiredReservations(ctx context.Context, limit int32) ([]domain.UploadReservation, error) {
+	rows, err := r.q.ListExpiredUploadReservations(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	reservations := make([]domain.UploadReservation, 0, len(rows))
+	for _, row := range rows {
+		reservations = append(reservations, domain.UploadReservation{ID: row.ID, TenantID: row.TenantID})
+	}
+	return reservations, nil
+}
+
+// DeleteExpiredReservation removes a reservation that is past its expiry and
+// returns it, or domain.ErrReservationNotFound.
+func (r *UploadRepository) DeleteExpiredReservation(ctx context.Context, tenantID, reservationID uuid.UUID) (*domain.UploadReservation, error) {
+	row, err := r.q.DeleteExpiredUploadReservation(ctx, sqlc.DeleteExpiredUploadReservationParams{TenantID: tenantID, ID: reservationID})
+	if err != nil {
+		return nil, mapReservationErr(err)
+	}
+	return toReservation(row), nil
+}
+
+func mapUploadErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrUploadNotFound
+	}
+	return err
+}
+
+func toUpload(row sqlc.Upload) *domain.Upload {
+	u := &domain.Upload{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		ListingID:   row.ListingID,
+		UserID:      row.UserID,
+		Filename:    row.Filename,
+		Length:      row.UploadLength,
+		Offset:      row.UploadOffset,
+		CompletedAt: nullTimePtr(row.CompletedAt),
+		ExpiresAt:   row.ExpiresAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.PhotoID.Valid {
+		u.PhotoID = &row.PhotoID.UUID
+	}
+	// The column is only ever written from a string map, so a decode failure
+	// just leaves the metadata empty
+	_ = json.Unmarshal(row.Metadata, &u.Metadata)
+	return u
+}
+
+func mapReservationErr(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.ErrReservationNotFound
+	}
+	return err
+}
+
+func toReservation(row sqlc.UploadReservation) *domain.UploadReservation {
+	res := &domain.UploadReservation{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		ListingID:   row.ListingID,
+		UserID:      row.UserID,
+		Filename:    row.Filename,
+		ObjectKey:   row.ObjectKey,
+		MimeType:    row.MimeType,
+		SizeBytes:   row.FileSizeBytes,
+		CompletedAt: nullTimePtr(row.CompletedAt),
+		ExpiresAt:   row.ExpiresAt,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+	if row.PhotoID.Valid {
+		res.PhotoID = &row.PhotoID.UUID
+	}
+	return res
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATEs raised when a UNIQUE or FOREIGN KEY constraint is violated.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// UniqueViolation reports whether err is a unique constraint violation and, if so,
+// the name of the violated constraint.
+func UniqueViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return pgErr.ConstraintName, true
+	}
+	return "", false
+}
+
+// ForeignKeyViolation reports whether err is a foreign key violation and, if
+// so, the name of the violated constraint.
+func ForeignKeyViolation(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return pgErr.ConstraintName, true
+	}
+	return "", false
+}
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_auth_sessions_family;
+
+ALTER TABLE auth_sessions
+    DROP COLUMN IF EXISTS revoked_at,
+    DROP COLUMN IF EXISTS rotated_at,
+    DROP COLUMN IF EXISTS family_id;
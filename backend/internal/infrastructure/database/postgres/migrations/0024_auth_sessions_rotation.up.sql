@@ -0,0 +1,10 @@
+-- Refresh tokens are rotated on every use. All tokens descending from the
+-- same login share a family_id so that replaying a rotated token can revoke
+-- the whole chain. refresh_token stores the SHA-256 hash of the opaque token.
+ALTER TABLE auth_sessions
+    ADD COLUMN IF NOT EXISTS family_id UUID NOT NULL DEFAULT gen_random_uuid(),
+    ADD COLUMN IF NOT EXISTS rotated_at TIMESTAMPTZ DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_family
+    ON auth_sessions(family_id);
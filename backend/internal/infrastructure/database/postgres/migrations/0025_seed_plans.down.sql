@@ -0,0 +1,4 @@
+DELETE FROM plans
+WHERE billing_cycle = 'monthly'
+  AND type IN ('free', 'basic', 'business')
+  AND NOT EXISTS (SELECT 1 FROM subscriptions s WHERE s.plan_id = plans.id);
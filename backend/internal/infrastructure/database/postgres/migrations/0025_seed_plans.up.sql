@@ -0,0 +1,23 @@
+-- Default plans and their limits. Signup subscribes every new tenant to the free plan.
+INSERT INTO plans (type, price, billing_cycle)
+SELECT v.type, v.price, 'monthly'
+FROM (VALUES
+    ('free', 0.00),
+    ('basic', 9.99),
+    ('business', 29.99)
+) AS v(type, price)
+WHERE NOT EXISTS (
+    SELECT 1 FROM plans p WHERE p.type = v.type AND p.billing_cycle = 'monthly'
+);
+
+INSERT INTO plan_limits (plan_id, max_storage_bytes, max_upload_bytes, max_listings, max_listing_photos)
+SELECT p.id, v.max_storage_bytes, v.max_upload_bytes, v.max_listings, v.max_listing_photos
+FROM plans p
+JOIN (VALUES
+    ('free',     1073741824::BIGINT,   20971520::BIGINT,   5,     50),   -- 1 GiB, 20 MiB
+    ('basic',    53687091200::BIGINT,  104857600::BIGINT,  100,   500),  -- 50 GiB, 100 MiB
+    ('business', 536870912000::BIGINT, 5368709120::BIGINT, 10000, 5000)  -- 500 GiB, 5 GiB
+) AS v(type, max_storage_bytes, max_upload_bytes, max_listings, max_listing_photos)
+    ON v.type = p.type
+WHERE p.billing_cycle = 'monthly'
+ON CONFLICT (plan_id) DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_login_throttles_updated_at ON login_throttles;
+DROP TABLE IF EXISTS login_throttles;
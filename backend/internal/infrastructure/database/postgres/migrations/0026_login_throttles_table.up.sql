@@ -0,0 +1,35 @@
+-- Failed login tracking for brute-force lockout. One row per user and per
+-- source IP; kept in the database so locks survive API restarts.
+CREATE TABLE IF NOT EXISTS login_throttles (
+    scope TEXT NOT NULL
+        CONSTRAINT login_throttles_scope_check CHECK (scope IN ('user', 'ip')),
+
+    subject TEXT NOT NULL,  -- user id or IP address
+
+    failed_attempts INT NOT NULL DEFAULT 0,
+    lockout_count INT NOT NULL DEFAULT 0,
+
+    last_failed_at TIMESTAMPTZ DEFAULT NULL,
+    locked_until TIMESTAMPTZ DEFAULT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_login_throttles_failed_attempts_positive
+        CHECK (failed_attempts >= 0),
+
+    CONSTRAINT chk_login_throttles_lockout_count_positive
+        CHECK (lockout_count >= 0),
+
+    PRIMARY KEY (scope, subject)
+);
+
+-- Trigger to keep updated_at fresh
+CREATE TRIGGER trg_login_throttles_updated_at
+BEFORE UPDATE ON login_throttles
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Cleanup of stale rows
+CREATE INDEX idx_login_throttles_last_failed_at
+    ON login_throttles(last_failed_at);
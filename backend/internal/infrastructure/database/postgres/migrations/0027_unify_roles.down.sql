@@ -0,0 +1,39 @@
+ALTER TABLE tenant_users DROP CONSTRAINT IF EXISTS fk_tenant_users_role;
+
+-- Restore user_roles from the memberships
+CREATE TABLE IF NOT EXISTS user_roles (
+    id UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT uq_user_role_tenant UNIQUE (user_id, role_id, tenant_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_tenant
+    ON user_roles(tenant_id);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_tenant_user
+    ON user_roles(user_id, tenant_id);
+
+INSERT INTO user_roles (user_id, role_id, tenant_id)
+SELECT tu.user_id, r.id, tu.tenant_id
+FROM tenant_users tu
+JOIN roles r ON r.name = tu.role
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS uq_roles_name;
+
+-- Map catalog names back to the original membership roles
+UPDATE tenant_users
+SET role = CASE role
+    WHEN 'super_admin' THEN 'admin'
+    WHEN 'tenant_admin' THEN 'admin'
+    WHEN 'tenant_editor' THEN 'editor'
+    ELSE role
+END;
+
+ALTER TABLE tenant_users
+    ADD CONSTRAINT tenant_user_role_check CHECK (role IN ('admin', 'editor', 'viewer'));
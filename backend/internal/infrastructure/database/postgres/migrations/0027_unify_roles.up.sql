@@ -0,0 +1,48 @@
+-- tenant_users.role becomes the single source of truth for a member's role.
+-- roles stays as the catalog of valid role names and user_roles is retired.
+
+-- Rename membership roles to the catalog names
+ALTER TABLE tenant_users DROP CONSTRAINT IF EXISTS tenant_user_role_check;
+
+UPDATE tenant_users
+SET role = CASE role
+    WHEN 'admin' THEN 'tenant_admin'
+    WHEN 'editor' THEN 'tenant_editor'
+    ELSE role
+END
+WHERE role IN ('admin', 'editor');
+
+-- Fold user_roles into tenant_users, keeping the most privileged role per member
+INSERT INTO tenant_users (tenant_id, user_id, role)
+SELECT DISTINCT ON (ur.tenant_id, ur.user_id) ur.tenant_id, ur.user_id, r.name
+FROM user_roles ur
+JOIN roles r ON r.id = ur.role_id
+ORDER BY ur.tenant_id, ur.user_id,
+    array_position(ARRAY['super_admin', 'tenant_admin', 'tenant_editor', 'viewer'], r.name)
+ON CONFLICT (tenant_id, user_id) DO UPDATE
+SET role = EXCLUDED.role
+WHERE array_position(ARRAY['super_admin', 'tenant_admin', 'tenant_editor', 'viewer'], EXCLUDED.role)
+    < array_position(ARRAY['super_admin', 'tenant_admin', 'tenant_editor', 'viewer'], tenant_users.role);
+
+DROP TABLE IF EXISTS user_roles;
+
+-- Make the role catalog complete and unique
+DELETE FROM roles a
+USING roles b
+WHERE a.name = b.name
+  AND (a.created_at, a.id) > (b.created_at, b.id);
+
+INSERT INTO roles (name)
+SELECT v.name
+FROM (VALUES ('super_admin'), ('tenant_admin'), ('tenant_editor'), ('viewer')) AS v(name)
+WHERE NOT EXISTS (
+    SELECT 1 FROM roles r WHERE r.name = v.name
+);
+
+ALTER TABLE roles
+    ADD CONSTRAINT uq_roles_name UNIQUE (name);
+
+-- Every membership must name a catalogued role
+ALTER TABLE tenant_users
+    ADD CONSTRAINT fk_tenant_users_role
+    FOREIGN KEY (role) REFERENCES roles(name) ON UPDATE CASCADE;
@@ -0,0 +1,4 @@
+-- Keys are left as they are; they remain valid relative URLs.
+ALTER TABLE files RENAME COLUMN thumbnail_key TO thumbnail_url;
+ALTER TABLE files RENAME COLUMN watermarked_key TO watermarked_url;
+ALTER TABLE files RENAME COLUMN original_key TO original_url;
@@ -0,0 +1,11 @@
+-- files used to hold URLs tied to one storage backend. They now hold object
+-- keys (tenants/<tenant_id>/...) and URLs are produced by the backend on demand.
+ALTER TABLE files RENAME COLUMN original_url TO original_key;
+ALTER TABLE files RENAME COLUMN watermarked_url TO watermarked_key;
+ALTER TABLE files RENAME COLUMN thumbnail_url TO thumbnail_key;
+
+-- Strip whatever host or path prefix was stored in front of the key
+UPDATE files
+SET original_key = regexp_replace(original_key, '^.*?(tenants/)', '\1'),
+    watermarked_key = regexp_replace(watermarked_key, '^.*?(tenants/)', '\1'),
+    thumbnail_key = regexp_replace(thumbnail_key, '^.*?(tenants/)', '\1');
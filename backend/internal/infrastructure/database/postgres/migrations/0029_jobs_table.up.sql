@@ -0,0 +1,59 @@
+-- Durable background job queue consumed by cmd/worker. Workers claim queued
+-- jobs with FOR UPDATE SKIP LOCKED; failed jobs are retried with backoff until
+-- max_attempts, after which they are parked in the 'dead' state for inspection.
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID DEFAULT NULL REFERENCES tenants(id) ON DELETE CASCADE,  -- NULL for system jobs
+
+    type TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+    status TEXT NOT NULL DEFAULT 'queued'
+        CONSTRAINT jobs_status_check CHECK (status IN ('queued', 'running', 'succeeded', 'dead')),
+
+    -- Optional key collapsing duplicate queued jobs (e.g. one re-render per photo)
+    unique_key TEXT DEFAULT NULL,
+
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL DEFAULT 5,
+
+    run_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    locked_at TIMESTAMPTZ DEFAULT NULL,
+    locked_by TEXT DEFAULT NULL,
+    last_error TEXT DEFAULT NULL,
+    finished_at TIMESTAMPTZ DEFAULT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_jobs_attempts_positive
+        CHECK (attempts >= 0),
+
+    CONSTRAINT chk_jobs_max_attempts_positive
+        CHECK (max_attempts > 0)
+);
+
+-- Trigger to keep updated_at fresh
+CREATE TRIGGER trg_jobs_updated_at
+BEFORE UPDATE ON jobs
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Claim scan: due queued jobs in run order
+CREATE INDEX idx_jobs_queued_run_at
+    ON jobs(run_at, id)
+    WHERE status = 'queued';
+
+-- Per-tenant concurrency checks and stale lease recovery
+CREATE INDEX idx_jobs_running_tenant
+    ON jobs(tenant_id, locked_at)
+    WHERE status = 'running';
+
+-- At most one queued job per unique key
+CREATE UNIQUE INDEX uq_jobs_queued_unique_key
+    ON jobs(unique_key)
+    WHERE status = 'queued' AND unique_key IS NOT NULL;
+
+-- Dead-letter inspection and cleanup of finished jobs
+CREATE INDEX idx_jobs_status_finished_at
+    ON jobs(status, finished_at);
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_file_variants_updated_at ON file_variants;
+DROP TABLE IF EXISTS file_variants;
@@ -0,0 +1,37 @@
+-- Resized renditions of an uploaded photo, one per configured width. The API
+-- turns them into a srcset so clients can pick the smallest adequate image.
+CREATE TABLE IF NOT EXISTS file_variants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    file_id UUID NOT NULL REFERENCES files(id) ON DELETE CASCADE,
+
+    width INT NOT NULL,
+    height INT NOT NULL,
+
+    object_key TEXT NOT NULL,
+    file_size_bytes BIGINT NOT NULL,
+    mime_type TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_file_variants_dimensions_positive
+        CHECK (width > 0 AND height > 0),
+
+    CONSTRAINT chk_file_variants_size_positive
+        CHECK (file_size_bytes >= 0),
+
+    CONSTRAINT uq_file_variants_file_width
+        UNIQUE (file_id, width)
+);
+
+-- Trigger to keep updated_at fresh
+CREATE TRIGGER trg_file_variants_updated_at
+BEFORE UPDATE ON file_variants
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Tenant-scoped lookups
+CREATE INDEX idx_file_variants_tenant_file
+    ON file_variants(tenant_id, file_id);
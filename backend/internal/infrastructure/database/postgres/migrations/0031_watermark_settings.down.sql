@@ -0,0 +1,7 @@
+ALTER TABLE tenant_settings
+    DROP COLUMN IF EXISTS watermark_tiled,
+    DROP COLUMN IF EXISTS watermark_scale,
+    DROP COLUMN IF EXISTS watermark_opacity,
+    DROP COLUMN IF EXISTS watermark_position,
+    DROP COLUMN IF EXISTS watermark_image_key,
+    DROP COLUMN IF EXISTS watermark_type;
@@ -0,0 +1,17 @@
+-- Watermark rendering options. watermark_type picks between drawing
+-- watermark_text (or the tenant name) and compositing the uploaded logo at
+-- watermark_image_key. Scale is the watermark width as a fraction of the photo's.
+ALTER TABLE tenant_settings
+    ADD COLUMN IF NOT EXISTS watermark_type TEXT NOT NULL DEFAULT 'text'
+        CONSTRAINT tenant_settings_watermark_type_check CHECK (watermark_type IN ('text', 'image')),
+    ADD COLUMN IF NOT EXISTS watermark_image_key TEXT DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS watermark_position TEXT NOT NULL DEFAULT 'bottom_right'
+        CONSTRAINT tenant_settings_watermark_position_check
+        CHECK (watermark_position IN ('top_left', 'top_right', 'bottom_left', 'bottom_right', 'center')),
+    ADD COLUMN IF NOT EXISTS watermark_opacity DOUBLE PRECISION NOT NULL DEFAULT 0.5
+        CONSTRAINT chk_tenant_settings_watermark_opacity_range
+        CHECK (watermark_opacity > 0 AND watermark_opacity <= 1),
+    ADD COLUMN IF NOT EXISTS watermark_scale DOUBLE PRECISION NOT NULL DEFAULT 0.25
+        CONSTRAINT chk_tenant_settings_watermark_scale_range
+        CHECK (watermark_scale > 0 AND watermark_scale <= 1),
+    ADD COLUMN IF NOT EXISTS watermark_tiled BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+ALTER TABLE tenant_settings
+    DROP COLUMN IF EXISTS strip_gps;
+
+DROP TRIGGER IF EXISTS trg_file_metadata_updated_at ON file_metadata;
+DROP TABLE IF EXISTS file_metadata;
@@ -0,0 +1,65 @@
+-- Capture metadata read from an uploaded photo's EXIF and IPTC blocks. Typed
+-- columns hold what photographers filter and sort by; the remaining IPTC
+-- fields (title, caption, keywords, creator, ...) are kept as JSON.
+-- width/height are of the upright image, after the EXIF orientation.
+CREATE TABLE IF NOT EXISTS file_metadata (
+    file_id UUID PRIMARY KEY REFERENCES files(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    width INT NOT NULL,
+    height INT NOT NULL,
+    orientation INT NOT NULL DEFAULT 1,
+
+    captured_at TIMESTAMPTZ DEFAULT NULL,
+    camera_make TEXT DEFAULT NULL,
+    camera_model TEXT DEFAULT NULL,
+    lens_model TEXT DEFAULT NULL,
+    focal_length_mm DOUBLE PRECISION DEFAULT NULL,
+    f_number DOUBLE PRECISION DEFAULT NULL,
+    exposure_time_seconds DOUBLE PRECISION DEFAULT NULL,
+    iso INT DEFAULT NULL,
+
+    artist TEXT DEFAULT NULL,
+    copyright TEXT DEFAULT NULL,
+    description TEXT DEFAULT NULL,
+
+    -- Never copied into public renditions unless the tenant allows it
+    gps_latitude DOUBLE PRECISION DEFAULT NULL,
+    gps_longitude DOUBLE PRECISION DEFAULT NULL,
+
+    iptc JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_file_metadata_dimensions_positive
+        CHECK (width > 0 AND height > 0),
+
+    CONSTRAINT chk_file_metadata_orientation_range
+        CHECK (orientation BETWEEN 1 AND 8),
+
+    CONSTRAINT chk_file_metadata_gps_pair
+        CHECK ((gps_latitude IS NULL) = (gps_longitude IS NULL))
+);
+
+-- Trigger to keep updated_at fresh
+CREATE TRIGGER trg_file_metadata_updated_at
+BEFORE UPDATE ON file_metadata
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Browsing a tenant's photos by capture time or camera
+CREATE INDEX idx_file_metadata_tenant_captured_at
+    ON file_metadata(tenant_id, captured_at);
+
+CREATE INDEX idx_file_metadata_tenant_camera
+    ON file_metadata(tenant_id, camera_make, camera_model);
+
+-- Keyword and caption search
+CREATE INDEX idx_file_metadata_iptc
+    ON file_metadata USING GIN (iptc);
+
+-- Whether public renditions keep the photo's GPS location. Other sensitive
+-- tags such as serial numbers and maker notes are always stripped.
+ALTER TABLE tenant_settings
+    ADD COLUMN IF NOT EXISTS strip_gps BOOLEAN NOT NULL DEFAULT TRUE;
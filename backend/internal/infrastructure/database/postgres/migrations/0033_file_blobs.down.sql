@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_files_tenant_content_sha256;
+
+ALTER TABLE files
+    DROP CONSTRAINT IF EXISTS fk_files_blob,
+    DROP COLUMN IF EXISTS content_sha256;
+
+DROP TRIGGER IF EXISTS trg_file_blobs_updated_at ON file_blobs;
+DROP TABLE IF EXISTS file_blobs;
@@ -0,0 +1,47 @@
+-- Content-addressed originals. Identical bytes uploaded into several listings
+-- of a tenant are stored once; every files row pointing at the blob is one
+-- reference, and listing_photos reach the blob through their file. The
+-- tenant's storage usage counts each blob once, and its object is only
+-- deleted when ref_count drops to zero.
+CREATE TABLE IF NOT EXISTS file_blobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    content_sha256 TEXT NOT NULL
+        CONSTRAINT chk_file_blobs_content_sha256_format CHECK (content_sha256 ~ '^[0-9a-f]{64}$'),
+
+    object_key TEXT NOT NULL,
+    file_size_bytes BIGINT NOT NULL,
+    mime_type TEXT NOT NULL,
+
+    ref_count INT NOT NULL DEFAULT 1,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_file_blobs_size_positive
+        CHECK (file_size_bytes >= 0),
+
+    CONSTRAINT chk_file_blobs_ref_count_positive
+        CHECK (ref_count >= 0),
+
+    CONSTRAINT uq_file_blobs_tenant_content
+        UNIQUE (tenant_id, content_sha256)
+);
+
+-- Trigger to keep updated_at fresh
+CREATE TRIGGER trg_file_blobs_updated_at
+BEFORE UPDATE ON file_blobs
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Files uploaded before hashing keep a NULL digest and own their object
+ALTER TABLE files
+    ADD COLUMN IF NOT EXISTS content_sha256 TEXT DEFAULT NULL,
+    ADD CONSTRAINT fk_files_blob
+        FOREIGN KEY (tenant_id, content_sha256)
+        REFERENCES file_blobs (tenant_id, content_sha256);
+
+CREATE INDEX idx_files_tenant_content_sha256
+    ON files(tenant_id, content_sha256)
+    WHERE content_sha256 IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS upload_parts;
+DROP TRIGGER IF EXISTS trg_uploads_updated_at ON uploads;
+DROP TABLE IF EXISTS uploads;
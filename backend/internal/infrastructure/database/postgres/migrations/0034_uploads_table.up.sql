@@ -0,0 +1,65 @@
+-- Resumable (tus) uploads in progress. Each PATCH stores its bytes as a
+-- separate part object and advances upload_offset; once it reaches
+-- upload_length the parts are streamed through the normal photo upload and
+-- photo_id is set. Sessions are removed by the worker after expires_at.
+CREATE TABLE IF NOT EXISTS uploads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    listing_id UUID NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    filename TEXT NOT NULL DEFAULT '',
+    metadata JSONB NOT NULL DEFAULT '{}'::jsonb,  -- tus Upload-Metadata, decoded
+
+    upload_length BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+
+    photo_id UUID DEFAULT NULL REFERENCES listing_photos(id) ON DELETE SET NULL,
+    completed_at TIMESTAMPTZ DEFAULT NULL,
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_uploads_timestamps
+        CHECK (updated_at >= created_at),
+
+    CONSTRAINT chk_uploads_length_positive
+        CHECK (upload_length > 0),
+
+    CONSTRAINT chk_uploads_offset_range
+        CHECK (upload_offset >= 0 AND upload_offset <= upload_length)
+);
+
+CREATE TRIGGER trg_uploads_updated_at
+BEFORE UPDATE ON uploads
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- The bytes received by one PATCH, stored at object_key
+CREATE TABLE IF NOT EXISTS upload_parts (
+    upload_id UUID NOT NULL REFERENCES uploads(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    part_offset BIGINT NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    object_key TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (upload_id, part_offset),
+
+    CONSTRAINT chk_upload_parts_offset_positive
+        CHECK (part_offset >= 0),
+
+    CONSTRAINT chk_upload_parts_size_positive
+        CHECK (size_bytes > 0)
+);
+
+-- Expiry sweep
+CREATE INDEX idx_uploads_expires_at
+    ON uploads(expires_at);
+
+CREATE INDEX idx_uploads_tenant_user
+    ON uploads(tenant_id, user_id);
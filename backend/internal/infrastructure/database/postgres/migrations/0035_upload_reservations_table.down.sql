@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_upload_reservations_updated_at ON upload_reservations;
+DROP TABLE IF EXISTS upload_reservations;
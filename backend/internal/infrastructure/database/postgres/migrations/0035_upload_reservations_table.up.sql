@@ -0,0 +1,39 @@
+-- Direct-to-storage uploads: the client PUTs the photo to a presigned URL
+-- for object_key, then asks for it to be added to the listing. The declared
+-- size counts against the tenant's storage from the moment of reservation;
+-- reservations never completed are removed by the worker after expires_at,
+-- releasing that storage.
+CREATE TABLE IF NOT EXISTS upload_reservations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    listing_id UUID NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    filename TEXT NOT NULL DEFAULT '',
+    object_key TEXT NOT NULL UNIQUE,
+    mime_type VARCHAR(100) NOT NULL,
+    file_size_bytes BIGINT NOT NULL,
+
+    photo_id UUID DEFAULT NULL REFERENCES listing_photos(id) ON DELETE SET NULL,
+    completed_at TIMESTAMPTZ DEFAULT NULL,
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_upload_reservations_timestamps
+        CHECK (updated_at >= created_at),
+
+    CONSTRAINT chk_upload_reservations_size_positive
+        CHECK (file_size_bytes > 0)
+);
+
+CREATE TRIGGER trg_upload_reservations_updated_at
+BEFORE UPDATE ON upload_reservations
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Expiry sweep
+CREATE INDEX idx_upload_reservations_expires_at
+    ON upload_reservations(expires_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_listing_photos_file;
+DROP INDEX IF EXISTS idx_listing_photos_deleted_at;
+DROP INDEX IF EXISTS idx_listings_deleted_at;
+DROP INDEX IF EXISTS idx_listing_photos_tenant_deleted_at;
+DROP INDEX IF EXISTS idx_listings_tenant_deleted_at;
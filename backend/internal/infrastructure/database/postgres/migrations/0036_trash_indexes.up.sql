@@ -0,0 +1,20 @@
+-- Trash listings and the retention purge scan soft-deleted rows only
+CREATE INDEX idx_listings_tenant_deleted_at
+    ON listings(tenant_id, deleted_at DESC)
+    WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX idx_listing_photos_tenant_deleted_at
+    ON listing_photos(tenant_id, deleted_at DESC)
+    WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX idx_listings_deleted_at
+    ON listings(deleted_at)
+    WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX idx_listing_photos_deleted_at
+    ON listing_photos(deleted_at)
+    WHERE deleted_at IS NOT NULL;
+
+-- A purged photo's file goes once no listing shows it any more
+CREATE INDEX idx_listing_photos_file
+    ON listing_photos(file_id);
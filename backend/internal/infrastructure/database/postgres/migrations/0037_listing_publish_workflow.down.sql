@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS idx_listings_unpublish_at;
+DROP INDEX IF EXISTS idx_listings_publish_at;
+
+ALTER TABLE listings
+    DROP CONSTRAINT IF EXISTS chk_listing_schedule,
+    DROP CONSTRAINT IF EXISTS listing_status_check;
+
+UPDATE listings
+SET status = 'draft'
+WHERE status IN ('review', 'archived');
+
+ALTER TABLE listings
+    DROP COLUMN IF EXISTS unpublish_at,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS published_at,
+    ADD CONSTRAINT listing_status_check CHECK (status IN ('draft', 'published'));
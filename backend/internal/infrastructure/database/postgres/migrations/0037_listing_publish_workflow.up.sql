@@ -0,0 +1,27 @@
+-- Listings move through draft -> review -> published -> archived. publish_at
+-- and unpublish_at schedule a transition the worker makes once they pass;
+-- published_at records when the listing last went live.
+ALTER TABLE listings
+    DROP CONSTRAINT IF EXISTS listing_status_check;
+
+ALTER TABLE listings
+    ADD CONSTRAINT listing_status_check
+        CHECK (status IN ('draft', 'review', 'published', 'archived')),
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS unpublish_at TIMESTAMPTZ DEFAULT NULL,
+    ADD CONSTRAINT chk_listing_schedule
+        CHECK (publish_at IS NULL OR unpublish_at IS NULL OR unpublish_at > publish_at);
+
+UPDATE listings
+SET published_at = updated_at
+WHERE status = 'published';
+
+-- Schedule sweeps
+CREATE INDEX idx_listings_publish_at
+    ON listings(publish_at)
+    WHERE publish_at IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX idx_listings_unpublish_at
+    ON listings(unpublish_at)
+    WHERE unpublish_at IS NOT NULL AND deleted_at IS NULL;
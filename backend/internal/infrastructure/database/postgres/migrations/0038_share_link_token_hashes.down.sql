@@ -0,0 +1,5 @@
+-- Irreversible: hashed share tokens cannot be turned back into the tokens
+-- visitors hold, so links issued while they were hashed stop resolving and
+-- must be reissued. The rows are kept rather than deleted; rerunning the up
+-- migration skips their already hashed tokens.
+SELECT 1;
@@ -0,0 +1,7 @@
+-- share_links.token now stores the hex encoded SHA-256 hash of the share
+-- token, like auth_sessions.refresh_token. Links issued before keep working
+-- once their stored plaintext is hashed. Plaintext tokens are base64url, so
+-- tokens already hashed by an earlier run are left alone.
+UPDATE share_links
+SET token = encode(sha256(convert_to(token, 'UTF8')), 'hex')
+WHERE token !~ '^[0-9a-f]{64}$';
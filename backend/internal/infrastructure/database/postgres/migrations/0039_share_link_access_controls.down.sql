@@ -0,0 +1,15 @@
+DELETE FROM login_throttles
+WHERE scope = 'share_link';
+
+ALTER TABLE login_throttles
+    DROP CONSTRAINT IF EXISTS login_throttles_scope_check;
+
+ALTER TABLE login_throttles
+    ADD CONSTRAINT login_throttles_scope_check
+        CHECK (scope IN ('user', 'ip'));
+
+DROP TABLE IF EXISTS share_sessions;
+DROP TABLE IF EXISTS share_link_recipients;
+
+ALTER TABLE share_links
+    DROP COLUMN IF EXISTS password_hash;
@@ -0,0 +1,42 @@
+-- Gated share links: a bcrypt password_hash, an allow-list of recipient
+-- emails, or both. Visitors unlock a gated link once and are then
+-- recognised by a short-lived viewer session, whose token is stored hashed
+-- like share_links.token. Failed unlocks are throttled per link in
+-- login_throttles under the share_link scope.
+ALTER TABLE share_links
+    ADD COLUMN IF NOT EXISTS password_hash TEXT DEFAULT NULL;
+
+CREATE TABLE IF NOT EXISTS share_link_recipients (
+    share_link_id UUID NOT NULL REFERENCES share_links(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,  -- lower-cased
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (share_link_id, email)
+);
+
+CREATE TABLE IF NOT EXISTS share_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    share_link_id UUID NOT NULL REFERENCES share_links(id) ON DELETE CASCADE,
+
+    token TEXT NOT NULL UNIQUE,  -- SHA-256 hash of the session token
+    email TEXT DEFAULT NULL,     -- recipient that unlocked the link, if asked
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_share_sessions_expiry
+        CHECK (expires_at > created_at)
+);
+
+-- Expiry sweep
+CREATE INDEX idx_share_sessions_expires_at
+    ON share_sessions(expires_at);
+
+ALTER TABLE login_throttles
+    DROP CONSTRAINT IF EXISTS login_throttles_scope_check;
+
+ALTER TABLE login_throttles
+    ADD CONSTRAINT login_throttles_scope_check
+        CHECK (scope IN ('user', 'ip', 'share_link'));
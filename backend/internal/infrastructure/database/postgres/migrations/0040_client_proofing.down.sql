@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS selection_submissions;
+DROP TRIGGER IF EXISTS trg_photo_selections_updated_at ON photo_selections;
+DROP TABLE IF EXISTS photo_selections;
+
+ALTER TABLE files
+    DROP COLUMN IF EXISTS filename;
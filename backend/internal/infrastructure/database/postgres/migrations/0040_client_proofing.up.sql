@@ -0,0 +1,60 @@
+-- Files keep the name they were uploaded under, so clients and
+-- photographers can refer to photos by it. Files stored before have ''.
+ALTER TABLE files
+    ADD COLUMN IF NOT EXISTS filename TEXT NOT NULL DEFAULT '';
+
+-- Client proofing: the favorite mark, rating and comment a share link's
+-- visitors give each photo. Everyone holding the link proofs together, so
+-- there is one row per link and photo; rows without any mark are deleted.
+CREATE TABLE IF NOT EXISTS photo_selections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    share_link_id UUID NOT NULL REFERENCES share_links(id) ON DELETE CASCADE,
+    listing_id UUID NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    photo_id UUID NOT NULL REFERENCES listing_photos(id) ON DELETE CASCADE,
+
+    is_favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    rating INT DEFAULT NULL
+        CONSTRAINT chk_photo_selections_rating CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT DEFAULT NULL,
+
+    updated_by TEXT DEFAULT NULL,  -- recipient email of the last change, if known
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_photo_selections_timestamps
+        CHECK (updated_at >= created_at),
+
+    UNIQUE (share_link_id, photo_id)
+);
+
+CREATE TRIGGER trg_photo_selections_updated_at
+BEFORE UPDATE ON photo_selections
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+CREATE INDEX idx_photo_selections_listing
+    ON photo_selections(listing_id);
+
+-- A share link's final selection. Submitting freezes the link's
+-- photo_selections.
+CREATE TABLE IF NOT EXISTS selection_submissions (
+    share_link_id UUID PRIMARY KEY REFERENCES share_links(id) ON DELETE CASCADE,
+
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    listing_id UUID NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+
+    submitted_by TEXT DEFAULT NULL,  -- recipient email, if known
+    favorite_count INT NOT NULL,
+    note TEXT DEFAULT NULL,
+
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_selection_submissions_favorite_count_positive
+        CHECK (favorite_count >= 0)
+);
+
+CREATE INDEX idx_selection_submissions_listing
+    ON selection_submissions(listing_id);
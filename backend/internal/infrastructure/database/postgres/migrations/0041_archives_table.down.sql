@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_archives_updated_at ON archives;
+DROP TABLE IF EXISTS archives;
@@ -0,0 +1,47 @@
+-- ZIP archives of a listing's photos, built by the worker when a download
+-- is too large to stream. share_link_id is set when a share link visitor
+-- asked for the archive. Once ready, object_key holds the ZIP until
+-- expires_at, after which the worker removes both.
+CREATE TABLE IF NOT EXISTS archives (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    listing_id UUID NOT NULL REFERENCES listings(id) ON DELETE CASCADE,
+    share_link_id UUID DEFAULT NULL REFERENCES share_links(id) ON DELETE SET NULL,
+    requested_by UUID DEFAULT NULL REFERENCES users(id) ON DELETE SET NULL,
+
+    variant TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    photo_count INT NOT NULL,
+
+    object_key TEXT DEFAULT NULL,
+    size_bytes BIGINT DEFAULT NULL,
+
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT chk_archives_timestamps
+        CHECK (updated_at >= created_at),
+
+    CONSTRAINT archives_variant_check
+        CHECK (variant IN ('original', 'watermarked')),
+
+    CONSTRAINT archives_status_check
+        CHECK (status IN ('pending', 'ready', 'failed')),
+
+    CONSTRAINT chk_archives_photo_count_positive
+        CHECK (photo_count > 0),
+
+    CONSTRAINT chk_archives_ready_object
+        CHECK (status <> 'ready' OR (object_key IS NOT NULL AND size_bytes IS NOT NULL))
+);
+
+CREATE TRIGGER trg_archives_updated_at
+BEFORE UPDATE ON archives
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Expiry sweep
+CREATE INDEX idx_archives_expires_at
+    ON archives(expires_at);
@@ -0,0 +1,12 @@
+-- Rolling back discards the access log along with its tables.
+DROP TABLE IF EXISTS share_link_access_photos;
+DROP TABLE IF EXISTS share_link_accesses;
+
+-- Revoked links are expired rather than deleted, so they stay unusable
+-- without the column and keep their proofing selections.
+UPDATE share_links
+SET expires_at = LEAST(expires_at, revoked_at)
+WHERE revoked_at IS NOT NULL;
+
+ALTER TABLE share_links
+    DROP COLUMN IF EXISTS revoked_at;
@@ -0,0 +1,39 @@
+-- Revoking a share link sets revoked_at instead of deleting it, so its
+-- access log outlives the link.
+ALTER TABLE share_links
+    ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ DEFAULT NULL;
+
+-- One use of a share link. ip_prefix is the visitor's address with its host
+-- part zeroed; visitor_hash tells visitors of the link apart without
+-- storing who they are.
+CREATE TABLE IF NOT EXISTS share_link_accesses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    share_link_id UUID NOT NULL REFERENCES share_links(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    action TEXT NOT NULL,
+    visitor_hash TEXT NOT NULL,
+    ip_prefix TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+
+    accessed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    CONSTRAINT share_link_accesses_action_check
+        CHECK (action IN ('view', 'photo_view', 'download'))
+);
+
+-- The photos an access viewed or downloaded
+CREATE TABLE IF NOT EXISTS share_link_access_photos (
+    access_id UUID NOT NULL REFERENCES share_link_accesses(id) ON DELETE CASCADE,
+    share_link_id UUID NOT NULL REFERENCES share_links(id) ON DELETE CASCADE,
+    photo_id UUID NOT NULL REFERENCES listing_photos(id) ON DELETE CASCADE,
+
+    PRIMARY KEY (access_id, photo_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_share_link_accesses_link_time
+    ON share_link_accesses(share_link_id, accessed_at);
+
+CREATE INDEX IF NOT EXISTS idx_share_link_access_photos_link
+    ON share_link_access_photos(share_link_id, photo_id);
@@ -0,0 +1,38 @@
+// Package postgrestest opens a migrated Postgres database for integration
+// tests. Tests that use it are skipped unless TEST_DATABASE_URL names a
+// database they may write to.
+package postgrestest
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// Open migrates the database at TEST_DATABASE_URL and returns a connection
+// to it that is closed when the test ends, or skips the test if the
+// variable is unset. Tests share the database, so each should create its
+// own tenant rather than expect empty tables.
+func Open(t testing.TB) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	_, file, _, _ := runtime.Caller(0)
+	migrations := filepath.Join(filepath.Dir(file), "..", "migrations")
+	if err := postgres.RunMigrations(dsn, migrations); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
@@ -30,3 +30,12 @@ ORDER BY performed_at DESC;
 SELECT COUNT(*) AS count
 FROM audit_logs
 WHERE tenant_id = $1;
+
+-- name: ListAuditLogs :many
+SELECT id, performed_by, entity_id, entity_type, action, changed_data, performed_at
+FROM audit_logs
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND (sqlc.narg(entity_type)::text IS NULL OR entity_type = sqlc.narg(entity_type))
+  AND (sqlc.narg(entity_id)::uuid IS NULL OR entity_id = sqlc.narg(entity_id))
+ORDER BY performed_at DESC
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
@@ -31,3 +31,22 @@ FROM usage_stats
 WHERE tenant_id = $1
 ORDER BY total_storage_used_bytes DESC
 LIMIT $2;
+
+-- name: RecordUserUpload :exec
+INSERT INTO usage_stats (tenant_id, user_id, total_uploads, total_storage_used_bytes, created_at)
+VALUES ($1, $2, 1, $3, NOW())
+ON CONFLICT (tenant_id, user_id) DO UPDATE
+SET total_uploads = usage_stats.total_uploads + 1,
+    total_storage_used_bytes = usage_stats.total_storage_used_bytes + EXCLUDED.total_storage_used_bytes,
+    updated_at = NOW();
+
+-- Takes a purged upload off the user's counters. size is the storage the
+-- purge freed, which is zero while other files still share the content.
+
+-- name: ReleaseUserUpload :exec
+UPDATE usage_stats
+SET total_uploads = GREATEST(total_uploads - 1, 0),
+    total_storage_used_bytes = GREATEST(total_storage_used_bytes - sqlc.arg(size)::bigint, 0),
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND user_id = sqlc.arg(user_id);
@@ -1,12 +1,27 @@
 -- name: CreateAuthSession :one
-INSERT INTO auth_sessions (user_id, tenant_id, refresh_token, expires_at, created_at)
-VALUES ($1, $2, $3, $4, NOW())
-RETURNING id, user_id, tenant_id, refresh_token, expires_at, created_at;
+INSERT INTO auth_sessions (user_id, tenant_id, family_id, refresh_token, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+RETURNING id, user_id, tenant_id, family_id, refresh_token, expires_at, rotated_at, revoked_at, created_at;
 
 -- name: GetAuthSessionByToken :one
-SELECT id, user_id, tenant_id, refresh_token, expires_at, created_at
-FROM auth_sessions
-WHERE refresh_token = $1;
+SELECT s.id, s.user_id, s.tenant_id, s.family_id, s.refresh_token, s.expires_at, s.rotated_at, s.revoked_at, s.created_at, tu.role
+FROM auth_sessions s
+JOIN tenant_users tu ON tu.tenant_id = s.tenant_id AND tu.user_id = s.user_id
+WHERE s.refresh_token = $1;
+
+-- name: MarkAuthSessionRotated :one
+UPDATE auth_sessions
+SET rotated_at = NOW()
+WHERE id = $1
+  AND rotated_at IS NULL
+  AND revoked_at IS NULL
+RETURNING id;
+
+-- name: RevokeAuthSessionFamily :exec
+UPDATE auth_sessions
+SET revoked_at = NOW()
+WHERE family_id = $1
+  AND revoked_at IS NULL;
 
 -- name: DeleteAuthSession :exec
 DELETE FROM auth_sessions
@@ -20,7 +35,7 @@ WHERE user_id = $1
   AND tenant_id = $2;
 
 -- name: ListUserSessions :many
-SELECT id, refresh_token, expires_at, created_at
+SELECT id, family_id, refresh_token, expires_at, rotated_at, revoked_at, created_at
 FROM auth_sessions
 WHERE user_id = $1
   AND tenant_id = $2
@@ -0,0 +1,42 @@
+-- name: GetLoginThrottle :one
+SELECT scope, subject, failed_attempts, lockout_count, last_failed_at, locked_until
+FROM login_throttles
+WHERE scope = $1
+  AND subject = $2;
+
+-- name: RecordLoginFailure :one
+INSERT INTO login_throttles (scope, subject, failed_attempts, last_failed_at)
+VALUES (sqlc.arg(scope), sqlc.arg(subject), 1, NOW())
+ON CONFLICT (scope, subject) DO UPDATE
+SET failed_attempts = CASE
+        WHEN login_throttles.last_failed_at IS NULL
+          OR login_throttles.last_failed_at < sqlc.arg(window_start)::timestamptz THEN 1
+        ELSE login_throttles.failed_attempts + 1
+    END,
+    lockout_count = CASE
+        WHEN login_throttles.last_failed_at < sqlc.arg(reset_before)::timestamptz THEN 0
+        ELSE login_throttles.lockout_count
+    END,
+    last_failed_at = NOW(),
+    updated_at = NOW()
+RETURNING scope, subject, failed_attempts, lockout_count, last_failed_at, locked_until;
+
+-- name: LockLoginThrottle :one
+UPDATE login_throttles
+SET locked_until = $3,
+    lockout_count = lockout_count + 1,
+    failed_attempts = 0,
+    updated_at = NOW()
+WHERE scope = $1
+  AND subject = $2
+RETURNING scope, subject, failed_attempts, lockout_count, last_failed_at, locked_until;
+
+-- name: ResetLoginThrottle :exec
+DELETE FROM login_throttles
+WHERE scope = $1
+  AND subject = $2;
+
+-- name: DeleteStaleLoginThrottles :exec
+DELETE FROM login_throttles
+WHERE last_failed_at < $1
+  AND (locked_until IS NULL OR locked_until < NOW());
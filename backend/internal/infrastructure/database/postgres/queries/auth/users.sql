@@ -1,7 +1,14 @@
 -- name: CreateUser :one
-INSERT INTO users (email, password_hash, created_at)
-VALUES ($1, $2, NOW())
-RETURNING id, email, created_at;
+INSERT INTO users (tenant_id, username, email, password_hash, created_at)
+VALUES ($1, $2, $3, $4, NOW())
+RETURNING id, tenant_id, username, email, created_at, updated_at;
+
+-- name: GetUserForLogin :one
+SELECT u.id, u.tenant_id, u.username, u.email, u.password_hash, u.created_at, u.updated_at, tu.role
+FROM users u
+JOIN tenant_users tu ON tu.tenant_id = u.tenant_id AND tu.user_id = u.id
+WHERE u.tenant_id = sqlc.arg(tenant_id)
+  AND (lower(u.email) = lower(sqlc.arg(identifier)) OR u.username = sqlc.arg(identifier));
 
 -- name: GetUserByID :one
 SELECT id, email, password_hash, created_at
@@ -0,0 +1,165 @@
+-- name: EnqueueJob :one
+-- Returns no row when a queued job with the same unique_key already exists.
+INSERT INTO jobs (tenant_id, type, payload, unique_key, max_attempts, run_at)
+VALUES (
+    sqlc.narg(tenant_id),
+    sqlc.arg(type),
+    sqlc.arg(payload),
+    sqlc.narg(unique_key),
+    sqlc.arg(max_attempts),
+    sqlc.arg(run_at)
+)
+ON CONFLICT (unique_key) WHERE status = 'queued' AND unique_key IS NOT NULL DO NOTHING
+RETURNING id;
+
+-- name: PickJob :one
+-- Locks the oldest due job of one of types for the claiming transaction,
+-- skipping tenants that already run tenant_limit jobs; zero means no cap.
+SELECT j.id, j.tenant_id
+FROM jobs j
+WHERE j.status = 'queued'
+  AND j.run_at <= now()
+  AND j.type = ANY(string_to_array(sqlc.arg(types)::text, ','))
+  AND (
+      sqlc.arg(tenant_limit)::int <= 0
+      OR j.tenant_id IS NULL
+      OR (
+          SELECT COUNT(*)
+          FROM jobs r
+          WHERE r.tenant_id = j.tenant_id
+            AND r.status = 'running'
+      ) < sqlc.arg(tenant_limit)::int
+  )
+ORDER BY j.run_at, j.id
+LIMIT 1
+FOR UPDATE SKIP LOCKED;
+
+-- name: LockTenantJobClaims :exec
+-- Serializes claims of one tenant's jobs for the rest of the transaction so
+-- its running count cannot be raced past the cap by concurrent workers.
+SELECT pg_advisory_xact_lock(hashtext('jobs_claim:' || sqlc.arg(tenant_id)::uuid::text));
+
+-- name: CountRunningJobs :one
+SELECT COUNT(*)
+FROM jobs
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND status = 'running';
+
+-- name: ClaimJob :one
+UPDATE jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    locked_at = now(),
+    locked_by = sqlc.arg(worker_id)
+WHERE id = sqlc.arg(id)
+RETURNING id, tenant_id, type, payload, attempts, max_attempts, run_at, created_at;
+
+-- name: CompleteJob :execrows
+UPDATE jobs
+SET status = 'succeeded',
+    locked_at = NULL,
+    locked_by = NULL,
+    last_error = NULL,
+    finished_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'running'
+  AND locked_by = sqlc.arg(worker_id);
+
+-- name: RetryJob :execrows
+-- Requeues a failed job. The unique key is dropped if an identical job was
+-- queued in the meantime, since both must still run.
+UPDATE jobs
+SET status = 'queued',
+    run_at = sqlc.arg(run_at),
+    last_error = sqlc.arg(last_error),
+    locked_at = NULL,
+    locked_by = NULL,
+    unique_key = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM jobs d
+            WHERE d.unique_key = jobs.unique_key
+              AND d.status = 'queued'
+        ) THEN NULL
+        ELSE unique_key
+    END
+WHERE jobs.id = sqlc.arg(id)
+  AND jobs.status = 'running'
+  AND jobs.locked_by = sqlc.arg(worker_id);
+
+-- name: ReleaseJob :execrows
+-- Returns an interrupted job to the queue without consuming an attempt.
+UPDATE jobs
+SET status = 'queued',
+    attempts = GREATEST(attempts - 1, 0),
+    run_at = now(),
+    locked_at = NULL,
+    locked_by = NULL,
+    unique_key = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM jobs d
+            WHERE d.unique_key = jobs.unique_key
+              AND d.status = 'queued'
+        ) THEN NULL
+        ELSE unique_key
+    END
+WHERE jobs.id = sqlc.arg(id)
+  AND jobs.status = 'running'
+  AND jobs.locked_by = sqlc.arg(worker_id);
+
+-- name: BuryJob :execrows
+UPDATE jobs
+SET status = 'dead',
+    last_error = sqlc.arg(last_error),
+    locked_at = NULL,
+    locked_by = NULL,
+    finished_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'running'
+  AND locked_by = sqlc.arg(worker_id);
+
+-- name: RequeueStaleJobs :execrows
+-- Recovers jobs whose worker died mid-run. Jobs out of attempts are buried.
+UPDATE jobs
+SET status = CASE WHEN attempts >= max_attempts THEN 'dead' ELSE 'queued' END,
+    finished_at = CASE WHEN attempts >= max_attempts THEN now() ELSE NULL END,
+    last_error = 'lease expired',
+    run_at = now(),
+    locked_at = NULL,
+    locked_by = NULL,
+    unique_key = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM jobs d
+            WHERE d.unique_key = jobs.unique_key
+              AND d.status = 'queued'
+        ) THEN NULL
+        ELSE unique_key
+    END
+WHERE jobs.status = 'running'
+  AND jobs.locked_at < sqlc.arg(stale_before);
+
+-- name: RetryDeadJob :execrows
+-- Requeues a dead job with fresh attempts. As in RetryJob, the unique key is
+-- dropped if an identical job is already queued.
+UPDATE jobs
+SET status = 'queued',
+    attempts = 0,
+    run_at = now(),
+    finished_at = NULL,
+    last_error = NULL,
+    locked_at = NULL,
+    locked_by = NULL,
+    unique_key = CASE
+        WHEN EXISTS (
+            SELECT 1 FROM jobs d
+            WHERE d.unique_key = jobs.unique_key
+              AND d.status = 'queued'
+        ) THEN NULL
+        ELSE unique_key
+    END
+WHERE jobs.id = sqlc.arg(id)
+  AND jobs.status = 'dead';
+
+-- name: PurgeSucceededJobs :execrows
+DELETE FROM jobs
+WHERE status = 'succeeded'
+  AND finished_at < sqlc.arg(finished_before);
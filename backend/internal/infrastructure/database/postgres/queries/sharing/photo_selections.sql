@@ -0,0 +1,54 @@
+-- Only live, published photos of the link's listing can be proofed; no row
+-- is returned for any other photo.
+
+-- name: UpsertPhotoSelection :one
+INSERT INTO photo_selections (
+    tenant_id, share_link_id, listing_id, photo_id, is_favorite, rating, comment, updated_by
+)
+SELECT lp.tenant_id, sqlc.arg(share_link_id)::uuid, lp.listing_id, lp.id,
+       sqlc.arg(is_favorite)::boolean, sqlc.narg(rating)::int, sqlc.narg(comment)::text, sqlc.narg(updated_by)::text
+FROM listing_photos lp
+WHERE lp.tenant_id = sqlc.arg(tenant_id)
+  AND lp.listing_id = sqlc.arg(listing_id)
+  AND lp.id = sqlc.arg(photo_id)
+  AND lp.is_published = TRUE
+  AND lp.deleted_at IS NULL
+ON CONFLICT (share_link_id, photo_id) DO UPDATE
+SET is_favorite = EXCLUDED.is_favorite,
+    rating = EXCLUDED.rating,
+    comment = EXCLUDED.comment,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeletePhotoSelection :exec
+DELETE FROM photo_selections
+WHERE share_link_id = $1
+  AND photo_id = $2;
+
+-- name: ListShareLinkSelections :many
+SELECT ps.photo_id, ps.is_favorite, ps.rating, ps.comment, ps.updated_by, ps.updated_at,
+       lp.position, f.original_key, f.filename
+FROM photo_selections ps
+JOIN listing_photos lp ON lp.id = ps.photo_id
+JOIN files f ON f.id = lp.file_id
+WHERE ps.share_link_id = $1
+  AND lp.listing_id = ps.listing_id
+  AND lp.is_published = TRUE
+  AND lp.deleted_at IS NULL
+ORDER BY lp.position ASC;
+
+-- Selections of photos since moved to another listing stay with the
+-- listing they were made in and are left out.
+
+-- name: ListListingSelections :many
+SELECT ps.share_link_id, ps.photo_id, ps.is_favorite, ps.rating, ps.comment, ps.updated_by, ps.updated_at,
+       lp.position, f.original_key, f.filename
+FROM photo_selections ps
+JOIN listing_photos lp ON lp.id = ps.photo_id
+JOIN files f ON f.id = lp.file_id
+WHERE ps.tenant_id = $1
+  AND ps.listing_id = $2
+  AND lp.listing_id = ps.listing_id
+  AND lp.deleted_at IS NULL
+ORDER BY lp.position ASC, ps.updated_at ASC;
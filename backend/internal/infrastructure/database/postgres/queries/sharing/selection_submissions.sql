@@ -0,0 +1,18 @@
+-- name: CreateSelectionSubmission :one
+INSERT INTO selection_submissions (
+    share_link_id, tenant_id, listing_id, submitted_by, favorite_count, note
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetSelectionSubmission :one
+SELECT *
+FROM selection_submissions
+WHERE share_link_id = $1;
+
+-- name: ListListingSubmissions :many
+SELECT *
+FROM selection_submissions
+WHERE tenant_id = $1
+  AND listing_id = $2
+ORDER BY submitted_at DESC;
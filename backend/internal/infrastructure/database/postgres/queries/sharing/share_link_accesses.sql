@@ -0,0 +1,64 @@
+-- name: CreateShareLinkAccess :one
+INSERT INTO share_link_accesses (share_link_id, tenant_id, action, visitor_hash, ip_prefix, user_agent)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- photo_ids is a comma-separated list of photo UUIDs.
+
+-- name: AddShareLinkAccessPhotos :exec
+INSERT INTO share_link_access_photos (access_id, share_link_id, photo_id)
+SELECT sqlc.arg(access_id), sqlc.arg(share_link_id), photo_id
+FROM unnest(string_to_array(sqlc.arg(photo_ids)::text, ',')::uuid[]) AS photo_id
+ON CONFLICT DO NOTHING;
+
+-- name: SummarizeShareLinkAccesses :one
+SELECT COUNT(*) FILTER (WHERE action = 'view')::bigint AS views,
+       COUNT(*) FILTER (WHERE action = 'photo_view')::bigint AS photo_views,
+       COUNT(*) FILTER (WHERE action = 'download')::bigint AS downloads,
+       COUNT(DISTINCT visitor_hash)::bigint AS unique_visitors
+FROM share_link_accesses
+WHERE share_link_id = sqlc.arg(share_link_id)
+  AND accessed_at >= sqlc.arg(accessed_from)
+  AND accessed_at < sqlc.arg(accessed_to);
+
+-- bucket_size is a date_trunc field, e.g. 'hour' or 'day'. Buckets without
+-- views are left out.
+
+-- name: ShareLinkViewsOverTime :many
+SELECT date_trunc(sqlc.arg(bucket_size)::text, accessed_at)::timestamptz AS bucket,
+       COUNT(*)::bigint AS views,
+       COUNT(DISTINCT visitor_hash)::bigint AS unique_visitors
+FROM share_link_accesses
+WHERE share_link_id = sqlc.arg(share_link_id)
+  AND action = 'view'
+  AND accessed_at >= sqlc.arg(accessed_from)
+  AND accessed_at < sqlc.arg(accessed_to)
+GROUP BY bucket
+ORDER BY bucket;
+
+-- name: TopShareLinkPhotos :many
+SELECT ap.photo_id,
+       f.filename,
+       f.original_key,
+       COUNT(*) FILTER (WHERE a.action = 'photo_view')::bigint AS views,
+       COUNT(*) FILTER (WHERE a.action = 'download')::bigint AS downloads
+FROM share_link_access_photos ap
+JOIN share_link_accesses a ON a.id = ap.access_id
+JOIN listing_photos lp ON lp.id = ap.photo_id
+JOIN files f ON f.id = lp.file_id
+WHERE ap.share_link_id = sqlc.arg(share_link_id)
+  AND a.accessed_at >= sqlc.arg(accessed_from)
+  AND a.accessed_at < sqlc.arg(accessed_to)
+GROUP BY ap.photo_id, f.filename, f.original_key
+ORDER BY views DESC, downloads DESC, ap.photo_id
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListShareLinkAccesses :many
+SELECT a.id, a.action, a.visitor_hash, a.ip_prefix, a.user_agent, a.accessed_at,
+       COALESCE(string_agg(ap.photo_id::text, ',' ORDER BY ap.photo_id), '')::text AS photo_ids
+FROM share_link_accesses a
+LEFT JOIN share_link_access_photos ap ON ap.access_id = a.id
+WHERE a.share_link_id = $1
+GROUP BY a.id
+ORDER BY a.accessed_at DESC, a.id
+LIMIT $2 OFFSET $3;
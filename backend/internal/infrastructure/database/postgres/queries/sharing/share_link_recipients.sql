@@ -0,0 +1,10 @@
+-- name: AddShareLinkRecipient :exec
+INSERT INTO share_link_recipients (share_link_id, email)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: ListShareLinkRecipients :many
+SELECT email
+FROM share_link_recipients
+WHERE share_link_id = $1
+ORDER BY email;
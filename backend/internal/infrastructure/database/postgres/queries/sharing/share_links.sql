@@ -1,32 +1,67 @@
 -- name: CreateShareLink :one
 INSERT INTO share_links (
-    listing_id, tenant_id, permission, token, expires_at, max_views
+    listing_id, tenant_id, permission, token, expires_at, max_views, password_hash
 )
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, listing_id, tenant_id, permission, token, expires_at, max_views, view_count, created_at, updated_at;
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, listing_id, tenant_id, permission, token, expires_at, max_views, view_count, created_at, updated_at, password_hash, revoked_at;
+
+-- token holds the SHA-256 hash of the share token, never the token itself.
 
 -- name: GetShareLinkByToken :one
 SELECT *
 FROM share_links
-WHERE token = $1
-  AND tenant_id = $2
-  AND (expires_at > NOW());
+WHERE token = $1;
+
+-- Counts a view unless the link has been revoked, has expired or has used
+-- up its views, so concurrent visitors cannot exceed max_views.
 
 -- name: IncrementShareLinkView :one
 UPDATE share_links
 SET view_count = view_count + 1, updated_at = NOW()
 WHERE id = $1
+  AND revoked_at IS NULL
+  AND expires_at > NOW()
   AND (view_count < max_views OR max_views = 0)
 RETURNING id, view_count;
 
 -- name: ListShareLinksByListing :many
-SELECT id, permission, token, expires_at, max_views, view_count, created_at, updated_at
+SELECT id, permission, token, expires_at, max_views, view_count, created_at, updated_at, password_hash, revoked_at
 FROM share_links
 WHERE tenant_id = $1
   AND listing_id = $2
 ORDER BY created_at DESC;
 
--- name: DeleteShareLink :exec
-DELETE FROM share_links
+-- name: GetShareLink :one
+SELECT *
+FROM share_links
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND id = $3;
+
+-- Keeps the time of the first revocation when a link is revoked again.
+
+-- name: RevokeShareLink :one
+UPDATE share_links
+SET revoked_at = COALESCE(revoked_at, NOW())
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND id = $3
+RETURNING id;
+
+-- name: UpdateShareLinkLimits :one
+UPDATE share_links
+SET expires_at = $4,
+    max_views = $5
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND id = $3
+RETURNING *;
+
+-- Serialises changes to the link's proofing with its submission.
+
+-- name: LockShareLink :one
+SELECT id
+FROM share_links
 WHERE tenant_id = $1
-  AND id = $2;
+  AND id = $2
+FOR UPDATE;
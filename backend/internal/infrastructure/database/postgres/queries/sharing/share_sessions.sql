@@ -0,0 +1,18 @@
+-- name: CreateShareSession :one
+INSERT INTO share_sessions (share_link_id, token, email, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- token holds the SHA-256 hash of the session token. A session only
+-- unlocks the link it was issued for.
+
+-- name: GetShareSession :one
+SELECT *
+FROM share_sessions
+WHERE token = $1
+  AND share_link_id = $2
+  AND expires_at > NOW();
+
+-- name: DeleteExpiredShareSessions :execrows
+DELETE FROM share_sessions
+WHERE expires_at <= $1;
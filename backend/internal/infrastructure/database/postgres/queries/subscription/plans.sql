@@ -8,6 +8,14 @@ SELECT id, type, price, billing_cycle, created_at, updated_at
 FROM plans
 WHERE id = $1;
 
+-- name: GetPlanByType :one
+SELECT id, type, price, billing_cycle, created_at, updated_at
+FROM plans
+WHERE type = $1
+  AND billing_cycle = $2
+ORDER BY created_at ASC
+LIMIT 1;
+
 -- name: ListPlans :many
 SELECT id, type, price, billing_cycle, created_at, updated_at
 FROM plans
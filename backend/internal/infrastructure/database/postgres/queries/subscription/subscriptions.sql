@@ -1,6 +1,6 @@
 -- name: CreateSubscription :one
 INSERT INTO subscriptions (tenant_id, plan_id, status, started_at, end_at, created_at)
-VALUES ($1, $2, 'inactive', $3, $4, NOW())
+VALUES ($1, $2, $3, $4, $5, NOW())
 RETURNING id, tenant_id, plan_id, status, started_at, end_at, created_at, updated_at;
 
 -- name: GetSubscriptionByID :one
@@ -29,3 +29,12 @@ SET plan_id = $3, updated_at = NOW()
 WHERE tenant_id = $1
   AND id = $2
 RETURNING id, tenant_id, plan_id, status, started_at, end_at, created_at, updated_at;
+
+-- name: GetActiveSubscription :one
+SELECT *
+FROM subscriptions
+WHERE tenant_id = $1
+  AND status = 'active'
+  AND end_at > NOW()
+ORDER BY started_at DESC
+LIMIT 1;
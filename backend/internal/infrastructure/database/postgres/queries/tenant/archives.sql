@@ -0,0 +1,50 @@
+-- name: CreateArchive :one
+INSERT INTO archives (tenant_id, listing_id, share_link_id, requested_by, variant, photo_count, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetArchive :one
+SELECT *
+FROM archives
+WHERE tenant_id = $1
+  AND id = $2;
+
+-- Only a pending archive is completed or failed, so a retried build cannot
+-- replace an archive that was already handed out.
+
+-- name: CompleteArchive :one
+UPDATE archives
+SET status = 'ready',
+    object_key = $3,
+    size_bytes = $4,
+    expires_at = $5
+WHERE tenant_id = $1
+  AND id = $2
+  AND status = 'pending'
+RETURNING *;
+
+-- name: FailArchive :exec
+UPDATE archives
+SET status = 'failed'
+WHERE tenant_id = $1
+  AND id = $2
+  AND status = 'pending';
+
+-- name: ListExpiredArchives :many
+SELECT id, tenant_id, object_key
+FROM archives
+WHERE expires_at <= NOW()
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: DeleteArchive :exec
+DELETE FROM archives
+WHERE tenant_id = $1
+  AND id = $2;
+
+-- name: ListListingArchiveKeys :many
+SELECT object_key::text
+FROM archives
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND object_key IS NOT NULL;
@@ -0,0 +1,31 @@
+-- Takes a reference on the tenant's blob with this digest, creating it with
+-- the caller's object when the content is new. The caller compares the
+-- returned object_key with its own to tell whether its upload was kept.
+
+-- name: AcquireFileBlob :one
+INSERT INTO file_blobs AS b (tenant_id, content_sha256, object_key, file_size_bytes, mime_type)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (tenant_id, content_sha256) DO UPDATE
+SET ref_count = b.ref_count + 1,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ReleaseFileBlob :one
+UPDATE file_blobs
+SET ref_count = ref_count - 1,
+    updated_at = NOW()
+WHERE tenant_id = $1
+  AND content_sha256 = $2
+RETURNING *;
+
+-- name: DeleteUnreferencedFileBlob :execrows
+DELETE FROM file_blobs
+WHERE tenant_id = $1
+  AND id = $2
+  AND ref_count = 0;
+
+-- name: GetFileBlob :one
+SELECT *
+FROM file_blobs
+WHERE tenant_id = $1
+  AND content_sha256 = $2;
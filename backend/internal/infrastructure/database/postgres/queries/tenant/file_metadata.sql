@@ -0,0 +1,41 @@
+-- name: UpsertFileMetadata :one
+INSERT INTO file_metadata (
+    file_id, tenant_id, width, height, orientation,
+    captured_at, camera_make, camera_model, lens_model,
+    focal_length_mm, f_number, exposure_time_seconds, iso,
+    artist, copyright, description, gps_latitude, gps_longitude, iptc
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+ON CONFLICT (file_id) DO UPDATE
+SET width = EXCLUDED.width,
+    height = EXCLUDED.height,
+    orientation = EXCLUDED.orientation,
+    captured_at = EXCLUDED.captured_at,
+    camera_make = EXCLUDED.camera_make,
+    camera_model = EXCLUDED.camera_model,
+    lens_model = EXCLUDED.lens_model,
+    focal_length_mm = EXCLUDED.focal_length_mm,
+    f_number = EXCLUDED.f_number,
+    exposure_time_seconds = EXCLUDED.exposure_time_seconds,
+    iso = EXCLUDED.iso,
+    artist = EXCLUDED.artist,
+    copyright = EXCLUDED.copyright,
+    description = EXCLUDED.description,
+    gps_latitude = EXCLUDED.gps_latitude,
+    gps_longitude = EXCLUDED.gps_longitude,
+    iptc = EXCLUDED.iptc
+RETURNING *;
+
+-- name: GetFileMetadata :one
+SELECT *
+FROM file_metadata
+WHERE tenant_id = $1
+  AND file_id = $2;
+
+-- name: ListListingPhotoMetadata :many
+SELECT fm.*
+FROM file_metadata fm
+JOIN listing_photos lp ON lp.file_id = fm.file_id
+WHERE lp.tenant_id = $1
+  AND lp.listing_id = $2
+  AND lp.deleted_at IS NULL;
@@ -0,0 +1,19 @@
+-- name: DeleteFileVariants :many
+DELETE FROM file_variants
+WHERE tenant_id = $1
+  AND file_id = $2
+RETURNING object_key;
+
+-- name: CreateFileVariant :one
+INSERT INTO file_variants (tenant_id, file_id, width, height, object_key, file_size_bytes, mime_type)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListListingPhotoVariants :many
+SELECT fv.file_id, fv.width, fv.height, fv.object_key, fv.file_size_bytes, fv.mime_type
+FROM file_variants fv
+JOIN listing_photos lp ON lp.file_id = fv.file_id
+WHERE lp.tenant_id = $1
+  AND lp.listing_id = $2
+  AND lp.deleted_at IS NULL
+ORDER BY fv.file_id, fv.width ASC;
@@ -1,6 +1,6 @@
 -- name: CreateFile :one
-INSERT INTO files (tenant_id, listing_id, user_id, original_url, watermarked_url, watermark_type, thumbnail_url, file_size_bytes, mime_type)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+INSERT INTO files (tenant_id, listing_id, user_id, original_key, watermarked_key, watermark_type, thumbnail_key, file_size_bytes, mime_type, content_sha256, filename)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 RETURNING *;
 
 -- name: ListFilesByListing :many
@@ -17,3 +17,87 @@ WHERE tenant_id = $1
   AND user_id = $2
 ORDER BY created_at DESC;
 
+
+-- name: GetFile :one
+SELECT *
+FROM files
+WHERE tenant_id = $1
+  AND id = $2;
+
+-- name: SetFileThumbnailKey :one
+UPDATE files
+SET thumbnail_key = $3
+WHERE tenant_id = $1
+  AND id = $2
+RETURNING id;
+
+-- watermarked_key and watermark_type are set or cleared together to satisfy
+-- the paired CHECK on files.
+
+-- name: SetFileWatermark :one
+UPDATE files
+SET watermarked_key = sqlc.narg(watermarked_key),
+    watermark_type = sqlc.narg(watermark_type)
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id = sqlc.arg(id)
+RETURNING id;
+
+-- name: ListTenantFileIDs :many
+SELECT id
+FROM files
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(page_limit);
+
+-- Removes the file together with its listing photos, variants and metadata.
+-- The caller releases the blob named by content_sha256 and deletes the
+-- returned object keys.
+
+-- name: DeleteFile :one
+DELETE FROM files
+WHERE tenant_id = $1
+  AND id = $2
+RETURNING *;
+
+-- Keeps a file with the listing its photo moved to, so the file is not
+-- removed along with the listing it was uploaded to.
+
+-- name: MoveFileToListing :exec
+UPDATE files
+SET listing_id = sqlc.arg(target_listing_id),
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id = sqlc.arg(id)
+  AND listing_id = sqlc.arg(listing_id);
+
+-- Hands the listing's files that photos in other listings still show over
+-- to one of those listings, so purging the listing does not take them along.
+
+-- name: ReassignSharedListingFiles :exec
+UPDATE files f
+SET listing_id = (
+        SELECT lp.listing_id
+        FROM listing_photos lp
+        WHERE lp.file_id = f.id
+          AND lp.listing_id <> f.listing_id
+        ORDER BY lp.deleted_at NULLS FIRST, lp.created_at
+        LIMIT 1
+    ),
+    updated_at = NOW()
+WHERE f.tenant_id = $1
+  AND f.listing_id = $2
+  AND EXISTS (
+      SELECT 1
+      FROM listing_photos lp
+      WHERE lp.file_id = f.id
+        AND lp.listing_id <> f.listing_id
+  );
+
+-- name: FileHasPhotos :one
+SELECT EXISTS (
+    SELECT 1
+    FROM listing_photos
+    WHERE tenant_id = $1
+      AND file_id = $2
+) AS has_photos;
@@ -16,11 +16,193 @@ UPDATE listing_photos
 SET is_cover = CASE WHEN id = $3 THEN TRUE ELSE FALSE END,
     updated_at = NOW()
 WHERE tenant_id = $1
-  AND listing_id = $2;
+  AND listing_id = $2
+  AND deleted_at IS NULL;
 
 -- name: SoftDeleteListingPhoto :one
 UPDATE listing_photos
 SET deleted_at = NOW(), updated_at = NOW()
 WHERE tenant_id = $1
-  AND id = $2
+  AND listing_id = $2
+  AND id = $3
+  AND deleted_at IS NULL
+RETURNING id;
+
+-- name: ListListingPhotosWithFiles :many
+SELECT lp.id, lp.listing_id, lp.file_id, lp.position, lp.is_cover, lp.is_published, lp.created_at, lp.updated_at,
+       f.original_key, f.watermarked_key, f.thumbnail_key, f.file_size_bytes, f.mime_type, f.filename
+FROM listing_photos lp
+JOIN files f ON f.id = lp.file_id
+WHERE lp.tenant_id = $1
+  AND lp.listing_id = $2
+  AND lp.deleted_at IS NULL
+ORDER BY lp.position ASC;
+
+-- name: GetListingPhoto :one
+SELECT *
+FROM listing_photos
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND id = $3
+  AND deleted_at IS NULL;
+
+-- Soft-deleted rows still hold their position under UNIQUE (listing_id, position),
+-- so they are included when picking the next one.
+
+-- name: NextListingPhotoPosition :one
+SELECT COALESCE(MAX(position) + 1, 0)::int AS position
+FROM listing_photos
+WHERE listing_id = $1;
+
+-- name: ListingHasCoverPhoto :one
+SELECT EXISTS (
+    SELECT 1
+    FROM listing_photos
+    WHERE tenant_id = $1
+      AND listing_id = $2
+      AND is_cover = TRUE
+      AND deleted_at IS NULL
+) AS has_cover;
+
+-- name: CountListingPhotos :one
+SELECT COUNT(*)
+FROM listing_photos
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND deleted_at IS NULL;
+
+-- name: ListListingPhotoIDs :many
+SELECT id
+FROM listing_photos
+WHERE tenant_id = $1
+  AND listing_id = $2
+  AND deleted_at IS NULL
+ORDER BY position ASC;
+
+-- Moves every position of the listing, soft-deleted rows included, to a
+-- distinct negative value. UNIQUE (listing_id, position) is checked row by
+-- row, so renumbering in place could collide; from here it cannot.
+
+-- name: ParkListingPhotoPositions :exec
+UPDATE listing_photos
+SET position = -1 - position
+WHERE tenant_id = $1
+  AND listing_id = $2;
+
+-- Renumbers a parked listing from zero: live photos in the order of
+-- photo_ids, then the soft-deleted ones in their previous order.
+
+-- name: RenumberListingPhotos :execrows
+UPDATE listing_photos lp
+SET position = o.new_position,
+    updated_at = NOW()
+FROM (
+    SELECT p.id,
+           (ROW_NUMBER() OVER (
+               ORDER BY array_position(string_to_array(sqlc.arg(photo_ids)::text, ',')::uuid[], p.id) NULLS LAST,
+                        p.position DESC
+           ) - 1)::int AS new_position
+    FROM listing_photos p
+    WHERE p.tenant_id = sqlc.arg(tenant_id)
+      AND p.listing_id = sqlc.arg(listing_id)
+) o
+WHERE lp.id = o.id;
+
+-- name: SetListingPhotosPublished :many
+UPDATE listing_photos
+SET is_published = sqlc.arg(is_published),
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND listing_id = sqlc.arg(listing_id)
+  AND id = ANY(string_to_array(sqlc.arg(photo_ids)::text, ',')::uuid[])
+  AND deleted_at IS NULL
 RETURNING id;
+
+-- name: SoftDeleteListingPhotos :many
+UPDATE listing_photos
+SET deleted_at = NOW(),
+    is_cover = FALSE,
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND listing_id = sqlc.arg(listing_id)
+  AND id = ANY(string_to_array(sqlc.arg(photo_ids)::text, ',')::uuid[])
+  AND deleted_at IS NULL
+RETURNING id;
+
+-- Makes the first live photo the cover of a listing that has none.
+
+-- name: EnsureListingCoverPhoto :exec
+UPDATE listing_photos
+SET is_cover = TRUE,
+    updated_at = NOW()
+WHERE id = (
+    SELECT p.id
+    FROM listing_photos p
+    WHERE p.tenant_id = $1
+      AND p.listing_id = $2
+      AND p.deleted_at IS NULL
+    ORDER BY p.position ASC
+    LIMIT 1
+)
+AND NOT EXISTS (
+    SELECT 1
+    FROM listing_photos c
+    WHERE c.tenant_id = $1
+      AND c.listing_id = $2
+      AND c.is_cover = TRUE
+      AND c.deleted_at IS NULL
+);
+
+-- name: MoveListingPhoto :one
+UPDATE listing_photos
+SET listing_id = sqlc.arg(target_listing_id),
+    position = sqlc.arg(position),
+    is_cover = FALSE,
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND listing_id = sqlc.arg(listing_id)
+  AND id = sqlc.arg(id)
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListTenantTrashedListingPhotos :many
+SELECT lp.id, lp.listing_id, lp.file_id, lp.position, lp.is_published, lp.deleted_at,
+       l.title AS listing_title,
+       f.original_key, f.thumbnail_key, f.file_size_bytes, f.mime_type
+FROM listing_photos lp
+JOIN listings l ON l.id = lp.listing_id
+JOIN files f ON f.id = lp.file_id
+WHERE lp.tenant_id = $1
+  AND lp.deleted_at IS NOT NULL
+  AND l.deleted_at IS NULL
+ORDER BY lp.deleted_at DESC, lp.id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetTrashedListingPhoto :one
+SELECT *
+FROM listing_photos
+WHERE tenant_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL;
+
+-- name: RestoreListingPhoto :one
+UPDATE listing_photos
+SET deleted_at = NULL, updated_at = NOW()
+WHERE tenant_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: ListPurgeableListingPhotos :many
+SELECT id, tenant_id
+FROM listing_photos
+WHERE deleted_at < sqlc.arg(deleted_before)::timestamptz
+ORDER BY deleted_at ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: DeletePurgeableListingPhoto :one
+DELETE FROM listing_photos
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id = sqlc.arg(id)
+  AND deleted_at < sqlc.arg(deleted_before)::timestamptz
+RETURNING file_id;
@@ -1,7 +1,7 @@
 -- name: CreateListing :one
 INSERT INTO listings (tenant_id, user_id, title, description, status, visibility, created_at)
 VALUES ($1, $2, $3, $4, $5, $6, NOW())
-RETURNING id, tenant_id, user_id, title, description, status, visibility, created_at, updated_at, deleted_at;
+RETURNING id, tenant_id, user_id, title, description, status, visibility, created_at, updated_at, deleted_at, published_at, publish_at, unpublish_at;
 
 -- name: GetListingByID :one
 SELECT *
@@ -24,13 +24,151 @@ UPDATE listings
 SET status = $3, visibility = $4, updated_at = NOW()
 WHERE tenant_id = $1
   AND id = $2
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UpdateListingStatus :one
+UPDATE listings
+SET status = sqlc.arg(status),
+    published_at = sqlc.narg(published_at)::timestamptz,
+    publish_at = sqlc.narg(publish_at)::timestamptz,
+    unpublish_at = sqlc.narg(unpublish_at)::timestamptz,
+    updated_at = NOW()
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id = sqlc.arg(id)
+  AND deleted_at IS NULL
 RETURNING *;
 
+-- name: GetListingForUpdate :one
+SELECT *
+FROM listings
+WHERE tenant_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+FOR UPDATE;
+
+-- Scheduled publish and unpublish times that have passed, soonest first.
+
+-- name: ListDueScheduledListings :many
+SELECT id, tenant_id
+FROM listings
+WHERE deleted_at IS NULL
+  AND (publish_at <= sqlc.arg(due_before)::timestamptz
+       OR unpublish_at <= sqlc.arg(due_before)::timestamptz)
+ORDER BY LEAST(publish_at, unpublish_at) ASC
+LIMIT sqlc.arg(page_limit);
+
 -- name: SoftDeleteListing :one
 UPDATE listings
 SET deleted_at = NOW(), updated_at = NOW()
 WHERE tenant_id = $1
   AND id = $2
+  AND deleted_at IS NULL
 RETURNING id;
 
 
+-- name: UpdateListingDetails :one
+UPDATE listings
+SET title = $3, description = $4, visibility = $5, updated_at = NOW()
+WHERE tenant_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- Keyset pages over idx_tenant_listing_created_at_desc. The cursor is the
+-- (created_at, id) of the last row of the previous page.
+
+-- name: ListTenantListingsNewest :many
+SELECT *
+FROM listings
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(visibility)::text IS NULL OR visibility = sqlc.narg(visibility))
+  AND (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id))
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (created_at, id) < (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListTenantListingsOldest :many
+SELECT *
+FROM listings
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(visibility)::text IS NULL OR visibility = sqlc.narg(visibility))
+  AND (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id))
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (created_at, id) > (sqlc.narg(cursor_created_at)::timestamptz, sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: ListTenantListingsByTitle :many
+SELECT *
+FROM listings
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(visibility)::text IS NULL OR visibility = sqlc.narg(visibility))
+  AND (sqlc.narg(user_id)::uuid IS NULL OR user_id = sqlc.narg(user_id))
+  AND (sqlc.narg(cursor_title)::text IS NULL
+       OR (title, id) > (sqlc.narg(cursor_title)::text, sqlc.narg(cursor_id)::uuid))
+ORDER BY title ASC, id ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: LockListing :one
+SELECT id
+FROM listings
+WHERE tenant_id = $1
+  AND id = $2
+  AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: CountTenantListings :one
+SELECT COUNT(*)
+FROM listings
+WHERE tenant_id = $1
+  AND deleted_at IS NULL;
+
+-- name: ListTenantTrashedListings :many
+SELECT *
+FROM listings
+WHERE tenant_id = $1
+  AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id DESC
+LIMIT $2 OFFSET $3;
+
+-- name: RestoreListing :one
+UPDATE listings
+SET deleted_at = NULL, updated_at = NOW()
+WHERE tenant_id = $1
+  AND id = $2
+  AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: ListPurgeableListings :many
+SELECT id, tenant_id
+FROM listings
+WHERE deleted_at < sqlc.arg(deleted_before)::timestamptz
+ORDER BY deleted_at ASC
+LIMIT sqlc.arg(page_limit);
+
+-- Locks a listing that has been in the trash since before deleted_before,
+-- so it cannot be restored while it is purged.
+
+-- name: LockPurgeableListing :one
+SELECT id
+FROM listings
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id = sqlc.arg(id)
+  AND deleted_at < sqlc.arg(deleted_before)::timestamptz
+FOR UPDATE;
+
+-- Removes the listing with its share links and any photos and files still
+-- attached to it.
+
+-- name: DeleteListing :exec
+DELETE FROM listings
+WHERE tenant_id = $1
+  AND id = $2;
@@ -13,6 +13,19 @@ UPDATE tenant_settings
 SET theme = $2,
     watermark_enabled = $3,
     watermark_text = $4,
+    watermark_type = $5,
+    watermark_position = $6,
+    watermark_opacity = $7,
+    watermark_scale = $8,
+    watermark_tiled = $9,
+    strip_gps = $10,
+    updated_at = NOW()
+WHERE tenant_id = $1
+RETURNING *;
+
+-- name: SetTenantWatermarkImage :one
+UPDATE tenant_settings
+SET watermark_image_key = $2,
     updated_at = NOW()
 WHERE tenant_id = $1
 RETURNING *;
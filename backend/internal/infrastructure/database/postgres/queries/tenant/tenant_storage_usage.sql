@@ -22,3 +22,17 @@ SELECT used_storage_bytes, created_at, updated_at
 FROM tenant_storage_usage
 WHERE tenant_id = $1;
 
+
+-- Adds delta only if the new total stays within max_bytes. The row lock taken
+-- by the upsert serialises concurrent uploads, so the check cannot be raced.
+-- No row is returned when the limit would be exceeded.
+
+-- name: AddTenantStorageUsageWithinLimit :one
+INSERT INTO tenant_storage_usage AS u (tenant_id, used_storage_bytes, created_at)
+SELECT sqlc.arg(tenant_id), sqlc.arg(delta)::bigint, NOW()
+WHERE sqlc.arg(delta)::bigint <= sqlc.arg(max_bytes)::bigint
+ON CONFLICT (tenant_id) DO UPDATE
+SET used_storage_bytes = u.used_storage_bytes + EXCLUDED.used_storage_bytes,
+    updated_at = NOW()
+WHERE u.used_storage_bytes + EXCLUDED.used_storage_bytes <= sqlc.arg(max_bytes)::bigint
+RETURNING used_storage_bytes;
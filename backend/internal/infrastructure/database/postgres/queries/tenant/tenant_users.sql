@@ -25,3 +25,29 @@ ORDER BY role ASC, created_at DESC;
 SELECT tenant_id, role, created_at, updated_at
 FROM tenant_users
 WHERE user_id = $1;
+
+-- name: GetTenantUser :one
+SELECT tenant_id, user_id, role, created_at, updated_at
+FROM tenant_users
+WHERE tenant_id = $1
+  AND user_id = $2;
+
+-- name: ListTenantMembers :many
+SELECT u.id, u.username, u.email, tu.role, tu.created_at, tu.updated_at
+FROM tenant_users tu
+JOIN users u ON u.id = tu.user_id
+WHERE tu.tenant_id = $1
+ORDER BY tu.created_at ASC;
+
+-- name: GetTenantMember :one
+SELECT u.id, u.username, u.email, tu.role, tu.created_at, tu.updated_at
+FROM tenant_users tu
+JOIN users u ON u.id = tu.user_id
+WHERE tu.tenant_id = $1
+  AND tu.user_id = $2;
+
+-- name: CountTenantUsersByRole :one
+SELECT COUNT(*) AS count
+FROM tenant_users
+WHERE tenant_id = $1
+  AND role = $2;
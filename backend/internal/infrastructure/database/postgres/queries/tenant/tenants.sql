@@ -20,3 +20,9 @@ SET name = $2, updated_at = NOW()
 WHERE id = $1
 RETURNING id, name, created_at, updated_at;
 
+
+-- name: LockTenant :one
+SELECT id
+FROM tenants
+WHERE id = $1
+FOR UPDATE;
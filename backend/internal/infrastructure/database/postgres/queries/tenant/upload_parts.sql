@@ -0,0 +1,16 @@
+-- name: AddUploadPart :exec
+INSERT INTO upload_parts (upload_id, tenant_id, part_offset, size_bytes, object_key)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListUploadParts :many
+SELECT *
+FROM upload_parts
+WHERE tenant_id = $1
+  AND upload_id = $2
+ORDER BY part_offset ASC;
+
+-- name: DeleteUploadParts :many
+DELETE FROM upload_parts
+WHERE tenant_id = $1
+  AND upload_id = $2
+RETURNING object_key;
@@ -0,0 +1,35 @@
+-- name: CreateUploadReservation :one
+INSERT INTO upload_reservations (tenant_id, listing_id, user_id, filename, object_key, mime_type, file_size_bytes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetUploadReservation :one
+SELECT *
+FROM upload_reservations
+WHERE tenant_id = $1
+  AND user_id = $2
+  AND id = $3;
+
+-- name: CompleteUploadReservation :one
+UPDATE upload_reservations
+SET photo_id = $3,
+    completed_at = NOW()
+WHERE tenant_id = $1
+  AND id = $2
+  AND completed_at IS NULL
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: ListExpiredUploadReservations :many
+SELECT id, tenant_id
+FROM upload_reservations
+WHERE expires_at <= NOW()
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: DeleteExpiredUploadReservation :one
+DELETE FROM upload_reservations
+WHERE tenant_id = $1
+  AND id = $2
+  AND expires_at <= NOW()
+RETURNING *;
@@ -0,0 +1,54 @@
+-- name: CreateUpload :one
+INSERT INTO uploads (tenant_id, listing_id, user_id, filename, metadata, upload_length, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetUpload :one
+SELECT *
+FROM uploads
+WHERE tenant_id = $1
+  AND user_id = $2
+  AND id = $3;
+
+-- Moves the offset forward only from the offset the caller appended at, so
+-- of two PATCHes racing for the same offset only one is kept.
+
+-- name: AdvanceUploadOffset :one
+UPDATE uploads
+SET upload_offset = sqlc.arg(new_offset)
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND id = sqlc.arg(id)
+  AND upload_offset = sqlc.arg(expected_offset)
+  AND completed_at IS NULL
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: CompleteUpload :one
+UPDATE uploads
+SET photo_id = $3,
+    completed_at = NOW()
+WHERE tenant_id = $1
+  AND id = $2
+  AND completed_at IS NULL
+RETURNING *;
+
+-- name: DeleteUpload :one
+DELETE FROM uploads
+WHERE tenant_id = $1
+  AND user_id = $2
+  AND id = $3
+RETURNING id;
+
+-- name: ListExpiredUploads :many
+SELECT id, tenant_id
+FROM uploads
+WHERE expires_at <= NOW()
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: DeleteExpiredUpload :one
+DELETE FROM uploads
+WHERE tenant_id = $1
+  AND id = $2
+  AND expires_at <= NOW()
+RETURNING id;
@@ -0,0 +1,429 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TIFF tags read from and written to EXIF blocks.
+const (
+	tagImageDescription = 0x010E
+	tagMake             = 0x010F
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagArtist           = 0x013B
+	tagCopyright        = 0x8298
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+
+	tagExposureTime       = 0x829A
+	tagFNumber            = 0x829D
+	tagISO                = 0x8827
+	tagDateTimeOriginal   = 0x9003
+	tagOffsetTimeOriginal = 0x9011
+	tagFocalLength        = 0x920A
+	tagLensModel          = 0xA434
+
+	tagGPSVersionID    = 0x0000
+	tagGPSLatitudeRef  = 0x0001
+	tagGPSLatitude     = 0x0002
+	tagGPSLongitudeRef = 0x0003
+	tagGPSLongitude    = 0x0004
+)
+
+// TIFF field types.
+const (
+	typeByte      = 1
+	typeASCII     = 2
+	typeShort     = 3
+	typeLong      = 4
+	typeRational  = 5
+	typeUndefined = 7
+	typeSLong     = 9
+	typeSRational = 10
+)
+
+var typeSizes = map[uint16]int{
+	typeByte: 1, typeASCII: 1, typeShort: 2, typeLong: 4,
+	typeRational: 8, typeUndefined: 1, typeSLong: 4, typeSRational: 8,
+}
+
+// maxIFDEntries bounds a single directory so corrupt counts cannot make the
+// parser walk megabytes of garbage.
+const maxIFDEntries = 512
+
+// exifDateFormat is the layout of EXIF date/time strings.
+const exifDateFormat = "2006:01:02 15:04:05"
+
+// tiffField is one directory entry with its value bytes resolved.
+type tiffField struct {
+	typ   uint16
+	count int
+	value []byte
+}
+
+type tiffReader struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// readEXIF fills meta from a TIFF-structured EXIF block. Unknown, malformed
+// or out-of-range fields are ignored.
+func readEXIF(data []byte, meta *Metadata) {
+	if len(data) < 8 {
+		return
+	}
+	r := &tiffReader{data: data}
+	switch string(data[:2]) {
+	case "II":
+		r.order = binary.LittleEndian
+	case "MM":
+		r.order = binary.BigEndian
+	default:
+		return
+	}
+	if r.order.Uint16(data[2:]) != 42 {
+		return
+	}
+
+	ifd0 := r.ifd(r.order.Uint32(data[4:]))
+	if o := r.uint(ifd0[tagOrientation]); o >= 1 && o <= 8 {
+		meta.Orientation = o
+	}
+	meta.CameraMake = r.ascii(ifd0[tagMake])
+	meta.CameraModel = r.ascii(ifd0[tagModel])
+	meta.Artist = r.ascii(ifd0[tagArtist])
+	meta.Copyright = r.ascii(ifd0[tagCopyright])
+	meta.Description = r.ascii(ifd0[tagImageDescription])
+
+	if f, ok := ifd0[tagExifIFD]; ok {
+		exif := r.ifd(uint32(r.uint(f)))
+		meta.ExposureTime = r.rational(exif[tagExposureTime], 0)
+		meta.FNumber = r.rational(exif[tagFNumber], 0)
+		meta.FocalLength = r.rational(exif[tagFocalLength], 0)
+		meta.ISO = r.uint(exif[tagISO])
+		meta.LensModel = r.ascii(exif[tagLensModel])
+		meta.CapturedAt = parseEXIFTime(r.ascii(exif[tagDateTimeOriginal]), r.ascii(exif[tagOffsetTimeOriginal]))
+	}
+
+	if f, ok := ifd0[tagGPSIFD]; ok {
+		gps := r.ifd(uint32(r.uint(f)))
+		lat, latOK := r.degrees(gps[tagGPSLatitude], r.ascii(gps[tagGPSLatitudeRef]), "S")
+		lon, lonOK := r.degrees(gps[tagGPSLongitude], r.ascii(gps[tagGPSLongitudeRef]), "W")
+		if latOK && lonOK && math.Abs(lat) <= 90 && math.Abs(lon) <= 180 {
+			meta.GPS = &GPS{Latitude: lat, Longitude: lon}
+		}
+	}
+}
+
+// ifd reads the directory at offset.
+func (r *tiffReader) ifd(offset uint32) map[uint16]tiffField {
+	fields := make(map[uint16]tiffField)
+	// Offsets are compared as uint64 so they cannot wrap int on 32-bit platforms
+	if offset == 0 || uint64(offset)+2 > uint64(len(r.data)) {
+		return fields
+	}
+	start := int(offset)
+	n := int(r.order.Uint16(r.data[start:]))
+	if n > maxIFDEntries {
+		return fields
+	}
+	for i := 0; i < n; i++ {
+		entry := start + 2 + i*12
+		if entry+12 > len(r.data) {
+			break
+		}
+		typ := r.order.Uint16(r.data[entry+2:])
+		size, ok := typeSizes[typ]
+		if !ok {
+			continue
+		}
+		count := int(r.order.Uint32(r.data[entry+4:]))
+		total := size * count
+		if count <= 0 || total/size != count || total > len(r.data) {
+			continue
+		}
+		// Values of up to four bytes are stored inline in the entry
+		value := r.data[entry+8 : entry+12]
+		if total > 4 {
+			at := uint64(r.order.Uint32(r.data[entry+8:]))
+			if at+uint64(total) > uint64(len(r.data)) {
+				continue
+			}
+			value = r.data[at : at+uint64(total)]
+		}
+		fields[r.order.Uint16(r.data[entry:])] = tiffField{typ: typ, count: count, value: value[:total]}
+	}
+	return fields
+}
+
+func (r *tiffReader) ascii(f tiffField) string {
+	if f.typ != typeASCII && f.typ != typeUndefined {
+		return ""
+	}
+	return cleanText(f.value)
+}
+
+// uint returns the first value of an integer field, or 0.
+func (r *tiffReader) uint(f tiffField) int {
+	switch f.typ {
+	case typeShort:
+		return int(r.order.Uint16(f.value))
+	case typeLong:
+		return int(r.order.Uint32(f.value))
+	case typeSLong:
+		return max(0, int(int32(r.order.Uint32(f.value))))
+	}
+	return 0
+}
+
+// rational returns the i-th value of a rational field, or 0.
+func (r *tiffReader) rational(f tiffField, i int) float64 {
+	if (f.typ != typeRational && f.typ != typeSRational) || i >= f.count {
+		return 0
+	}
+	num, den := r.order.Uint32(f.value[i*8:]), r.order.Uint32(f.value[i*8+4:])
+	if den == 0 {
+		return 0
+	}
+	if f.typ == typeSRational {
+		return float64(int32(num)) / float64(int32(den))
+	}
+	return float64(num) / float64(den)
+}
+
+// degrees converts a GPS degrees/minutes/seconds triple to decimal degrees,
+// negated when ref is the southern or western hemisphere.
+func (r *tiffReader) degrees(f tiffField, ref, negative string) (float64, bool) {
+	if f.typ != typeRational || f.count < 3 {
+		return 0, false
+	}
+	v := r.rational(f, 0) + r.rational(f, 1)/60 + r.rational(f, 2)/3600
+	if strings.EqualFold(ref, negative) {
+		v = -v
+	}
+	return v, true
+}
+
+// parseEXIFTime parses an EXIF timestamp. Without an offset tag the camera's
+// local time zone is unknown, so the wall clock is recorded as UTC.
+func parseEXIFTime(value, offset string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	loc := time.UTC
+	if offset != "" {
+		if t, err := time.Parse("-07:00", offset); err == nil {
+			loc = t.Location()
+		}
+	}
+	t, err := time.ParseInLocation(exifDateFormat, value, loc)
+	if err != nil || t.Year() < 1900 {
+		return nil
+	}
+	return &t
+}
+
+// EXIF encodes the descriptive fields of m as a TIFF-structured EXIF block
+// for embedding in renditions. Only capture settings and attribution are
+// carried over, so serial numbers, owner names and maker notes never leave
+// the original. The location is included only when withGPS is set.
+// Orientation is always 1 because renditions are stored upright.
+func (m *Metadata) EXIF(withGPS bool) []byte {
+	artist := firstNonEmpty(m.Artist, m.IPTC.Creator)
+	copyright := firstNonEmpty(m.Copyright, m.IPTC.Copyright)
+	description := firstNonEmpty(m.Description, m.IPTC.Caption)
+
+	ifd0 := tiffIFD{shortField(tagOrientation, 1)}
+	ifd0 = ifd0.ascii(tagImageDescription, description)
+	ifd0 = ifd0.ascii(tagMake, m.CameraMake)
+	ifd0 = ifd0.ascii(tagModel, m.CameraModel)
+	ifd0 = ifd0.ascii(tagArtist, artist)
+	ifd0 = ifd0.ascii(tagCopyright, copyright)
+
+	var exif tiffIFD
+	if m.ExposureTime > 0 {
+		exif = append(exif, rationalField(tagExposureTime, exposureRational(m.ExposureTime)))
+	}
+	if m.FNumber > 0 {
+		exif = append(exif, rationalField(tagFNumber, tenths(m.FNumber)))
+	}
+	if m.ISO > 0 && m.ISO <= math.MaxUint16 {
+		exif = append(exif, shortField(tagISO, uint16(m.ISO)))
+	}
+	if m.CapturedAt != nil {
+		exif = exif.ascii(tagDateTimeOriginal, m.CapturedAt.Format(exifDateFormat))
+		exif = exif.ascii(tagOffsetTimeOriginal, m.CapturedAt.Format("-07:00"))
+	}
+	if m.FocalLength > 0 {
+		exif = append(exif, rationalField(tagFocalLength, tenths(m.FocalLength)))
+	}
+	exif = exif.ascii(tagLensModel, m.LensModel)
+
+	var gps tiffIFD
+	if withGPS && m.GPS != nil {
+		latRef, lonRef := "N", "E"
+		if m.GPS.Latitude < 0 {
+			latRef = "S"
+		}
+		if m.GPS.Longitude < 0 {
+			lonRef = "W"
+		}
+		gps = tiffIFD{
+			{tag: tagGPSVersionID, typ: typeByte, count: 4, value: []byte{2, 3, 0, 0}},
+		}
+		gps = gps.ascii(tagGPSLatitudeRef, latRef)
+		gps = append(gps, rationalField(tagGPSLatitude, dms(math.Abs(m.GPS.Latitude))...))
+		gps = gps.ascii(tagGPSLongitudeRef, lonRef)
+		gps = append(gps, rationalField(tagGPSLongitude, dms(math.Abs(m.GPS.Longitude))...))
+	}
+
+	return encodeTIFF(ifd0, exif, gps)
+}
+
+// tiffEntry is a directory entry being written, with its value in big-endian order.
+type tiffEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+type tiffIFD []tiffEntry
+
+// ascii appends a NUL-terminated string field unless s is empty.
+func (d tiffIFD) ascii(tag uint16, s string) tiffIFD {
+	if s == "" {
+		return d
+	}
+	return append(d, tiffEntry{tag: tag, typ: typeASCII, count: uint32(len(s) + 1), value: append([]byte(s), 0)})
+}
+
+func shortField(tag, v uint16) tiffEntry {
+	return tiffEntry{tag: tag, typ: typeShort, count: 1, value: binary.BigEndian.AppendUint16(nil, v)}
+}
+
+func longField(tag uint16, v uint32) tiffEntry {
+	return tiffEntry{tag: tag, typ: typeLong, count: 1, value: binary.BigEndian.AppendUint32(nil, v)}
+}
+
+// rationalField builds a RATIONAL field from numerator/denominator pairs.
+func rationalField(tag uint16, pairs ...[2]uint32) tiffEntry {
+	value := make([]byte, 0, 8*len(pairs))
+	for _, p := range pairs {
+		value = binary.BigEndian.AppendUint32(value, p[0])
+		value = binary.BigEndian.AppendUint32(value, p[1])
+	}
+	return tiffEntry{tag: tag, typ: typeRational, count: uint32(len(pairs)), value: value}
+}
+
+// size is the encoded length of the directory including out-of-line values.
+func (d tiffIFD) size() int {
+	n := 2 + 12*len(d) + 4
+	for _, e := range d {
+		if len(e.value) > 4 {
+			n += len(e.value) + len(e.value)%2
+		}
+	}
+	return n
+}
+
+// encodeTIFF lays out IFD0 followed by the optional EXIF and GPS
+// sub-directories, linking them from IFD0.
+func encodeTIFF(ifd0, exif, gps tiffIFD) []byte {
+	// Pointer fields are added first so every directory size is final
+	if len(exif) > 0 {
+		ifd0 = append(ifd0, longField(tagExifIFD, 0))
+	}
+	if len(gps) > 0 {
+		ifd0 = append(ifd0, longField(tagGPSIFD, 0))
+	}
+	exifAt := 8 + ifd0.size()
+	gpsAt := exifAt
+	if len(exif) > 0 {
+		gpsAt += exif.size()
+	}
+	for i := range ifd0 {
+		switch ifd0[i].tag {
+		case tagExifIFD:
+			ifd0[i] = longField(tagExifIFD, uint32(exifAt))
+		case tagGPSIFD:
+			ifd0[i] = longField(tagGPSIFD, uint32(gpsAt))
+		}
+	}
+
+	out := []byte{'M', 'M', 0, 42, 0, 0, 0, 8}
+	out = ifd0.encode(out)
+	if len(exif) > 0 {
+		out = exif.encode(out)
+	}
+	if len(gps) > 0 {
+		out = gps.encode(out)
+	}
+	return out
+}
+
+// encode appends the directory at the current end of out, with its
+// out-of-line values directly after it.
+func (d tiffIFD) encode(out []byte) []byte {
+	sort.Slice(d, func(i, j int) bool { return d[i].tag < d[j].tag })
+
+	dataAt := len(out) + 2 + 12*len(d) + 4
+	var data []byte
+	out = binary.BigEndian.AppendUint16(out, uint16(len(d)))
+	for _, e := range d {
+		out = binary.BigEndian.AppendUint16(out, e.tag)
+		out = binary.BigEndian.AppendUint16(out, e.typ)
+		out = binary.BigEndian.AppendUint32(out, e.count)
+		if len(e.value) <= 4 {
+			var inline [4]byte
+			copy(inline[:], e.value)
+			out = append(out, inline[:]...)
+			continue
+		}
+		out = binary.BigEndian.AppendUint32(out, uint32(dataAt+len(data)))
+		data = append(data, e.value...)
+		if len(data)%2 == 1 {
+			data = append(data, 0)
+		}
+	}
+	// No further image directories
+	out = binary.BigEndian.AppendUint32(out, 0)
+	return append(out, data...)
+}
+
+// exposureRational writes exposures under a second as 1/N, the way cameras do.
+func exposureRational(seconds float64) [2]uint32 {
+	if seconds >= 1 {
+		return tenths(seconds)
+	}
+	return [2]uint32{1, uint32(math.Round(1 / seconds))}
+}
+
+func tenths(v float64) [2]uint32 {
+	return [2]uint32{uint32(math.Round(v * 10)), 10}
+}
+
+// dms splits decimal degrees into degrees, minutes and hundredths of seconds.
+func dms(v float64) [][2]uint32 {
+	deg := math.Floor(v)
+	minutes := math.Floor((v - deg) * 60)
+	seconds := (v - deg - minutes/60) * 3600
+	return [][2]uint32{
+		{uint32(deg), 1},
+		{uint32(minutes), 1},
+		{uint32(math.Round(seconds * 100)), 100},
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,265 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// rawEntry is a directory entry written verbatim, so tests can corrupt it.
+type rawEntry struct {
+	tag, typ uint16
+	count    uint32
+	value    uint32
+}
+
+// rawTIFF lays out a little-endian TIFF block whose header points at
+// ifdOffset, with one directory at offset 8 declaring n entries and holding
+// entries, followed by tail. Tail data starts at rawTail(len(entries)).
+func rawTIFF(ifdOffset uint32, n uint16, entries []rawEntry, tail []byte) []byte {
+	le := binary.LittleEndian
+	out := []byte{'I', 'I', 42, 0}
+	out = le.AppendUint32(out, ifdOffset)
+	out = le.AppendUint16(out, n)
+	for _, e := range entries {
+		out = le.AppendUint16(out, e.tag)
+		out = le.AppendUint16(out, e.typ)
+		out = le.AppendUint32(out, e.count)
+		out = le.AppendUint32(out, e.value)
+	}
+	out = le.AppendUint32(out, 0)
+	return append(out, tail...)
+}
+
+func rawTail(entries int) uint32 {
+	return uint32(8 + 2 + 12*entries + 4)
+}
+
+func TestReadEXIFRoundTrip(t *testing.T) {
+	captured := time.Date(2024, 5, 1, 14, 30, 0, 0, time.FixedZone("", 2*60*60))
+	want := Metadata{
+		Orientation:  1,
+		CapturedAt:   &captured,
+		CameraMake:   "Canon",
+		CameraModel:  "EOS R5",
+		LensModel:    "RF 24-70mm",
+		FocalLength:  35,
+		FNumber:      2.8,
+		ExposureTime: 0.004,
+		ISO:          400,
+		Artist:       "Jane Doe",
+		Copyright:    "(c) Jane Doe",
+		Description:  "Front view",
+		GPS:          &GPS{Latitude: -33.8568, Longitude: 151.2153},
+	}
+
+	var got Metadata
+	readEXIF(want.EXIF(true), &got)
+
+	if got.CapturedAt == nil || !got.CapturedAt.Equal(captured) {
+		t.Errorf("CapturedAt = %v, want %v", got.CapturedAt, captured)
+	}
+	if got.GPS == nil || math.Abs(got.GPS.Latitude-want.GPS.Latitude) > 1e-5 || math.Abs(got.GPS.Longitude-want.GPS.Longitude) > 1e-5 {
+		t.Errorf("GPS = %+v, want %+v", got.GPS, want.GPS)
+	}
+	got.CapturedAt, got.GPS = want.CapturedAt, want.GPS
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("readEXIF = %+v, want %+v", got, want)
+	}
+}
+
+func TestReadEXIFMalformed(t *testing.T) {
+	cameraMake := []byte("Canon\x00")
+	model := []byte("EOS R5\x00")
+	tests := []struct {
+		name      string
+		data      []byte
+		wantMake  string
+		wantModel string
+	}{
+		{
+			name: "valid",
+			data: rawTIFF(8, 2, []rawEntry{
+				{tag: tagMake, typ: typeASCII, count: 6, value: rawTail(2)},
+				{tag: tagModel, typ: typeASCII, count: 7, value: rawTail(2) + 6},
+			}, append(cameraMake, model...)),
+			wantMake:  "Canon",
+			wantModel: "EOS R5",
+		},
+		{
+			name: "short header",
+			data: []byte("II*\x00\x08\x00"),
+		},
+		{
+			name: "unknown byte order",
+			data: []byte("XX*\x00\x08\x00\x00\x00\x00\x00"),
+		},
+		{
+			name: "bad magic",
+			data: []byte("II\x2b\x00\x08\x00\x00\x00\x00\x00"),
+		},
+		{
+			name: "directory offset out of range",
+			data: rawTIFF(math.MaxUint32, 1, []rawEntry{
+				{tag: tagModel, typ: typeASCII, count: 4, value: 0x00313233},
+			}, nil),
+		},
+		{
+			name: "directory offset at last byte",
+			data: rawTIFF(rawTail(0)-1, 0, nil, nil),
+		},
+		{
+			name: "truncated directory keeps whole entries",
+			data: rawTIFF(8, 3, []rawEntry{
+				{tag: tagModel, typ: typeASCII, count: 4, value: 0x00355245},
+				{tag: tagMake, typ: typeASCII, count: 4, value: 0x00414141},
+			}, nil)[:8+2+12+6],
+			wantModel: "ER5",
+		},
+		{
+			name: "more entries than allowed",
+			data: rawTIFF(8, maxIFDEntries+1, []rawEntry{
+				{tag: tagModel, typ: typeASCII, count: 4, value: 0x00355245},
+			}, nil),
+		},
+		{
+			name: "value offset out of range",
+			data: rawTIFF(8, 2, []rawEntry{
+				{tag: tagMake, typ: typeASCII, count: 6, value: math.MaxUint32 - 2},
+				{tag: tagModel, typ: typeASCII, count: 7, value: rawTail(2)},
+			}, model),
+			wantModel: "EOS R5",
+		},
+		{
+			name: "value runs past the end",
+			data: rawTIFF(8, 2, []rawEntry{
+				{tag: tagMake, typ: typeASCII, count: 6, value: rawTail(2) + 4},
+				{tag: tagModel, typ: typeASCII, count: 7, value: rawTail(2)},
+			}, model),
+			wantModel: "EOS R5",
+		},
+		{
+			name: "oversized counts",
+			data: rawTIFF(8, 4, []rawEntry{
+				{tag: tagMake, typ: typeASCII, count: math.MaxUint32, value: rawTail(4)},
+				{tag: tagExifIFD, typ: typeLong, count: math.MaxUint32, value: 8},
+				{tag: tagGPSIFD, typ: typeSRational, count: math.MaxUint32 / 4, value: 8},
+				{tag: tagModel, typ: typeASCII, count: 7, value: rawTail(4)},
+			}, model),
+			wantModel: "EOS R5",
+		},
+		{
+			name: "zero count",
+			data: rawTIFF(8, 1, []rawEntry{
+				{tag: tagModel, typ: typeASCII, count: 0, value: 0x00355245},
+			}, nil),
+		},
+		{
+			name: "unknown type",
+			data: rawTIFF(8, 1, []rawEntry{
+				{tag: tagModel, typ: 99, count: 4, value: 0x00355245},
+			}, nil),
+		},
+		{
+			// Sub-directories are read once from IFD0, never followed
+			// further, so pointing them back at IFD0 cannot loop.
+			name: "sub-directories pointing at IFD0",
+			data: rawTIFF(8, 3, []rawEntry{
+				{tag: tagModel, typ: typeASCII, count: 4, value: 0x00355245},
+				{tag: tagExifIFD, typ: typeLong, count: 1, value: 8},
+				{tag: tagGPSIFD, typ: typeLong, count: 1, value: 8},
+			}, nil),
+			wantModel: "ER5",
+		},
+		{
+			name: "negative sub-directory offset",
+			data: rawTIFF(8, 2, []rawEntry{
+				{tag: tagModel, typ: typeASCII, count: 4, value: 0x00355245},
+				{tag: tagExifIFD, typ: typeSLong, count: 1, value: math.MaxUint32},
+			}, nil),
+			wantModel: "ER5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var meta Metadata
+			readEXIF(tt.data, &meta)
+			if meta.CameraMake != tt.wantMake || meta.CameraModel != tt.wantModel {
+				t.Errorf("make, model = %q, %q, want %q, %q", meta.CameraMake, meta.CameraModel, tt.wantMake, tt.wantModel)
+			}
+		})
+	}
+}
+
+func TestReadEXIFTruncated(t *testing.T) {
+	captured := time.Date(2024, 5, 1, 14, 30, 0, 0, time.UTC)
+	full := (&Metadata{
+		CapturedAt:  &captured,
+		CameraMake:  "Canon",
+		CameraModel: "EOS R5",
+		FNumber:     2.8,
+		GPS:         &GPS{Latitude: 51.5, Longitude: -0.12},
+	}).EXIF(true)
+	for n := range len(full) {
+		var meta Metadata
+		readEXIF(full[:n], &meta)
+	}
+}
+
+// TestReadEXIFAllocations checks that counts and offsets claiming gigabytes
+// cost no more than a well-formed block, since values are sliced from data.
+func TestReadEXIFAllocations(t *testing.T) {
+	entries := make([]rawEntry, maxIFDEntries)
+	for i := range entries {
+		entries[i] = rawEntry{tag: uint16(i), typ: typeSRational, count: math.MaxUint32 / 8, value: 8}
+	}
+	entries[0] = rawEntry{tag: tagExifIFD, typ: typeLong, count: 1, value: 8}
+	entries[1] = rawEntry{tag: tagGPSIFD, typ: typeLong, count: 1, value: 8}
+	data := rawTIFF(8, maxIFDEntries, entries, make([]byte, 1<<16))
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for range 10 {
+		var meta Metadata
+		readEXIF(data, &meta)
+	}
+	runtime.ReadMemStats(&after)
+	if perRun := (after.TotalAlloc - before.TotalAlloc) / 10; perRun > 1<<20 {
+		t.Errorf("readEXIF allocated %d bytes per run, want at most 1 MiB", perRun)
+	}
+}
+
+func FuzzParseExif(f *testing.F) {
+	captured := time.Date(2024, 5, 1, 14, 30, 0, 0, time.UTC)
+	f.Add((&Metadata{
+		CapturedAt:   &captured,
+		CameraMake:   "Canon",
+		CameraModel:  "EOS R5",
+		LensModel:    "RF 24-70mm",
+		FocalLength:  35,
+		FNumber:      2.8,
+		ExposureTime: 0.004,
+		ISO:          400,
+		Artist:       "Jane Doe",
+		GPS:          &GPS{Latitude: 51.5, Longitude: -0.12},
+	}).EXIF(true))
+	f.Add(rawTIFF(8, 2, []rawEntry{
+		{tag: tagExifIFD, typ: typeLong, count: 1, value: 8},
+		{tag: tagGPSIFD, typ: typeSRational, count: math.MaxUint32, value: 8},
+	}, nil))
+	f.Add([]byte("MM\x00*\x00\x00\x00\x08"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var meta Metadata
+		readEXIF(data, &meta)
+		if meta.Orientation < 0 || meta.Orientation > 8 {
+			t.Errorf("Orientation = %d", meta.Orientation)
+		}
+		if meta.GPS != nil && (math.Abs(meta.GPS.Latitude) > 90 || math.Abs(meta.GPS.Longitude) > 180) {
+			t.Errorf("GPS = %+v out of range", *meta.GPS)
+		}
+	})
+}
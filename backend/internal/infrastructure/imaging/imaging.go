@@ -0,0 +1,154 @@
+// Package imaging decodes, resizes and re-encodes photos in pure Go so the
+// worker needs no native image libraries.
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"slices"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+)
+
+// MaxPixels bounds decoded images so a small, highly compressed file cannot
+// exhaust the worker's memory.
+const MaxPixels = 100_000_000
+
+// jpegQuality is used for every JPEG rendition.
+const jpegQuality = 85
+
+// Imaging errors.
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image dimensions exceed the pixel limit")
+)
+
+// Decode parses a JPEG, PNG or WebP image, checking its dimensions before the
+// pixel data is decoded, and turns it upright according to its EXIF
+// orientation. Every error means the data itself is unusable.
+func Decode(data []byte) (image.Image, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s header: %w", format, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s image: %w", format, err)
+	}
+	if exif, _ := findMetadata(data); exif != nil {
+		meta := Metadata{Orientation: 1}
+		readEXIF(exif, &meta)
+		img = Orient(img, meta.Orientation)
+	}
+	return img, nil
+}
+
+// FitWidth scales img to width pixels wide, keeping its aspect ratio.
+func FitWidth(img image.Image, width int) image.Image {
+	b := img.Bounds()
+	height := max(1, (b.Dy()*width+b.Dx()/2)/b.Dx())
+	return scale(img, b, width, height)
+}
+
+// Square crops the centre square of img and scales it to size×size.
+func Square(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	return scale(img, image.Rect(x, y, x+side, y+side), size, size)
+}
+
+func scale(img image.Image, src image.Rectangle, width, height int) image.Image {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+// Encode writes img as JPEG, or as PNG when it has transparency that JPEG
+// would lose. It returns the MIME type and file extension used.
+func Encode(w io.Writer, img image.Image) (mimeType, ext string, err error) {
+	return EncodeWithEXIF(w, img, nil)
+}
+
+// EncodeWithEXIF is Encode with a TIFF-structured EXIF block, as built by
+// Metadata.EXIF, embedded in the output. A nil block embeds nothing.
+func EncodeWithEXIF(w io.Writer, img image.Image, exif []byte) (mimeType, ext string, err error) {
+	var buf bytes.Buffer
+	if opaque(img) {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return "", "", fmt.Errorf("failed to encode jpeg: %w", err)
+		}
+		mimeType, ext = "image/jpeg", ".jpg"
+	} else {
+		encoder := png.Encoder{CompressionLevel: png.BestSpeed}
+		if err := encoder.Encode(&buf, img); err != nil {
+			return "", "", fmt.Errorf("failed to encode png: %w", err)
+		}
+		mimeType, ext = "image/png", ".png"
+	}
+
+	out := buf.Bytes()
+	if exif != nil {
+		if ext == ".jpg" {
+			out = insertJPEGSegment(out, 0xE1, append(slices.Clip(exifHeader), exif...))
+		} else {
+			out = insertPNGChunk(out, "eXIf", exif)
+		}
+	}
+	if _, err := w.Write(out); err != nil {
+		return "", "", fmt.Errorf("failed to write image: %w", err)
+	}
+	return mimeType, ext, nil
+}
+
+// insertJPEGSegment places an APPn segment right after the SOI marker. Blocks
+// too large for a segment are dropped rather than failing the render.
+func insertJPEGSegment(data []byte, marker byte, payload []byte) []byte {
+	if len(payload)+2 > math.MaxUint16 {
+		return data
+	}
+	out := make([]byte, 0, len(data)+4+len(payload))
+	out = append(out, data[:2]...)
+	out = append(out, 0xFF, marker)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(payload)+2))
+	out = append(out, payload...)
+	return append(out, data[2:]...)
+}
+
+// insertPNGChunk places a chunk right after IHDR, which is always the first.
+func insertPNGChunk(data []byte, name string, payload []byte) []byte {
+	at := len(pngSignature) + 12 + 13
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	chunk = append(chunk, name...)
+	chunk = append(chunk, payload...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	out := make([]byte, 0, len(data)+len(chunk))
+	out = append(out, data[:at]...)
+	out = append(out, chunk...)
+	return append(out, data[at:]...)
+}
+
+func opaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
@@ -0,0 +1,254 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"strings"
+	"time"
+)
+
+// Metadata is the capture information embedded in a photo. Zero values mean
+// the tag was absent.
+type Metadata struct {
+	// Width and Height are the dimensions of the upright image, i.e. after
+	// Orientation has been applied.
+	Width       int
+	Height      int
+	Orientation int
+	CapturedAt  *time.Time
+	CameraMake  string
+	CameraModel string
+	LensModel   string
+	// FocalLength is in millimetres, ExposureTime in seconds.
+	FocalLength  float64
+	FNumber      float64
+	ExposureTime float64
+	ISO          int
+	Artist       string
+	Copyright    string
+	Description  string
+	GPS          *GPS
+	IPTC         IPTC
+}
+
+// GPS is the location a photo was taken at, in decimal degrees.
+type GPS struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// IPTC holds the IPTC-IIM fields photographers commonly fill in.
+type IPTC struct {
+	Title     string
+	Headline  string
+	Caption   string
+	Keywords  []string
+	Creator   string
+	Copyright string
+	City      string
+	Country   string
+}
+
+// IsZero reports whether no IPTC field is set.
+func (i IPTC) IsZero() bool {
+	return i.Title == "" && i.Headline == "" && i.Caption == "" && len(i.Keywords) == 0 &&
+		i.Creator == "" && i.Copyright == "" && i.City == "" && i.Country == ""
+}
+
+// ReadMetadata extracts the EXIF and IPTC metadata of a JPEG, PNG or WebP
+// image. Only an unreadable image is an error; malformed or missing metadata
+// blocks are skipped.
+func ReadMetadata(data []byte) (*Metadata, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image header: %w", err)
+	}
+
+	meta := &Metadata{Width: cfg.Width, Height: cfg.Height, Orientation: 1}
+	exif, iptc := findMetadata(data)
+	if exif != nil {
+		readEXIF(exif, meta)
+	}
+	if iptc != nil {
+		meta.IPTC = readIPTC(iptc)
+	}
+	if swapsAxes(meta.Orientation) {
+		meta.Width, meta.Height = meta.Height, meta.Width
+	}
+	return meta, nil
+}
+
+// findMetadata locates the raw TIFF-structured EXIF block and the IPTC-IIM
+// records in the image container. Either may be nil.
+func findMetadata(data []byte) (exif, iptc []byte) {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		return jpegMetadata(data)
+	case bytes.HasPrefix(data, pngSignature):
+		return pngChunk(data, "eXIf"), nil
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return bytes.TrimPrefix(webpChunk(data, "EXIF"), exifHeader), nil
+	}
+	return nil, nil
+}
+
+var (
+	exifHeader      = []byte("Exif\x00\x00")
+	photoshopHeader = []byte("Photoshop 3.0\x00")
+	pngSignature    = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// jpegMetadata walks the JPEG marker segments up to the image data, picking
+// the first EXIF APP1 and Photoshop APP13 segments.
+func jpegMetadata(data []byte) (exif, iptc []byte) {
+	for i := 2; i+4 <= len(data) && (exif == nil || iptc == nil); {
+		if data[i] != 0xFF {
+			return
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// Fill byte before the marker
+			i++
+			continue
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			i += 2
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// Start of scan or end of image: no metadata past this point
+			return
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return
+		}
+		segment := data[i+4 : i+2+length]
+		switch {
+		case marker == 0xE1 && exif == nil && bytes.HasPrefix(segment, exifHeader):
+			exif = segment[len(exifHeader):]
+		case marker == 0xED && iptc == nil && bytes.HasPrefix(segment, photoshopHeader):
+			iptc = photoshopIPTC(segment[len(photoshopHeader):])
+		}
+		i += 2 + length
+	}
+	return
+}
+
+// photoshopIPTC returns the IPTC-NAA resource (ID 0x0404) of a Photoshop
+// image resource block.
+func photoshopIPTC(data []byte) []byte {
+	for len(data) >= 12 && string(data[:4]) == "8BIM" {
+		id := binary.BigEndian.Uint16(data[4:])
+		// Pascal-string name, padded to an even length including its length byte
+		nameLen := int(data[6]) + 1
+		nameLen += nameLen % 2
+		if 6+nameLen+4 > len(data) {
+			return nil
+		}
+		data = data[6+nameLen:]
+		size := int(binary.BigEndian.Uint32(data))
+		data = data[4:]
+		if size > len(data) {
+			return nil
+		}
+		if id == 0x0404 {
+			return data[:size]
+		}
+		data = data[min(size+size%2, len(data)):]
+	}
+	return nil
+}
+
+// pngChunk returns the data of the first chunk named name.
+func pngChunk(data []byte, name string) []byte {
+	for i := len(pngSignature); i+8 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		if length < 0 || i+12+length > len(data) {
+			return nil
+		}
+		if string(data[i+4:i+8]) == name {
+			return data[i+8 : i+8+length]
+		}
+		i += 12 + length
+	}
+	return nil
+}
+
+// webpChunk returns the data of the first RIFF chunk named name.
+func webpChunk(data []byte, name string) []byte {
+	for i := 12; i+8 <= len(data); {
+		length := int(binary.LittleEndian.Uint32(data[i+4:]))
+		if length < 0 || i+8+length > len(data) {
+			return nil
+		}
+		if string(data[i:i+4]) == name {
+			return data[i+8 : i+8+length]
+		}
+		i += 8 + length + length%2
+	}
+	return nil
+}
+
+// IPTC-IIM application record (record 2) datasets.
+const (
+	iptcObjectName = 5
+	iptcKeywords   = 25
+	iptcByline     = 80
+	iptcCity       = 90
+	iptcCountry    = 101
+	iptcHeadline   = 105
+	iptcCopyright  = 116
+	iptcCaption    = 120
+)
+
+// readIPTC parses the application record datasets of an IPTC-IIM block.
+func readIPTC(data []byte) IPTC {
+	var out IPTC
+	for len(data) >= 5 && data[0] == 0x1C {
+		record, dataset := data[1], data[2]
+		size := int(binary.BigEndian.Uint16(data[3:]))
+		// Extended datasets (high bit set) are never used for text fields
+		if size&0x8000 != 0 || 5+size > len(data) {
+			break
+		}
+		value := cleanText(data[5 : 5+size])
+		data = data[5+size:]
+		if record != 2 || value == "" {
+			continue
+		}
+
+		switch dataset {
+		case iptcObjectName:
+			out.Title = value
+		case iptcKeywords:
+			out.Keywords = append(out.Keywords, value)
+		case iptcByline:
+			out.Creator = value
+		case iptcCity:
+			out.City = value
+		case iptcCountry:
+			out.Country = value
+		case iptcHeadline:
+			out.Headline = value
+		case iptcCopyright:
+			out.Copyright = value
+		case iptcCaption:
+			out.Caption = value
+		}
+	}
+	return out
+}
+
+// cleanText trims padding from a metadata string and drops invalid UTF-8,
+// which older cameras and editors write in legacy encodings.
+func cleanText(b []byte) string {
+	s := strings.ToValidUTF8(string(b), "")
+	return strings.TrimSpace(strings.TrimRight(s, "\x00"))
+}
@@ -0,0 +1,182 @@
+package imaging
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sync"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Watermark positions. They match the values stored in tenant settings.
+const (
+	TopLeft     = "top_left"
+	TopRight    = "top_right"
+	BottomLeft  = "bottom_left"
+	BottomRight = "bottom_right"
+	Center      = "center"
+)
+
+// ErrEmptyWatermark is returned for watermark text that draws nothing.
+var ErrEmptyWatermark = errors.New("watermark text is empty")
+
+// WatermarkOptions controls where and how strongly a watermark is drawn.
+type WatermarkOptions struct {
+	Position string
+	// Opacity is the watermark alpha from 0 to 1.
+	Opacity float64
+	// Scale is the watermark width as a fraction of the photo's width.
+	Scale float64
+	// Tiled repeats the watermark across the whole photo, ignoring Position.
+	Tiled bool
+}
+
+// boldFont is parsed once from the embedded Go Bold TTF.
+var boldFont = sync.OnceValues(func() (*opentype.Font, error) {
+	return opentype.Parse(gobold.TTF)
+})
+
+// WatermarkText draws text over img in white with a dark outline, so it
+// reads on both light and dark photos.
+func WatermarkText(img image.Image, text string, opts WatermarkOptions) (image.Image, error) {
+	width, maxHeight := stampBounds(img, opts)
+	stamp, err := textStamp(text, width, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+	return composite(img, stamp, opts), nil
+}
+
+// WatermarkImage composites logo over img, keeping the logo's own transparency.
+func WatermarkImage(img, logo image.Image, opts WatermarkOptions) image.Image {
+	width, maxHeight := stampBounds(img, opts)
+	lb := logo.Bounds()
+	if height := lb.Dy() * width / max(lb.Dx(), 1); height > maxHeight {
+		width = max(1, width*maxHeight/height)
+	}
+	return composite(img, FitWidth(logo, width), opts)
+}
+
+// stampBounds returns the watermark width for img and the tallest it may be.
+func stampBounds(img image.Image, opts WatermarkOptions) (width, maxHeight int) {
+	b := img.Bounds()
+	width = max(1, int(math.Round(float64(b.Dx())*opts.Scale)))
+	maxHeight = max(1, b.Dy()/2)
+	return width, maxHeight
+}
+
+func textStamp(text string, width, maxHeight int) (image.Image, error) {
+	f, err := boldFont()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load watermark font: %w", err)
+	}
+
+	size, err := faceSize(f, text, width, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingNone})
+	if err != nil {
+		return nil, fmt.Errorf("failed to size watermark font: %w", err)
+	}
+	defer face.Close()
+
+	m := face.Metrics()
+	outline := max(1, int(size/24))
+	w := font.MeasureString(face, text).Ceil() + 2*outline
+	h := (m.Ascent + m.Descent).Ceil() + 2*outline
+	stamp := image.NewNRGBA(image.Rect(0, 0, w, h))
+
+	d := &font.Drawer{Dst: stamp, Face: face, Src: image.NewUniform(color.NRGBA{A: 160})}
+	baseline := outline + m.Ascent.Ceil()
+	for _, off := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		d.Dot = fixed.P(outline+off[0]*outline, baseline+off[1]*outline)
+		d.DrawString(text)
+	}
+	d.Src = image.White
+	d.Dot = fixed.P(outline, baseline)
+	d.DrawString(text)
+	return stamp, nil
+}
+
+// faceSize returns the font size at which text is width pixels wide, or
+// maxHeight pixels tall if that is smaller. Both scale linearly with size, so
+// they are measured once at a reference size.
+func faceSize(f *opentype.Font, text string, width, maxHeight int) (float64, error) {
+	const refSize = 100
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: refSize, DPI: 72, Hinting: font.HintingNone})
+	if err != nil {
+		return 0, fmt.Errorf("failed to load watermark font: %w", err)
+	}
+	defer face.Close()
+
+	advance := font.MeasureString(face, text)
+	if advance <= 0 {
+		return 0, ErrEmptyWatermark
+	}
+	m := face.Metrics()
+	height := m.Ascent + m.Descent
+	return refSize * min(float64(width)/fixedPx(advance), float64(maxHeight)/fixedPx(height)), nil
+}
+
+func fixedPx(v fixed.Int26_6) float64 {
+	return float64(v) / 64
+}
+
+// composite draws stamp over a copy of img at opts.Opacity.
+func composite(img, stamp image.Image, opts WatermarkOptions) image.Image {
+	b := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+
+	opacity := min(max(opts.Opacity, 0), 1)
+	mask := image.NewUniform(color.Alpha{A: uint8(math.Round(opacity * 255))})
+	sb := stamp.Bounds()
+	place := func(at image.Point) {
+		draw.DrawMask(dst, image.Rectangle{Min: at, Max: at.Add(sb.Size())}, stamp, sb.Min, mask, image.Point{}, draw.Over)
+	}
+
+	if opts.Tiled {
+		// Staggered rows so the pattern cannot be cropped out in one strip
+		stepX := sb.Dx() + sb.Dx()/2
+		stepY := sb.Dy() * 3
+		for row, y := 0, sb.Dy(); y < dst.Bounds().Dy(); row, y = row+1, y+stepY {
+			for x := -(row % 2) * stepX / 2; x < dst.Bounds().Dx(); x += stepX {
+				place(image.Pt(x, y))
+			}
+		}
+		return dst
+	}
+
+	place(anchor(dst.Bounds(), sb.Size(), opts.Position))
+	return dst
+}
+
+// anchor returns the top-left corner of a stamp of size placed at position
+// inside bounds, inset by a small margin.
+func anchor(bounds image.Rectangle, size image.Point, position string) image.Point {
+	margin := max(1, min(bounds.Dx(), bounds.Dy())/40)
+	left, top := margin, margin
+	right := bounds.Dx() - size.X - margin
+	bottom := bounds.Dy() - size.Y - margin
+
+	switch position {
+	case TopLeft:
+		return image.Pt(left, top)
+	case TopRight:
+		return image.Pt(right, top)
+	case BottomLeft:
+		return image.Pt(left, bottom)
+	case Center:
+		return image.Pt((bounds.Dx()-size.X)/2, (bounds.Dy()-size.Y)/2)
+	default:
+		return image.Pt(right, bottom)
+	}
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalBackendVerifyPut(t *testing.T) {
+	b, err := NewLocalBackend(t.TempDir(), "http://localhost/storage", []byte("test-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	b.now = func() time.Time { return now }
+
+	const key = "tenants/t/staging/object"
+	signed, err := b.PresignPut(context.Background(), key, "image/jpeg", 1024, time.Minute)
+	if err != nil {
+		t.Fatalf("PresignPut: %v", err)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(u.Path, "/"+key) {
+		t.Fatalf("presigned path %q does not end in the key", u.Path)
+	}
+
+	tests := []struct {
+		name        string
+		key         string
+		contentType string
+		size        int64
+		advance     time.Duration
+		wantErr     bool
+	}{
+		{name: "signed upload", key: key, contentType: "image/jpeg", size: 1024},
+		{name: "other content type", key: key, contentType: "image/png", size: 1024, wantErr: true},
+		{name: "other size", key: key, contentType: "image/jpeg", size: 2048, wantErr: true},
+		{name: "unknown size", key: key, contentType: "image/jpeg", size: -1, wantErr: true},
+		{name: "other key", key: "tenants/t/originals/object.jpg", contentType: "image/jpeg", size: 1024, wantErr: true},
+		{name: "expired", key: key, contentType: "image/jpeg", size: 1024, advance: 2 * time.Minute, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := now.Add(tt.advance)
+			b.now = func() time.Time { return at }
+
+			err := b.VerifyPut(tt.key, tt.contentType, tt.size, u.Query())
+			if tt.wantErr && !errors.Is(err, ErrInvalidSignature) {
+				t.Fatalf("VerifyPut err = %v, want ErrInvalidSignature", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("VerifyPut: %v", err)
+			}
+		})
+	}
+}
+
+func TestLocalBackendPutURLDoesNotDownload(t *testing.T) {
+	b, err := NewLocalBackend(t.TempDir(), "http://localhost/storage", []byte("test-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const key = "tenants/t/staging/object"
+	signed, err := b.PresignPut(context.Background(), key, "image/jpeg", 1024, time.Minute)
+	if err != nil {
+		t.Fatalf("PresignPut: %v", err)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Verify(http.MethodGet, key, u.Query()); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("Verify GET with a PUT signature: err = %v, want ErrInvalidSignature", err)
+	}
+}
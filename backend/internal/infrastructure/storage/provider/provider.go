@@ -0,0 +1,35 @@
+// Package provider builds the storage backend selected by configuration.
+package provider
+
+import (
+	"fmt"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/config"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage/s3_storage"
+)
+
+// Backend kinds accepted in STORAGE_BACKEND.
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// New returns the backend named by cfg.StorageBackend.
+func New(cfg *config.Config) (storage.Backend, error) {
+	switch cfg.StorageBackend {
+	case BackendLocal:
+		return storage.NewLocalBackend(cfg.StorageLocalDir, cfg.StoragePublicURL, []byte(cfg.StorageSigningKey))
+	case BackendS3:
+		return s3_storage.New(s3_storage.Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND %q", cfg.StorageBackend)
+	}
+}
@@ -1 +1,391 @@
-package s3_storage
\ No newline at end of file
+// Package s3_storage implements storage.Backend against Amazon S3 and
+// S3-compatible services such as MinIO, signing requests with AWS SigV4.
+package s3_storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+)
+
+const (
+	sigAlgorithm    = "AWS4-HMAC-SHA256"
+	sigService      = "s3"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	amzDateFormat   = "20060102T150405Z"
+	maxPresignTTL   = 7 * 24 * time.Hour
+	errorBodyLimit  = 1 << 10
+)
+
+// Config locates a bucket and the credentials used to access it.
+type Config struct {
+	// Endpoint is the service URL, e.g. https://s3.us-east-1.amazonaws.com
+	// or http://localhost:9000 for MinIO.
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PathStyle addresses objects as endpoint/bucket/key instead of
+	// bucket.endpoint/key. MinIO deployments usually need it.
+	PathStyle bool
+}
+
+// Backend stores objects in an S3 bucket.
+type Backend struct {
+	cfg      Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+var _ storage.Backend = (*Backend)(nil)
+
+// New validates cfg and returns a Backend.
+func New(cfg Config) (*Backend, error) {
+	if cfg.Bucket == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("s3 storage: bucket, access key and secret key are required")
+	}
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("s3 storage: invalid endpoint %q", cfg.Endpoint)
+	}
+	return &Backend{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Minute},
+		now:      time.Now,
+	}, nil
+}
+
+// Put uploads r to key. S3 needs the length up front, so the body is spooled
+// to a temporary file first.
+func (b *Backend) Put(ctx context.Context, key string, r io.Reader, contentType string) (int64, error) {
+	tmp, err := os.CreateTemp("", "s3-upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return 0, fmt.Errorf("failed to buffer object: %w", err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to rewind object: %w", err)
+	}
+
+	req, err := b.newRequest(ctx, http.MethodPut, key, io.NopCloser(tmp))
+	if err != nil {
+		return 0, err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := b.do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return size, nil
+}
+
+// Get downloads the object at key.
+func (b *Backend) Get(ctx context.Context, key string) (io.ReadCloser, *storage.Object, error) {
+	req, err := b.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := b.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.Body, describe(key, resp), nil
+}
+
+// Delete removes the object at key.
+func (b *Backend) Delete(ctx context.Context, key string) error {
+	req, err := b.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := b.do(req)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Stat issues a HEAD request for the object at key.
+func (b *Backend) Stat(ctx context.Context, key string) (*storage.Object, error) {
+	req, err := b.newRequest(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return describe(key, resp), nil
+}
+
+// PresignGet returns a query-signed download URL.
+func (b *Backend) PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return b.presign(http.MethodGet, key, nil, ttl)
+}
+
+// PresignPut returns a query-signed upload URL. Content-Type and
+// Content-Length are signed headers, so S3 rejects an upload of any other
+// type or size.
+func (b *Backend) PresignPut(ctx context.Context, key, contentType string, size int64, ttl time.Duration) (string, error) {
+	return b.presign(http.MethodPut, key, map[string]string{
+		"content-length": strconv.FormatInt(size, 10),
+		"content-type":   contentType,
+	}, ttl)
+}
+
+func (b *Backend) newRequest(ctx context.Context, method, key string, body io.ReadCloser) (*http.Request, error) {
+	u, err := b.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build s3 request: %w", err)
+	}
+	if body != nil {
+		req.Body = body
+	}
+	return req, nil
+}
+
+// do signs and sends req. Non-2xx responses become errors; 404 becomes
+// storage.ErrNotFound.
+func (b *Backend) do(req *http.Request) (*http.Response, error) {
+	b.sign(req)
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 %s failed: %w", req.Method, err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, storage.ErrNotFound
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, errorBodyLimit))
+	return nil, fmt.Errorf("s3 %s returned %s: %s", req.Method, resp.Status, strings.TrimSpace(string(msg)))
+}
+
+// sign adds SigV4 authorization headers to req. The payload is left
+// unsigned, which S3 allows over both HTTP and HTTPS.
+func (b *Backend) sign(req *http.Request) {
+	now := b.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": unsignedPayload,
+		"x-amz-date":           amzDate,
+	}
+	if ct := req.Header.Get("Content-Type"); ct != "" {
+		headers["content-type"] = ct
+	}
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(headers)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	scope := b.scope(now)
+	signature := b.signature(now, stringToSign(amzDate, scope, canonicalRequest))
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigAlgorithm, b.cfg.AccessKey, scope, signedHeaders, signature))
+}
+
+// presign signs a URL for method on key. The request must carry headers,
+// keyed by lower-case name, with exactly these values.
+func (b *Backend) presign(method, key string, headers map[string]string, ttl time.Duration) (string, error) {
+	if ttl <= 0 || ttl > maxPresignTTL {
+		return "", fmt.Errorf("s3 storage: presign ttl must be between 1s and %s", maxPresignTTL)
+	}
+	u, err := b.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	now := b.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	scope := b.scope(now)
+
+	signed := map[string]string{"host": u.Host}
+	for name, value := range headers {
+		signed[name] = value
+	}
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(signed)
+
+	query := url.Values{
+		"X-Amz-Algorithm":     {sigAlgorithm},
+		"X-Amz-Credential":    {b.cfg.AccessKey + "/" + scope},
+		"X-Amz-Date":          {amzDate},
+		"X-Amz-Expires":       {strconv.Itoa(int(ttl.Seconds()))},
+		"X-Amz-SignedHeaders": {signedHeaders},
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		canonicalHeaders,
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	query.Set("X-Amz-Signature", b.signature(now, stringToSign(amzDate, scope, canonicalRequest)))
+	u.RawQuery = canonicalQuery(query)
+	return u.String(), nil
+}
+
+func (b *Backend) objectURL(key string) (*url.URL, error) {
+	key, err := storage.CleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	u := *b.endpoint
+	if b.cfg.PathStyle {
+		u.Path = "/" + b.cfg.Bucket + "/" + key
+	} else {
+		u.Host = b.cfg.Bucket + "." + u.Host
+		u.Path = "/" + key
+	}
+	u.RawPath = escapePath(u.Path)
+	return &u, nil
+}
+
+func (b *Backend) scope(t time.Time) string {
+	return t.Format("20060102") + "/" + b.cfg.Region + "/" + sigService + "/aws4_request"
+}
+
+func (b *Backend) signature(t time.Time, toSign string) string {
+	key := hmacSHA256([]byte("AWS4"+b.cfg.SecretKey), t.Format("20060102"))
+	key = hmacSHA256(key, b.cfg.Region)
+	key = hmacSHA256(key, sigService)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, toSign))
+}
+
+func stringToSign(amzDate, scope, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	return strings.Join([]string{sigAlgorithm, amzDate, scope, hex.EncodeToString(hash[:])}, "\n")
+}
+
+func canonicalizeHeaders(headers map[string]string) (canonical, signed string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		sb.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	return sb.String(), strings.Join(names, ";")
+}
+
+// canonicalQuery sorts and encodes query parameters as SigV4 requires.
+func canonicalQuery(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vals := append([]string(nil), values[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escapePath encodes every path segment but keeps the slashes.
+func escapePath(p string) string {
+	return uriEncode(p, false)
+}
+
+// uriEncode percent-encodes everything except unreserved characters, and
+// also '/' unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			sb.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func describe(key string, resp *http.Response) *storage.Object {
+	obj := &storage.Object{
+		Key:         key,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		obj.ModifiedAt = modified
+	}
+	return obj
+}
@@ -0,0 +1,57 @@
+// Package storage holds the blob backends that keep uploaded photo bytes.
+// Objects are addressed by backend-neutral keys; URLs are only produced on
+// demand, as short-lived presigned links.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// Storage errors.
+var (
+	ErrInvalidKey = errors.New("storage: invalid object key")
+	ErrNotFound   = errors.New("storage: object not found")
+)
+
+// Object describes a stored object.
+type Object struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModifiedAt  time.Time
+}
+
+// Backend stores objects by key.
+type Backend interface {
+	// Put streams r into the object at key and returns the number of bytes written.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) (int64, error)
+	// Get opens the object at key. The caller must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, *Object, error)
+	// Delete removes the object at key. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	// Stat describes the object at key, or returns ErrNotFound.
+	Stat(ctx context.Context, key string) (*Object, error)
+	// PresignGet returns a URL that downloads the object until ttl elapses.
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error)
+	// PresignPut returns a URL that accepts an upload of the object until ttl
+	// elapses. The upload must send exactly contentType as its Content-Type
+	// and size as its Content-Length.
+	PresignPut(ctx context.Context, key, contentType string, size int64, ttl time.Duration) (string, error)
+}
+
+// CleanKey normalises key and rejects absolute paths and parent references.
+func CleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	cleaned := path.Clean(key)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"time"
+
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/google/uuid"
+)
+
+// ShareLinkAnalyticsResponse is how a share link was used from From up to
+// To: its totals, its views per interval and its most viewed photos.
+type ShareLinkAnalyticsResponse struct {
+	From      time.Time             `json:"from"`
+	To        time.Time             `json:"to"`
+	Interval  string                `json:"interval"`
+	Summary   AccessSummaryResponse `json:"summary"`
+	Views     []ViewBucketResponse  `json:"views"`
+	TopPhotos []PhotoStatResponse   `json:"top_photos"`
+}
+
+// AccessSummaryResponse counts a share link's accesses by action, and the
+// distinct visitors behind them.
+type AccessSummaryResponse struct {
+	Views          int64 `json:"views"`
+	PhotoViews     int64 `json:"photo_views"`
+	Downloads      int64 `json:"downloads"`
+	UniqueVisitors int64 `json:"unique_visitors"`
+}
+
+// ViewBucketResponse counts the views of a share link in the interval
+// starting at Start. Intervals without views are left out.
+type ViewBucketResponse struct {
+	Start          time.Time `json:"start"`
+	Views          int64     `json:"views"`
+	UniqueVisitors int64     `json:"unique_visitors"`
+}
+
+// PhotoStatResponse counts how often a photo was viewed and downloaded
+// through a share link.
+type PhotoStatResponse struct {
+	PhotoID   uuid.UUID `json:"photo_id"`
+	Filename  string    `json:"filename"`
+	Views     int64     `json:"views"`
+	Downloads int64     `json:"downloads"`
+}
+
+// NewShareLinkAnalyticsResponse converts a share link's analytics.
+func NewShareLinkAnalyticsResponse(a *sharing.Analytics) ShareLinkAnalyticsResponse {
+	views := make([]ViewBucketResponse, 0, len(a.Views))
+	for _, b := range a.Views {
+		views = append(views, ViewBucketResponse{Start: b.Start, Views: b.Views, UniqueVisitors: b.UniqueVisitors})
+	}
+	photos := make([]PhotoStatResponse, 0, len(a.TopPhotos))
+	for _, p := range a.TopPhotos {
+		photos = append(photos, PhotoStatResponse{
+			PhotoID:   p.PhotoID,
+			Filename:  p.FileName,
+			Views:     p.Views,
+			Downloads: p.Downloads,
+		})
+	}
+	return ShareLinkAnalyticsResponse{
+		From:     a.Query.From,
+		To:       a.Query.To,
+		Interval: a.Query.Interval,
+		Summary: AccessSummaryResponse{
+			Views:          a.Summary.Views,
+			PhotoViews:     a.Summary.PhotoViews,
+			Downloads:      a.Summary.Downloads,
+			UniqueVisitors: a.Summary.UniqueVisitors,
+		},
+		Views:     views,
+		TopPhotos: photos,
+	}
+}
+
+// ShareLinkAccessResponse is one logged use of a share link. The visitor is
+// only known by an anonymized IP prefix, its user agent and VisitorHash,
+// which is the same for their accesses to the link.
+type ShareLinkAccessResponse struct {
+	ID          uuid.UUID   `json:"id"`
+	Action      string      `json:"action"`
+	VisitorHash string      `json:"visitor_hash"`
+	IPPrefix    string      `json:"ip_prefix"`
+	UserAgent   string      `json:"user_agent"`
+	PhotoIDs    []uuid.UUID `json:"photo_ids"`
+	AccessedAt  time.Time   `json:"accessed_at"`
+}
+
+// NewShareLinkAccessResponses converts a page of a share link's access log.
+func NewShareLinkAccessResponses(accesses []sharing.Access) []ShareLinkAccessResponse {
+	out := make([]ShareLinkAccessResponse, 0, len(accesses))
+	for _, a := range accesses {
+		photoIDs := a.PhotoIDs
+		if photoIDs == nil {
+			photoIDs = []uuid.UUID{}
+		}
+		out = append(out, ShareLinkAccessResponse{
+			ID:          a.ID,
+			Action:      a.Action,
+			VisitorHash: a.VisitorHash,
+			IPPrefix:    a.IPPrefix,
+			UserAgent:   a.UserAgent,
+			PhotoIDs:    photoIDs,
+			AccessedAt:  a.AccessedAt,
+		})
+	}
+	return out
+}
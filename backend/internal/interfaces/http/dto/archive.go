@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// ArchiveResponse is a ZIP archive built in the background. download_url and
+// size_bytes are set once it is ready; the URL only works for a short time,
+// and the archive itself until expires_at.
+type ArchiveResponse struct {
+	ID          uuid.UUID `json:"id"`
+	ListingID   uuid.UUID `json:"listing_id"`
+	Variant     string    `json:"variant"`
+	Status      string    `json:"status"`
+	PhotoCount  int32     `json:"photo_count"`
+	SizeBytes   *int64    `json:"size_bytes"`
+	DownloadURL *string   `json:"download_url"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewArchiveResponse converts an archive and its presigned download URL.
+func NewArchiveResponse(a *tenant.Archive, downloadURL *string) ArchiveResponse {
+	return ArchiveResponse{
+		ID:          a.ID,
+		ListingID:   a.ListingID,
+		Variant:     a.Variant,
+		Status:      a.Status,
+		PhotoCount:  a.PhotoCount,
+		SizeBytes:   a.SizeBytes,
+		DownloadURL: downloadURL,
+		ExpiresAt:   a.ExpiresAt,
+		CreatedAt:   a.CreatedAt,
+	}
+}
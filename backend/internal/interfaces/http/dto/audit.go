@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	"github.com/google/uuid"
+)
+
+// AuditLogResponse is a recorded audit event.
+type AuditLogResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	PerformedBy *uuid.UUID      `json:"performed_by"`
+	EntityID    uuid.UUID       `json:"entity_id"`
+	EntityType  string          `json:"entity_type"`
+	Action      string          `json:"action"`
+	Data        json.RawMessage `json:"data,omitempty"`
+	PerformedAt time.Time       `json:"performed_at"`
+}
+
+// NewAuditLogResponses converts a list of audit entries.
+func NewAuditLogResponses(entries []audit.Entry) []AuditLogResponse {
+	out := make([]AuditLogResponse, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, AuditLogResponse{
+			ID:          e.ID,
+			PerformedBy: e.PerformedBy,
+			EntityID:    e.EntityID,
+			EntityType:  e.EntityType,
+			Action:      e.Action,
+			Data:        e.Data,
+			PerformedAt: e.PerformedAt,
+		})
+	}
+	return out
+}
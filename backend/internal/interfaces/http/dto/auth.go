@@ -1 +1,92 @@
-package dto
\ No newline at end of file
+package dto
+
+import (
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	authapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/application"
+	"github.com/google/uuid"
+)
+
+// SignupRequest creates a tenant and its first admin user.
+type SignupRequest struct {
+	TenantName string `json:"tenant_name" binding:"required"`
+	Username   string `json:"username" binding:"required"`
+	Email      string `json:"email" binding:"required"`
+	Password   string `json:"password" binding:"required"`
+}
+
+// SignupResponse identifies the tenant and user created at signup.
+type SignupResponse struct {
+	TenantID       uuid.UUID `json:"tenant_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	SubscriptionID uuid.UUID `json:"subscription_id"`
+	Role           string    `json:"role"`
+}
+
+// NewSignupResponse converts a signup result.
+func NewSignupResponse(r *authapp.SignupResult) SignupResponse {
+	return SignupResponse{
+		TenantID:       r.TenantID,
+		UserID:         r.UserID,
+		SubscriptionID: r.SubscriptionID,
+		Role:           r.Role,
+	}
+}
+
+// LoginRequest authenticates a user by email or username within a tenant.
+type LoginRequest struct {
+	TenantID   uuid.UUID `json:"tenant_id"`
+	Identifier string    `json:"identifier" binding:"required"`
+	Password   string    `json:"password" binding:"required"`
+}
+
+// LoginResponse is returned after a successful login.
+type LoginResponse struct {
+	User   UserResponse  `json:"user"`
+	Tokens TokenResponse `json:"tokens"`
+}
+
+// NewLoginResponse converts a login result.
+func NewLoginResponse(r *authapp.LoginResult) LoginResponse {
+	return LoginResponse{
+		User: UserResponse{
+			ID:       r.UserID,
+			TenantID: r.TenantID,
+			Username: r.Username,
+			Email:    r.Email,
+			Role:     r.Role,
+		},
+		Tokens: NewTokenResponse(r.Tokens),
+	}
+}
+
+// RefreshRequest exchanges a refresh token for a new token pair.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LogoutRequest ends the session the refresh token belongs to.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// TokenResponse is an access and refresh token pair.
+type TokenResponse struct {
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+	TokenType             string    `json:"token_type"`
+}
+
+// NewTokenResponse converts a token pair.
+func NewTokenResponse(t auth.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:           t.AccessToken,
+		AccessTokenExpiresAt:  t.AccessTokenExpiresAt,
+		RefreshToken:          t.RefreshToken,
+		RefreshTokenExpiresAt: t.RefreshTokenExpiresAt,
+		TokenType:             t.TokenType,
+	}
+}
@@ -1 +1,86 @@
-package dto
\ No newline at end of file
+package dto
+
+import (
+	"time"
+
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CreateListingRequest creates a draft listing.
+type CreateListingRequest struct {
+	Title       string  `json:"title" binding:"required"`
+	Description *string `json:"description"`
+	Visibility  string  `json:"visibility"`
+}
+
+// UpdateListingRequest patches a listing; omitted fields are left unchanged
+// and an empty description clears it.
+type UpdateListingRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Visibility  *string `json:"visibility"`
+}
+
+// Changes converts the request to a domain update.
+func (r UpdateListingRequest) Changes() tenant.ListingChanges {
+	return tenant.ListingChanges{
+		Title:       r.Title,
+		Description: r.Description,
+		Visibility:  r.Visibility,
+	}
+}
+
+// TransitionListingRequest moves a listing to another workflow status.
+type TransitionListingRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// ScheduleListingRequest sets when a listing is published and unpublished
+// automatically. Both times are replaced; null or omitted clears one.
+type ScheduleListingRequest struct {
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+}
+
+// ListingResponse is a listing. PublishedAt is when it last went live;
+// PublishAt and UnpublishAt are pending scheduled transitions.
+type ListingResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	UserID      uuid.UUID  `json:"user_id"`
+	Title       string     `json:"title"`
+	Description *string    `json:"description"`
+	Status      string     `json:"status"`
+	Visibility  string     `json:"visibility"`
+	PublishedAt *time.Time `json:"published_at"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// NewListingResponse converts a listing.
+func NewListingResponse(l *tenant.Listing) ListingResponse {
+	return ListingResponse{
+		ID:          l.ID,
+		UserID:      l.UserID,
+		Title:       l.Title,
+		Description: l.Description,
+		Status:      l.Status,
+		Visibility:  l.Visibility,
+		PublishedAt: l.PublishedAt,
+		PublishAt:   l.PublishAt,
+		UnpublishAt: l.UnpublishAt,
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+// NewListingResponses converts a list of listings.
+func NewListingResponses(listings []tenant.Listing) []ListingResponse {
+	out := make([]ListingResponse, 0, len(listings))
+	for i := range listings {
+		out = append(out, NewListingResponse(&listings[i]))
+	}
+	return out
+}
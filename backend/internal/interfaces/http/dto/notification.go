@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	notification "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	"github.com/google/uuid"
+)
+
+// NotificationResponse is an in-app notification.
+type NotificationResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	Message   string          `json:"message"`
+	Type      string          `json:"type"`
+	Data      json.RawMessage `json:"data,omitempty"`
+	IsRead    bool            `json:"is_read"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// NewNotificationResponses converts a list of notifications.
+func NewNotificationResponses(notifications []notification.Notification) []NotificationResponse {
+	out := make([]NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		out = append(out, NotificationResponse{
+			ID:        n.ID,
+			Message:   n.Message,
+			Type:      n.Type,
+			Data:      n.Data,
+			IsRead:    n.IsRead,
+			CreatedAt: n.CreatedAt,
+		})
+	}
+	return out
+}
+
+// UnreadCountResponse is the number of unread notifications.
+type UnreadCountResponse struct {
+	Unread int64 `json:"unread"`
+}
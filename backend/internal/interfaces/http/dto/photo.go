@@ -1 +1,135 @@
-package dto
\ No newline at end of file
+package dto
+
+import (
+	"time"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PhotoSelectionRequest names photos of a listing for a bulk operation. For
+// a reorder it lists every photo in the new display order.
+type PhotoSelectionRequest struct {
+	PhotoIDs []uuid.UUID `json:"photo_ids" binding:"required"`
+}
+
+// TransferPhotosRequest moves or copies photos of a listing to another
+// listing of the tenant.
+type TransferPhotosRequest struct {
+	PhotoIDs        []uuid.UUID `json:"photo_ids" binding:"required"`
+	TargetListingID uuid.UUID   `json:"target_listing_id" binding:"required"`
+}
+
+// PhotoResponse is a photo in a listing. The URLs are presigned and expire
+// after a few minutes. Variants and Srcset list resized renditions, narrowest
+// first, and stay empty until the photo has been processed; Metadata is null
+// until it has been extracted.
+type PhotoResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	ListingID      uuid.UUID              `json:"listing_id"`
+	FileID         uuid.UUID              `json:"file_id"`
+	Filename       string                 `json:"filename"`
+	Position       int32                  `json:"position"`
+	IsCover        bool                   `json:"is_cover"`
+	IsPublished    bool                   `json:"is_published"`
+	OriginalURL    string                 `json:"original_url"`
+	WatermarkedURL *string                `json:"watermarked_url"`
+	ThumbnailURL   *string                `json:"thumbnail_url"`
+	Variants       []PhotoVariantResponse `json:"variants"`
+	Srcset         string                 `json:"srcset"`
+	Metadata       *PhotoMetadataResponse `json:"metadata"`
+	SizeBytes      int64                  `json:"size_bytes"`
+	MimeType       string                 `json:"mime_type"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+// PhotoVariantResponse is one resized rendition of a photo.
+type PhotoVariantResponse struct {
+	Width  int32  `json:"width"`
+	Height int32  `json:"height"`
+	URL    string `json:"url"`
+}
+
+// PhotoMetadataResponse is the capture information read from a photo's EXIF
+// and IPTC blocks. Fields the camera did not record are omitted.
+type PhotoMetadataResponse struct {
+	Width               int32                `json:"width"`
+	Height              int32                `json:"height"`
+	Orientation         int32                `json:"orientation"`
+	CapturedAt          *time.Time           `json:"captured_at,omitempty"`
+	CameraMake          string               `json:"camera_make,omitempty"`
+	CameraModel         string               `json:"camera_model,omitempty"`
+	LensModel           string               `json:"lens_model,omitempty"`
+	FocalLengthMM       float64              `json:"focal_length_mm,omitempty"`
+	FNumber             float64              `json:"f_number,omitempty"`
+	ExposureTimeSeconds float64              `json:"exposure_time_seconds,omitempty"`
+	ISO                 int32                `json:"iso,omitempty"`
+	Artist              string               `json:"artist,omitempty"`
+	Copyright           string               `json:"copyright,omitempty"`
+	Description         string               `json:"description,omitempty"`
+	GPS                 *GPSLocationResponse `json:"gps,omitempty"`
+	IPTC                tenant.IPTC          `json:"iptc"`
+}
+
+// GPSLocationResponse is a capture location in decimal degrees.
+type GPSLocationResponse struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// NewPhotoMetadataResponse converts extracted file metadata; nil stays nil.
+func NewPhotoMetadataResponse(m *tenant.FileMetadata) *PhotoMetadataResponse {
+	if m == nil {
+		return nil
+	}
+	resp := &PhotoMetadataResponse{
+		Width:               m.Width,
+		Height:              m.Height,
+		Orientation:         m.Orientation,
+		CapturedAt:          m.CapturedAt,
+		CameraMake:          m.CameraMake,
+		CameraModel:         m.CameraModel,
+		LensModel:           m.LensModel,
+		FocalLengthMM:       m.FocalLength,
+		FNumber:             m.FNumber,
+		ExposureTimeSeconds: m.ExposureTime,
+		ISO:                 m.ISO,
+		Artist:              m.Artist,
+		Copyright:           m.Copyright,
+		Description:         m.Description,
+		IPTC:                m.IPTC,
+	}
+	if m.GPS != nil {
+		resp.GPS = &GPSLocationResponse{Latitude: m.GPS.Latitude, Longitude: m.GPS.Longitude}
+	}
+	return resp
+}
+
+// NewPhotoResponse converts a photo and its download links.
+func NewPhotoResponse(p *tenant.Photo, urls tenantapp.PhotoURLs) PhotoResponse {
+	variants := make([]PhotoVariantResponse, 0, len(urls.Variants))
+	for _, v := range urls.Variants {
+		variants = append(variants, PhotoVariantResponse{Width: v.Width, Height: v.Height, URL: v.URL})
+	}
+	return PhotoResponse{
+		ID:             p.ID,
+		ListingID:      p.ListingID,
+		FileID:         p.FileID,
+		Filename:       p.Name(),
+		Position:       p.Position,
+		IsCover:        p.IsCover,
+		IsPublished:    p.IsPublished,
+		OriginalURL:    urls.Original,
+		WatermarkedURL: urls.Watermarked,
+		ThumbnailURL:   urls.Thumbnail,
+		Variants:       variants,
+		Srcset:         urls.Srcset,
+		Metadata:       NewPhotoMetadataResponse(p.Metadata),
+		SizeBytes:      p.SizeBytes,
+		MimeType:       p.MimeType,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
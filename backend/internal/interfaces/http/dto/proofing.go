@@ -0,0 +1,186 @@
+package dto
+
+import (
+	"time"
+
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/google/uuid"
+)
+
+// SelectPhotoRequest sets a share link visitor's marks on a photo, replacing
+// earlier ones. A null rating or comment clears it.
+type SelectPhotoRequest struct {
+	Favorite bool    `json:"favorite"`
+	Rating   *int32  `json:"rating"`
+	Comment  *string `json:"comment"`
+}
+
+// SubmitSelectionRequest makes a share link's selection final.
+type SubmitSelectionRequest struct {
+	Note *string `json:"note"`
+}
+
+// PhotoSelectionResponse is the marks given to a photo through one share link.
+type PhotoSelectionResponse struct {
+	PhotoID   uuid.UUID `json:"photo_id"`
+	Filename  string    `json:"filename,omitempty"`
+	Position  int32     `json:"position"`
+	Favorite  bool      `json:"favorite"`
+	Rating    *int32    `json:"rating"`
+	Comment   *string   `json:"comment"`
+	UpdatedBy *string   `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewPhotoSelectionResponse converts a photo selection.
+func NewPhotoSelectionResponse(s *sharing.PhotoSelection) PhotoSelectionResponse {
+	return PhotoSelectionResponse{
+		PhotoID:   s.PhotoID,
+		Filename:  s.FileName,
+		Position:  s.Position,
+		Favorite:  s.Favorite,
+		Rating:    s.Rating,
+		Comment:   s.Comment,
+		UpdatedBy: s.UpdatedBy,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+// SelectionSubmissionResponse is a submitted selection.
+type SelectionSubmissionResponse struct {
+	ShareLinkID uuid.UUID `json:"share_link_id"`
+	SubmittedBy *string   `json:"submitted_by"`
+	Favorites   int32     `json:"favorites"`
+	Note        *string   `json:"note"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// NewSelectionSubmissionResponse converts a submission.
+func NewSelectionSubmissionResponse(s *sharing.SelectionSubmission) SelectionSubmissionResponse {
+	return SelectionSubmissionResponse{
+		ShareLinkID: s.ShareLinkID,
+		SubmittedBy: s.SubmittedBy,
+		Favorites:   s.Favorites,
+		Note:        s.Note,
+		SubmittedAt: s.SubmittedAt,
+	}
+}
+
+// SelectionResponse is a share link's selection as its visitors see it.
+// Submission is null until the selection is submitted.
+type SelectionResponse struct {
+	Photos     []PhotoSelectionResponse     `json:"photos"`
+	Submission *SelectionSubmissionResponse `json:"submission"`
+}
+
+// NewSelectionResponse converts a share link's selection.
+func NewSelectionResponse(s *sharingapp.Selection) SelectionResponse {
+	photos := make([]PhotoSelectionResponse, 0, len(s.Photos))
+	for i := range s.Photos {
+		photos = append(photos, NewPhotoSelectionResponse(&s.Photos[i]))
+	}
+	out := SelectionResponse{Photos: photos}
+	if s.Submission != nil {
+		submission := NewSelectionSubmissionResponse(s.Submission)
+		out.Submission = &submission
+	}
+	return out
+}
+
+// LinkSelectionResponse is the marks one share link gave a photo.
+type LinkSelectionResponse struct {
+	ShareLinkID uuid.UUID `json:"share_link_id"`
+	Favorite    bool      `json:"favorite"`
+	Rating      *int32    `json:"rating"`
+	Comment     *string   `json:"comment"`
+	UpdatedBy   *string   `json:"updated_by"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// PhotoSelectionSummaryResponse sums up the marks a photo got across share
+// links. average_rating is null when nobody rated the photo.
+type PhotoSelectionSummaryResponse struct {
+	PhotoID       uuid.UUID               `json:"photo_id"`
+	Filename      string                  `json:"filename"`
+	Position      int32                   `json:"position"`
+	Favorites     int                     `json:"favorites"`
+	Ratings       int                     `json:"ratings"`
+	AverageRating *float64                `json:"average_rating"`
+	Comments      int                     `json:"comments"`
+	Selections    []LinkSelectionResponse `json:"selections"`
+}
+
+// NewPhotoSelectionSummaryResponse converts a photo's selection summary.
+func NewPhotoSelectionSummaryResponse(s *sharing.PhotoSelectionSummary) PhotoSelectionSummaryResponse {
+	selections := make([]LinkSelectionResponse, 0, len(s.Selections))
+	for _, sel := range s.Selections {
+		selections = append(selections, LinkSelectionResponse{
+			ShareLinkID: sel.ShareLinkID,
+			Favorite:    sel.Favorite,
+			Rating:      sel.Rating,
+			Comment:     sel.Comment,
+			UpdatedBy:   sel.UpdatedBy,
+			UpdatedAt:   sel.UpdatedAt,
+		})
+	}
+	out := PhotoSelectionSummaryResponse{
+		PhotoID:    s.PhotoID,
+		Filename:   s.FileName,
+		Position:   s.Position,
+		Favorites:  s.Favorites,
+		Ratings:    s.Ratings,
+		Comments:   s.Comments,
+		Selections: selections,
+	}
+	if s.Ratings > 0 {
+		avg := s.AverageRating
+		out.AverageRating = &avg
+	}
+	return out
+}
+
+// ListingSelectionsResponse is the proofing of a listing across its share
+// links, by photo in display order.
+type ListingSelectionsResponse struct {
+	Photos      []PhotoSelectionSummaryResponse `json:"photos"`
+	Submissions []SelectionSubmissionResponse   `json:"submissions"`
+}
+
+// NewListingSelectionsResponse converts a listing's proofing.
+func NewListingSelectionsResponse(s *sharingapp.ListingSelections) ListingSelectionsResponse {
+	photos := make([]PhotoSelectionSummaryResponse, 0, len(s.Photos))
+	for i := range s.Photos {
+		photos = append(photos, NewPhotoSelectionSummaryResponse(&s.Photos[i]))
+	}
+	submissions := make([]SelectionSubmissionResponse, 0, len(s.Submissions))
+	for i := range s.Submissions {
+		submissions = append(submissions, NewSelectionSubmissionResponse(&s.Submissions[i]))
+	}
+	return ListingSelectionsResponse{Photos: photos, Submissions: submissions}
+}
+
+// ChosenPhotoResponse is a photo marked as a favorite, as exported.
+type ChosenPhotoResponse struct {
+	Filename      string    `json:"filename"`
+	PhotoID       uuid.UUID `json:"photo_id"`
+	Position      int32     `json:"position"`
+	Favorites     int       `json:"favorites"`
+	AverageRating *float64  `json:"average_rating"`
+}
+
+// NewChosenPhotoResponses converts the chosen photos of a listing.
+func NewChosenPhotoResponses(chosen []sharing.PhotoSelectionSummary) []ChosenPhotoResponse {
+	out := make([]ChosenPhotoResponse, 0, len(chosen))
+	for i := range chosen {
+		summary := NewPhotoSelectionSummaryResponse(&chosen[i])
+		out = append(out, ChosenPhotoResponse{
+			Filename:      summary.Filename,
+			PhotoID:       summary.PhotoID,
+			Position:      summary.Position,
+			Favorites:     summary.Favorites,
+			AverageRating: summary.AverageRating,
+		})
+	}
+	return out
+}
@@ -0,0 +1,156 @@
+package dto
+
+import (
+	"time"
+
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// CreateShareLinkRequest issues a share link for a listing. Omitting
+// expires_at uses the default lifetime; max_views of zero is unlimited. A
+// password makes visitors unlock the link first. allowed_emails also limit
+// it to the listed recipients and require a password: unlocking only
+// checks that the email typed is on the list, not that the visitor owns it.
+type CreateShareLinkRequest struct {
+	Permission    string     `json:"permission"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	MaxViews      int32      `json:"max_views"`
+	Password      string     `json:"password"`
+	AllowedEmails []string   `json:"allowed_emails"`
+}
+
+// UpdateShareLinkRequest extends a share link without changing its token.
+// Omitted fields are left as they are; max_views of zero is unlimited.
+type UpdateShareLinkRequest struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	MaxViews  *int32     `json:"max_views"`
+}
+
+// ShareLinkResponse is a share link. Token is only present in the response
+// that created the link; just its hash is kept, as is the password's.
+// RevokedAt is set once the link has been revoked.
+type ShareLinkResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	ListingID         uuid.UUID  `json:"listing_id"`
+	Permission        string     `json:"permission"`
+	Token             string     `json:"token,omitempty"`
+	PasswordProtected bool       `json:"password_protected"`
+	AllowedEmails     []string   `json:"allowed_emails"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	MaxViews          int32      `json:"max_views"`
+	ViewCount         int32      `json:"view_count"`
+	RevokedAt         *time.Time `json:"revoked_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+// NewShareLinkResponse converts a share link.
+func NewShareLinkResponse(l *sharing.ShareLink) ShareLinkResponse {
+	emails := l.AllowedEmails
+	if emails == nil {
+		emails = []string{}
+	}
+	return ShareLinkResponse{
+		ID:                l.ID,
+		ListingID:         l.ListingID,
+		Permission:        l.Permission,
+		Token:             l.Token,
+		PasswordProtected: l.PasswordHash != nil,
+		AllowedEmails:     emails,
+		ExpiresAt:         l.ExpiresAt,
+		MaxViews:          l.MaxViews,
+		ViewCount:         l.ViewCount,
+		RevokedAt:         l.RevokedAt,
+		CreatedAt:         l.CreatedAt,
+	}
+}
+
+// UnlockShareRequest unlocks a gated share link. password is needed when
+// the link has one, and email when it is limited to recipients. The email
+// is not verified.
+type UnlockShareRequest struct {
+	Password string `json:"password"`
+	Email    string `json:"email"`
+}
+
+// ShareSessionResponse describes the viewer session started by unlocking a
+// share link. Its token is only sent as a cookie.
+type ShareSessionResponse struct {
+	Email     *string   `json:"email"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewShareSessionResponse converts a viewer session.
+func NewShareSessionResponse(s *sharing.ShareSession) ShareSessionResponse {
+	return ShareSessionResponse{Email: s.Email, ExpiresAt: s.ExpiresAt}
+}
+
+// NewShareLinkResponses converts a list of share links.
+func NewShareLinkResponses(links []sharing.ShareLink) []ShareLinkResponse {
+	out := make([]ShareLinkResponse, 0, len(links))
+	for i := range links {
+		out = append(out, NewShareLinkResponse(&links[i]))
+	}
+	return out
+}
+
+// SharedListingResponse is a listing as seen through a share link, with its
+// published photos in display order.
+type SharedListingResponse struct {
+	ID          uuid.UUID             `json:"id"`
+	Title       string                `json:"title"`
+	Description *string               `json:"description"`
+	Permission  string                `json:"permission"`
+	ExpiresAt   time.Time             `json:"expires_at"`
+	Photos      []SharedPhotoResponse `json:"photos"`
+}
+
+// SharedPhotoResponse is a published photo seen through a share link. The
+// URLs are presigned and expire after a few minutes; URL is the watermarked
+// rendition when the tenant watermarks its photos.
+type SharedPhotoResponse struct {
+	ID           uuid.UUID              `json:"id"`
+	Filename     string                 `json:"filename"`
+	Position     int32                  `json:"position"`
+	IsCover      bool                   `json:"is_cover"`
+	URL          string                 `json:"url"`
+	ThumbnailURL *string                `json:"thumbnail_url"`
+	Variants     []PhotoVariantResponse `json:"variants"`
+	Srcset       string                 `json:"srcset"`
+	MimeType     string                 `json:"mime_type"`
+}
+
+// NewSharedListingResponse converts a shared listing; photos holds the
+// converted photos.
+func NewSharedListingResponse(shared *sharingapp.SharedListing, photos []SharedPhotoResponse) SharedListingResponse {
+	return SharedListingResponse{
+		ID:          shared.Listing.ID,
+		Title:       shared.Listing.Title,
+		Description: shared.Listing.Description,
+		Permission:  shared.Link.Permission,
+		ExpiresAt:   shared.Link.ExpiresAt,
+		Photos:      photos,
+	}
+}
+
+// NewSharedPhotoResponse converts a published photo and its public links.
+func NewSharedPhotoResponse(p *tenant.Photo, urls tenantapp.PublicPhotoURLs) SharedPhotoResponse {
+	variants := make([]PhotoVariantResponse, 0, len(urls.Variants))
+	for _, v := range urls.Variants {
+		variants = append(variants, PhotoVariantResponse{Width: v.Width, Height: v.Height, URL: v.URL})
+	}
+	return SharedPhotoResponse{
+		ID:           p.ID,
+		Filename:     p.Name(),
+		Position:     p.Position,
+		IsCover:      p.IsCover,
+		URL:          urls.Display,
+		ThumbnailURL: urls.Thumbnail,
+		Variants:     variants,
+		Srcset:       urls.Srcset,
+		MimeType:     p.MimeType,
+	}
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"time"
+
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	subscription "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	"github.com/google/uuid"
+)
+
+// PlanResponse is a purchasable plan.
+type PlanResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Type         string    `json:"type"`
+	Price        string    `json:"price"`
+	BillingCycle string    `json:"billing_cycle"`
+}
+
+// NewPlanResponse converts a plan.
+func NewPlanResponse(p *subscription.Plan) PlanResponse {
+	return PlanResponse{
+		ID:           p.ID,
+		Type:         p.Type,
+		Price:        p.Price,
+		BillingCycle: p.BillingCycle,
+	}
+}
+
+// NewPlanResponses converts a list of plans.
+func NewPlanResponses(plans []subscription.Plan) []PlanResponse {
+	out := make([]PlanResponse, 0, len(plans))
+	for i := range plans {
+		out = append(out, NewPlanResponse(&plans[i]))
+	}
+	return out
+}
+
+// LimitsResponse are the quotas of a plan.
+type LimitsResponse struct {
+	MaxStorageBytes  int64 `json:"max_storage_bytes"`
+	MaxUploadBytes   int64 `json:"max_upload_bytes"`
+	MaxListings      int32 `json:"max_listings"`
+	MaxListingPhotos int32 `json:"max_listing_photos"`
+}
+
+// SubscriptionResponse is a tenant's current subscription.
+type SubscriptionResponse struct {
+	ID                 uuid.UUID      `json:"id"`
+	Status             string         `json:"status"`
+	CurrentPeriodStart time.Time      `json:"current_period_start"`
+	CurrentPeriodEnd   time.Time      `json:"current_period_end"`
+	Plan               PlanResponse   `json:"plan"`
+	Limits             LimitsResponse `json:"limits"`
+}
+
+// NewSubscriptionResponse converts a current subscription.
+func NewSubscriptionResponse(s *subscriptionapp.CurrentSubscription) SubscriptionResponse {
+	return SubscriptionResponse{
+		ID:                 s.Subscription.ID,
+		Status:             s.Subscription.Status,
+		CurrentPeriodStart: s.Subscription.StartedAt,
+		CurrentPeriodEnd:   s.Subscription.EndAt,
+		Plan:               NewPlanResponse(&s.Plan),
+		Limits: LimitsResponse{
+			MaxStorageBytes:  s.Limits.MaxStorageBytes,
+			MaxUploadBytes:   s.Limits.MaxUploadBytes,
+			MaxListings:      s.Limits.MaxListings,
+			MaxListingPhotos: s.Limits.MaxListingPhotos,
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"time"
+
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TenantResponse is a tenant's profile.
+type TenantResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewTenantResponse converts a tenant.
+func NewTenantResponse(t *tenant.Tenant) TenantResponse {
+	return TenantResponse{
+		ID:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// UpdateTenantRequest renames a tenant.
+type UpdateTenantRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// SettingsResponse is a tenant's settings.
+type SettingsResponse struct {
+	Theme             string    `json:"theme"`
+	WatermarkEnabled  bool      `json:"watermark_enabled"`
+	WatermarkText     *string   `json:"watermark_text"`
+	WatermarkType     string    `json:"watermark_type"`
+	HasWatermarkImage bool      `json:"has_watermark_image"`
+	WatermarkPosition string    `json:"watermark_position"`
+	WatermarkOpacity  float64   `json:"watermark_opacity"`
+	WatermarkScale    float64   `json:"watermark_scale"`
+	WatermarkTiled    bool      `json:"watermark_tiled"`
+	StripGPS          bool      `json:"strip_gps"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// NewSettingsResponse converts tenant settings.
+func NewSettingsResponse(s *tenant.Settings) SettingsResponse {
+	return SettingsResponse{
+		Theme:             s.Theme,
+		WatermarkEnabled:  s.WatermarkEnabled,
+		WatermarkText:     s.WatermarkText,
+		WatermarkType:     s.WatermarkType,
+		HasWatermarkImage: s.WatermarkImageKey != nil,
+		WatermarkPosition: s.WatermarkPosition,
+		WatermarkOpacity:  s.WatermarkOpacity,
+		WatermarkScale:    s.WatermarkScale,
+		WatermarkTiled:    s.WatermarkTiled,
+		StripGPS:          s.StripGPS,
+		UpdatedAt:         s.UpdatedAt,
+	}
+}
+
+// UpdateSettingsRequest patches tenant settings; omitted fields are left unchanged
+// and an empty watermark_text clears it.
+type UpdateSettingsRequest struct {
+	Theme             *string  `json:"theme"`
+	WatermarkEnabled  *bool    `json:"watermark_enabled"`
+	WatermarkText     *string  `json:"watermark_text"`
+	WatermarkType     *string  `json:"watermark_type"`
+	WatermarkPosition *string  `json:"watermark_position"`
+	WatermarkOpacity  *float64 `json:"watermark_opacity"`
+	WatermarkScale    *float64 `json:"watermark_scale"`
+	WatermarkTiled    *bool    `json:"watermark_tiled"`
+	StripGPS          *bool    `json:"strip_gps"`
+}
+
+// Apply copies the fields present in the request onto s.
+func (r UpdateSettingsRequest) Apply(s *tenant.Settings) {
+	if r.Theme != nil {
+		s.Theme = *r.Theme
+	}
+	if r.WatermarkEnabled != nil {
+		s.WatermarkEnabled = *r.WatermarkEnabled
+	}
+	if r.WatermarkText != nil {
+		if *r.WatermarkText == "" {
+			s.WatermarkText = nil
+		} else {
+			s.WatermarkText = r.WatermarkText
+		}
+	}
+	if r.WatermarkType != nil {
+		s.WatermarkType = *r.WatermarkType
+	}
+	if r.WatermarkPosition != nil {
+		s.WatermarkPosition = *r.WatermarkPosition
+	}
+	if r.WatermarkOpacity != nil {
+		s.WatermarkOpacity = *r.WatermarkOpacity
+	}
+	if r.WatermarkScale != nil {
+		s.WatermarkScale = *r.WatermarkScale
+	}
+	if r.WatermarkTiled != nil {
+		s.WatermarkTiled = *r.WatermarkTiled
+	}
+	if r.StripGPS != nil {
+		s.StripGPS = *r.StripGPS
+	}
+}
+
+// StorageUsageResponse is how much storage a tenant uses.
+type StorageUsageResponse struct {
+	UsedStorageBytes int64     `json:"used_storage_bytes"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// NewStorageUsageResponse converts a storage usage counter.
+func NewStorageUsageResponse(u *tenant.StorageUsage) StorageUsageResponse {
+	return StorageUsageResponse{
+		UsedStorageBytes: u.UsedStorageBytes,
+		UpdatedAt:        u.UpdatedAt,
+	}
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// TrashedListingResponse is a deleted listing. It can be restored until
+// purge_at, when it is removed for good with its photos.
+type TrashedListingResponse struct {
+	ListingResponse
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+// NewTrashedListingResponses converts a list of deleted listings.
+func NewTrashedListingResponses(listings []tenant.Listing) []TrashedListingResponse {
+	out := make([]TrashedListingResponse, 0, len(listings))
+	for i := range listings {
+		l := &listings[i]
+		resp := TrashedListingResponse{ListingResponse: NewListingResponse(l)}
+		if l.DeletedAt != nil {
+			resp.DeletedAt = *l.DeletedAt
+			resp.PurgeAt = tenant.PurgeAt(*l.DeletedAt)
+		}
+		out = append(out, resp)
+	}
+	return out
+}
+
+// TrashedPhotoResponse is a deleted photo of a live listing. It can be
+// restored until purge_at. PreviewURL is presigned and expires after a few
+// minutes.
+type TrashedPhotoResponse struct {
+	ID           uuid.UUID `json:"id"`
+	ListingID    uuid.UUID `json:"listing_id"`
+	ListingTitle string    `json:"listing_title"`
+	FileID       uuid.UUID `json:"file_id"`
+	Position     int32     `json:"position"`
+	IsPublished  bool      `json:"is_published"`
+	PreviewURL   string    `json:"preview_url"`
+	SizeBytes    int64     `json:"size_bytes"`
+	MimeType     string    `json:"mime_type"`
+	DeletedAt    time.Time `json:"deleted_at"`
+	PurgeAt      time.Time `json:"purge_at"`
+}
+
+// NewTrashedPhotoResponse converts a deleted photo and its preview link.
+func NewTrashedPhotoResponse(p *tenant.TrashedPhoto, previewURL string) TrashedPhotoResponse {
+	return TrashedPhotoResponse{
+		ID:           p.ID,
+		ListingID:    p.ListingID,
+		ListingTitle: p.ListingTitle,
+		FileID:       p.FileID,
+		Position:     p.Position,
+		IsPublished:  p.IsPublished,
+		PreviewURL:   previewURL,
+		SizeBytes:    p.SizeBytes,
+		MimeType:     p.MimeType,
+		DeletedAt:    p.DeletedAt,
+		PurgeAt:      tenant.PurgeAt(p.DeletedAt),
+	}
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"strconv"
+	"time"
+
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// UploadResponse is the progress of a resumable upload. PhotoID is set once
+// every byte has arrived and the photo was added to the listing.
+type UploadResponse struct {
+	ID          uuid.UUID         `json:"id"`
+	ListingID   uuid.UUID         `json:"listing_id"`
+	Filename    string            `json:"filename"`
+	Metadata    map[string]string `json:"metadata"`
+	Length      int64             `json:"length"`
+	Offset      int64             `json:"offset"`
+	PhotoID     *uuid.UUID        `json:"photo_id"`
+	CompletedAt *time.Time        `json:"completed_at"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// NewUploadResponse converts a resumable upload.
+func NewUploadResponse(u *tenant.Upload) UploadResponse {
+	metadata := u.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	return UploadResponse{
+		ID:          u.ID,
+		ListingID:   u.ListingID,
+		Filename:    u.Filename,
+		Metadata:    metadata,
+		Length:      u.Length,
+		Offset:      u.Offset,
+		PhotoID:     u.PhotoID,
+		CompletedAt: u.CompletedAt,
+		ExpiresAt:   u.ExpiresAt,
+		CreatedAt:   u.CreatedAt,
+	}
+}
+
+// ReserveUploadRequest asks for a presigned URL to upload one photo of
+// size_bytes straight to storage.
+type ReserveUploadRequest struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type" binding:"required"`
+	SizeBytes   int64  `json:"size_bytes" binding:"required"`
+}
+
+// UploadReservationResponse is a reserved upload. The photo is sent with a
+// PUT to UploadURL carrying the listed headers, before UploadExpiresAt; the
+// reservation is then completed, before ExpiresAt, to add it to the listing.
+type UploadReservationResponse struct {
+	ID              uuid.UUID         `json:"id"`
+	ListingID       uuid.UUID         `json:"listing_id"`
+	Filename        string            `json:"filename"`
+	SizeBytes       int64             `json:"size_bytes"`
+	UploadURL       string            `json:"upload_url"`
+	Method          string            `json:"method"`
+	Headers         map[string]string `json:"headers"`
+	UploadExpiresAt time.Time         `json:"upload_expires_at"`
+	ExpiresAt       time.Time         `json:"expires_at"`
+}
+
+// NewUploadReservationResponse converts a reservation and its upload URL,
+// which expires at uploadExpiresAt.
+func NewUploadReservationResponse(r *tenant.UploadReservation, uploadURL string, uploadExpiresAt time.Time) UploadReservationResponse {
+	return UploadReservationResponse{
+		ID:        r.ID,
+		ListingID: r.ListingID,
+		Filename:  r.Filename,
+		SizeBytes: r.SizeBytes,
+		UploadURL: uploadURL,
+		Method:    "PUT",
+		Headers: map[string]string{
+			"Content-Type":   r.MimeType,
+			"Content-Length": strconv.FormatInt(r.SizeBytes, 10),
+		},
+		UploadExpiresAt: uploadExpiresAt,
+		ExpiresAt:       r.ExpiresAt,
+	}
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/google/uuid"
+)
+
+// UserResponse is a tenant member.
+type UserResponse struct {
+	ID          uuid.UUID               `json:"id"`
+	TenantID    uuid.UUID               `json:"tenant_id"`
+	Username    string                  `json:"username"`
+	Email       string                  `json:"email"`
+	Role        string                  `json:"role"`
+	Permissions []authdomain.Permission `json:"permissions,omitempty"`
+	JoinedAt    *time.Time              `json:"joined_at,omitempty"`
+}
+
+// NewUserResponse converts a member and lists the permissions their role grants.
+func NewUserResponse(tenantID uuid.UUID, m *tenant.Member) UserResponse {
+	joinedAt := m.JoinedAt
+	return UserResponse{
+		ID:          m.UserID,
+		TenantID:    tenantID,
+		Username:    m.Username,
+		Email:       m.Email,
+		Role:        m.Role,
+		Permissions: authdomain.DefaultPolicy.Permissions([]string{m.Role}),
+		JoinedAt:    &joinedAt,
+	}
+}
+
+// UpdateRoleRequest changes a member's role.
+type UpdateRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
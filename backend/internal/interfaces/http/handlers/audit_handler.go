@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	audit "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AuditHandler serves the tenant's audit log.
+type AuditHandler struct {
+	events *auditapp.EventReader
+}
+
+// NewAuditHandler creates an AuditHandler.
+func NewAuditHandler(events *auditapp.EventReader) *AuditHandler {
+	return &AuditHandler{events: events}
+}
+
+// List handles GET /v1/audit-logs, optionally filtered by entity_type and entity_id.
+func (h *AuditHandler) List(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	filter := audit.Filter{EntityType: c.Query("entity_type")}
+	if raw := c.Query("entity_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "entity_id must be a UUID", nil)
+			return
+		}
+		filter.EntityID = id
+	}
+
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	entries, err := h.events.List(c.Request.Context(), principal.TenantID, filter, int32(limit+1), int32(offset))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	hasMore := len(entries) > limit
+	if hasMore {
+		entries = entries[:limit]
+	}
+
+	response.Paginated(c, dto.NewAuditLogResponses(entries), response.Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: hasMore,
+	})
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+
+	authapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// AuthHandler serves signup, login and session endpoints.
+type AuthHandler struct {
+	signup *authapp.SignupService
+	login  *authapp.LoginService
+}
+
+// NewAuthHandler creates an AuthHandler.
+func NewAuthHandler(signup *authapp.SignupService, login *authapp.LoginService) *AuthHandler {
+	return &AuthHandler{signup: signup, login: login}
+}
+
+// Signup handles POST /v1/auth/signup.
+func (h *AuthHandler) Signup(c *gin.Context) {
+	var req dto.SignupRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	result, err := h.signup.Signup(c.Request.Context(), authapp.SignupInput{
+		TenantName: req.TenantName,
+		Username:   req.Username,
+		Email:      req.Email,
+		Password:   req.Password,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, dto.NewSignupResponse(result))
+}
+
+// Login handles POST /v1/auth/login.
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	if req.TenantID == uuid.Nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "tenant_id is required", nil)
+		return
+	}
+
+	result, err := h.login.Login(c.Request.Context(), authapp.LoginInput{
+		TenantID:   req.TenantID,
+		Identifier: req.Identifier,
+		Password:   req.Password,
+		IP:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewLoginResponse(result))
+}
+
+// Refresh handles POST /v1/auth/refresh.
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	tokens, err := h.login.Refresh(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewTokenResponse(tokens))
+}
+
+// Logout handles POST /v1/auth/logout.
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var req dto.LogoutRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.login.Logout(c.Request.Context(), req.RefreshToken, c.ClientIP(), c.Request.UserAgent()); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
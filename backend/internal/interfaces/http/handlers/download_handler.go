@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// DownloadHandler serves ZIP downloads of listings, to the tenant's members
+// and to share link holders. Small archives are streamed in the response;
+// large ones are queued and answered with 202 and the archive to poll.
+type DownloadHandler struct {
+	downloads *sharingapp.DownloadService
+	archives  *tenantapp.ArchiveService
+}
+
+// NewDownloadHandler creates a DownloadHandler.
+func NewDownloadHandler(downloads *sharingapp.DownloadService, archives *tenantapp.ArchiveService) *DownloadHandler {
+	return &DownloadHandler{downloads: downloads, archives: archives}
+}
+
+// Listing handles GET /v1/listings/:listing_id/download?variant=&selection=&share_link_id=.
+func (h *DownloadHandler) Listing(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	selection, ok := selectionQuery(c)
+	if !ok {
+		return
+	}
+	linkID, ok := shareLinkQuery(c)
+	if !ok {
+		return
+	}
+
+	plan, err := h.downloads.ListingPlan(c.Request.Context(), sharingapp.ListingDownloadInput{
+		TenantID:    principal.TenantID,
+		ListingID:   listingID,
+		UserID:      principal.UserID,
+		Variant:     c.Query("variant"),
+		Selection:   selection,
+		ShareLinkID: linkID,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	h.serve(c, plan)
+}
+
+// ListingArchive handles GET /v1/listings/:listing_id/archives/:archive_id.
+func (h *DownloadHandler) ListingArchive(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	archiveID, ok := uuidParam(c, "archive_id")
+	if !ok {
+		return
+	}
+
+	archive, err := h.archives.Get(c.Request.Context(), principal.TenantID, listingID, archiveID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	h.respondArchive(c, archive)
+}
+
+// Shared handles GET /v1/shared/:token/download?variant=&selection=.
+func (h *DownloadHandler) Shared(c *gin.Context) {
+	selection, ok := selectionQuery(c)
+	if !ok {
+		return
+	}
+
+	plan, err := h.downloads.SharedPlan(c.Request.Context(), sharingapp.SharedDownloadInput{
+		Token:        c.Param("token"),
+		SessionToken: shareSession(c),
+		Variant:      c.Query("variant"),
+		Selection:    selection,
+		Visitor:      shareVisitor(c),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	h.serve(c, plan)
+}
+
+// SharedArchive handles GET /v1/shared/:token/archives/:archive_id.
+func (h *DownloadHandler) SharedArchive(c *gin.Context) {
+	archiveID, ok := uuidParam(c, "archive_id")
+	if !ok {
+		return
+	}
+
+	archive, err := h.downloads.SharedArchive(c.Request.Context(), c.Param("token"), shareSession(c), archiveID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	h.respondArchive(c, archive)
+}
+
+// serve streams the archive of plan, or queues it when it is too large.
+// Once the first entry is written a failure can only cut the ZIP short,
+// which leaves it without its central directory and so unreadable.
+func (h *DownloadHandler) serve(c *gin.Context, plan *tenant.ArchivePlan) {
+	if plan.Large() {
+		archive, err := h.archives.Queue(c.Request.Context(), plan)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		path := strings.TrimSuffix(c.Request.URL.Path, "/download")
+		c.Header("Location", fmt.Sprintf("%s/archives/%s", path, archive.ID))
+		response.JSON(c, http.StatusAccepted, dto.NewArchiveResponse(archive, nil))
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, plan.FileName))
+	c.Header("Cache-Control", "private, no-store")
+	c.Status(http.StatusOK)
+	if err := h.archives.Stream(c.Request.Context(), c.Writer, plan); err != nil {
+		if !c.Writer.Written() {
+			c.Writer.Header().Del("Content-Disposition")
+			respondError(c, err)
+			return
+		}
+		log.Printf("%s archive download of listing %s failed: %v", c.GetString(response.RequestIDKey), plan.ListingID, err)
+	}
+}
+
+func (h *DownloadHandler) respondArchive(c *gin.Context, archive *tenant.Archive) {
+	url, err := h.archives.DownloadURL(c.Request.Context(), archive)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// Presigned URLs must not outlive their signature in shared caches
+	c.Header("Cache-Control", "private, no-store")
+	response.JSON(c, http.StatusOK, dto.NewArchiveResponse(archive, url))
+}
+
+// selectionQuery reads the optional selection query parameter.
+func selectionQuery(c *gin.Context) (bool, bool) {
+	raw := c.Query("selection")
+	if raw == "" {
+		return false, true
+	}
+	selection, err := strconv.ParseBool(raw)
+	if err != nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "selection must be true or false", nil)
+		return false, false
+	}
+	return selection, true
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	notification "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/domain"
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	subscription "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/domain"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Pagination defaults for offset-paged list endpoints.
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// Domain errors grouped by the HTTP status they map to.
+var (
+	unauthorizedErrors = []error{
+		authdomain.ErrInvalidCredentials,
+		auth.ErrInvalidToken,
+		auth.ErrExpiredToken,
+		auth.ErrSessionNotFound,
+		auth.ErrSessionRevoked,
+		auth.ErrRefreshTokenReused,
+		sharing.ErrInvalidShareCredentials,
+	}
+
+	forbiddenErrors = []error{
+		auth.ErrTenantMismatch,
+		tenant.ErrCannotChangeOwnRole,
+		tenant.ErrRoleNotAssignable,
+		subscription.ErrSubscriptionRequired,
+		sharing.ErrShareLinkExpired,
+		sharing.ErrShareViewsUsedUp,
+		sharing.ErrShareNotPermitted,
+		sharing.ErrShareLinkRevoked,
+	}
+
+	notFoundErrors = []error{
+		tenant.ErrTenantNotFound,
+		tenant.ErrNotMember,
+		tenant.ErrListingNotFound,
+		tenant.ErrPhotoNotFound,
+		tenant.ErrUploadNotFound,
+		tenant.ErrReservationNotFound,
+		tenant.ErrArchiveNotFound,
+		sharing.ErrShareLinkNotFound,
+		subscription.ErrNoActiveSubscription,
+		notification.ErrNotificationNotFound,
+	}
+
+	conflictErrors = []error{
+		tenant.ErrTenantNameTaken,
+		tenant.ErrLastAdmin,
+		tenant.ErrUploadOffsetMismatch,
+		tenant.ErrUploadComplete,
+		tenant.ErrReservationComplete,
+		tenant.ErrReservedObjectAbsent,
+		tenant.ErrPhotoInListing,
+		tenant.ErrInvalidTransition,
+		sharing.ErrShareLinkNotGated,
+		sharing.ErrSelectionSubmitted,
+	}
+
+	tooLargeErrors = []error{
+		tenant.ErrPhotoTooLarge,
+		tenant.ErrWatermarkImageTooLarge,
+		tenant.ErrUploadTooLarge,
+	}
+
+	unsupportedMediaErrors = []error{
+		tenant.ErrUnsupportedPhotoType,
+		tenant.ErrReservedTypeMismatch,
+	}
+
+	validationErrors = []error{
+		authdomain.ErrInvalidUsername,
+		authdomain.ErrInvalidEmail,
+		authdomain.ErrInvalidPassword,
+		authdomain.ErrInvalidRole,
+		tenant.ErrInvalidTenantName,
+		tenant.ErrInvalidTheme,
+		tenant.ErrInvalidWatermarkText,
+		tenant.ErrInvalidWatermarkType,
+		tenant.ErrInvalidWatermarkPosition,
+		tenant.ErrInvalidWatermarkOpacity,
+		tenant.ErrInvalidWatermarkScale,
+		tenant.ErrWatermarkImageRequired,
+		tenant.ErrEmptyWatermarkImage,
+		tenant.ErrInvalidListingTitle,
+		tenant.ErrInvalidListingStatus,
+		tenant.ErrInvalidSchedule,
+		tenant.ErrInvalidVisibility,
+		tenant.ErrInvalidListingSort,
+		tenant.ErrInvalidCursor,
+		tenant.ErrEmptyPhoto,
+		tenant.ErrInvalidUploadLength,
+		tenant.ErrReservedSizeMismatch,
+		tenant.ErrInvalidPhotoOrder,
+		tenant.ErrNoPhotosSelected,
+		tenant.ErrTooManyPhotos,
+		tenant.ErrDuplicatePhoto,
+		tenant.ErrSameListing,
+		tenant.ErrInvalidArchiveVariant,
+		tenant.ErrEmptyArchive,
+		sharing.ErrInvalidPermission,
+		sharing.ErrInvalidExpiry,
+		sharing.ErrInvalidMaxViews,
+		sharing.ErrMaxViewsBelowViewCount,
+		sharing.ErrInvalidSharePassword,
+		sharing.ErrInvalidRecipient,
+		sharing.ErrTooManyRecipients,
+		sharing.ErrRecipientsNeedPassword,
+		sharing.ErrInvalidRating,
+		sharing.ErrCommentTooLong,
+		sharing.ErrNoteTooLong,
+		sharing.ErrEmptySelection,
+		sharing.ErrInvalidInterval,
+		sharing.ErrInvalidRange,
+	}
+)
+
+// respondError maps err to the matching API error envelope. Unknown errors
+// are logged and reported as INTERNAL_ERROR without leaking details.
+func respondError(c *gin.Context, err error) {
+	var duplicate *authdomain.DuplicateError
+	var locked *authdomain.LockedError
+	var tooLarge *http.MaxBytesError
+	var quota *subscription.QuotaExceededError
+	var unlock *sharing.UnlockRequiredError
+
+	switch {
+	case errors.As(err, &duplicate):
+		response.Error(c, http.StatusConflict, response.CodeConflict, err.Error(), map[string]any{"field": duplicate.Field})
+	case errors.As(err, &locked):
+		retryAfter := int(math.Ceil(locked.RetryAfter(time.Now()).Seconds()))
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		response.Error(c, http.StatusTooManyRequests, response.CodeRateLimitExceeded, err.Error(), map[string]any{
+			"locked_until": locked.Until.UTC(),
+		})
+	case errors.As(err, &unlock):
+		response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, err.Error(), map[string]any{
+			"password_required": unlock.Password,
+			"email_required":    unlock.Email,
+		})
+	case isAny(err, unauthorizedErrors):
+		response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, err.Error(), nil)
+	case isAny(err, forbiddenErrors):
+		response.Error(c, http.StatusForbidden, response.CodeForbidden, err.Error(), nil)
+	case isAny(err, notFoundErrors):
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, err.Error(), nil)
+	case isAny(err, conflictErrors):
+		response.Error(c, http.StatusConflict, response.CodeConflict, err.Error(), nil)
+	case errors.As(err, &quota):
+		response.Error(c, http.StatusForbidden, response.CodeQuotaExceeded, err.Error(), map[string]any{
+			"resource": quota.Resource,
+			"usage":    quota.Usage,
+			"limit":    quota.Limit,
+		})
+	case errors.As(err, &tooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, response.CodePayloadTooLarge, "request body is too large", map[string]any{
+			"max_bytes": tooLarge.Limit,
+		})
+	case isAny(err, tooLargeErrors):
+		response.Error(c, http.StatusRequestEntityTooLarge, response.CodePayloadTooLarge, err.Error(), nil)
+	case isAny(err, unsupportedMediaErrors):
+		response.Error(c, http.StatusUnsupportedMediaType, response.CodeUnsupportedMedia, err.Error(), nil)
+	case isAny(err, validationErrors):
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, err.Error(), nil)
+	default:
+		log.Printf("%s %s %s: %v", c.GetString(response.RequestIDKey), c.Request.Method, c.FullPath(), err)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternal, "internal server error", nil)
+	}
+}
+
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// bindJSON decodes the request body into dst, responding with VALIDATION_ERROR on failure.
+func bindJSON(c *gin.Context, dst any) bool {
+	if err := c.ShouldBindJSON(dst); err != nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "invalid request body", map[string]any{
+			"reason": err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// uuidParam parses a UUID path parameter. Malformed IDs cannot match any
+// resource, so they are reported as NOT_FOUND.
+func uuidParam(c *gin.Context, name string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "resource not found", map[string]any{
+			name: c.Param(name),
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// limitParam reads the limit query parameter.
+func limitParam(c *gin.Context) (int, bool) {
+	raw := c.Query("limit")
+	if raw == "" {
+		return defaultPageLimit, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 || n > maxPageLimit {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "limit must be between 1 and 100", nil)
+		return 0, false
+	}
+	return n, true
+}
+
+// pageParams reads the limit and offset query parameters.
+func pageParams(c *gin.Context) (limit, offset int, ok bool) {
+	if limit, ok = limitParam(c); !ok {
+		return 0, 0, false
+	}
+	if raw := c.Query("offset"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 || n > math.MaxInt32 {
+			response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "offset must be a non-negative integer", nil)
+			return 0, 0, false
+		}
+		offset = n
+	}
+	return limit, offset, true
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRespondError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "max views below views used",
+			err:        sharing.ErrMaxViewsBelowViewCount,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   response.CodeValidation,
+		},
+		{
+			name:       "wrapped validation error",
+			err:        fmt.Errorf("extend: %w", sharing.ErrMaxViewsBelowViewCount),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   response.CodeValidation,
+		},
+		{
+			name:       "negative max views",
+			err:        sharing.ErrInvalidMaxViews,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   response.CodeValidation,
+		},
+		{
+			name:       "revoked link",
+			err:        sharing.ErrShareLinkRevoked,
+			wantStatus: http.StatusForbidden,
+			wantCode:   response.CodeForbidden,
+		},
+		{
+			name:       "unknown error",
+			err:        errors.New("connection reset"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   response.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/", nil)
+
+			respondError(c, tt.err)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			var body struct {
+				Error response.ErrorBody `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			if body.Error.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", body.Error.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -1 +1,43 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// healthCheckTimeout bounds how long the database ping may take.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthHandler reports whether the API and its dependencies are up.
+type HealthHandler struct {
+	db *sql.DB
+}
+
+// NewHealthHandler creates a HealthHandler.
+func NewHealthHandler(db *sql.DB) *HealthHandler {
+	return &HealthHandler{db: db}
+}
+
+// Health handles GET /health. It is used by load balancers, so it answers
+// 503 when the database is unreachable.
+func (h *HealthHandler) Health(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthCheckTimeout)
+	defer cancel()
+
+	status, database, code := "healthy", "connected", http.StatusOK
+	if err := h.db.PingContext(ctx); err != nil {
+		status, database, code = "unhealthy", "disconnected", http.StatusServiceUnavailable
+	}
+
+	c.JSON(code, gin.H{
+		"status":    status,
+		"timestamp": time.Now().UTC(),
+		"services": gin.H{
+			"database": database,
+		},
+	})
+}
@@ -1 +1,235 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"net/http"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListingHandler serves listing endpoints.
+type ListingHandler struct {
+	listings *tenantapp.ListingService
+}
+
+// NewListingHandler creates a ListingHandler.
+func NewListingHandler(listings *tenantapp.ListingService) *ListingHandler {
+	return &ListingHandler{listings: listings}
+}
+
+// List handles GET /v1/listings. Results can be filtered by status,
+// visibility and user_id, ordered by sort and paged with cursor.
+func (h *ListingHandler) List(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	limit, ok := limitParam(c)
+	if !ok {
+		return
+	}
+
+	q := tenant.ListingQuery{
+		TenantID: principal.TenantID,
+		Filter: tenant.ListingFilter{
+			Status:     c.Query("status"),
+			Visibility: c.Query("visibility"),
+		},
+		Sort:  c.DefaultQuery("sort", tenant.ListingSortNewest),
+		Limit: int32(limit),
+	}
+	if raw := c.Query("user_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "user_id must be a UUID", nil)
+			return
+		}
+		q.Filter.UserID = id
+	}
+	if raw := c.Query("cursor"); raw != "" {
+		cursor, err := tenant.DecodeListingCursor(raw, q.Sort)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		q.After = cursor
+	}
+
+	page, err := h.listings.List(c.Request.Context(), q)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.Paginated(c, dto.NewListingResponses(page.Listings), response.Pagination{
+		Limit:      limit,
+		NextCursor: page.NextCursor,
+		HasMore:    page.NextCursor != "",
+	})
+}
+
+// Create handles POST /v1/listings.
+func (h *ListingHandler) Create(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	var req dto.CreateListingRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	listing, err := h.listings.Create(c.Request.Context(), tenantapp.CreateListingInput{
+		TenantID:    principal.TenantID,
+		UserID:      principal.UserID,
+		Title:       req.Title,
+		Description: req.Description,
+		Visibility:  req.Visibility,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, dto.NewListingResponse(listing))
+}
+
+// Get handles GET /v1/listings/:listing_id.
+func (h *ListingHandler) Get(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	listing, err := h.listings.Get(c.Request.Context(), principal.TenantID, listingID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
+
+// Update handles PATCH /v1/listings/:listing_id.
+func (h *ListingHandler) Update(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateListingRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	listing, err := h.listings.Update(c.Request.Context(), principal.TenantID, principal.UserID, listingID, req.Changes())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
+
+// Delete handles DELETE /v1/listings/:listing_id.
+func (h *ListingHandler) Delete(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	if err := h.listings.Delete(c.Request.Context(), principal.TenantID, principal.UserID, listingID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// Publish handles POST /v1/listings/:listing_id/publish.
+func (h *ListingHandler) Publish(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	listing, err := h.listings.Publish(c.Request.Context(), principal.TenantID, principal.UserID, listingID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
+
+// Unpublish handles POST /v1/listings/:listing_id/unpublish.
+func (h *ListingHandler) Unpublish(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	listing, err := h.listings.Unpublish(c.Request.Context(), principal.TenantID, principal.UserID, listingID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
+
+// Transition handles PUT /v1/listings/:listing_id/status, moving the listing
+// through draft, review, published and archived.
+func (h *ListingHandler) Transition(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.TransitionListingRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	listing, err := h.listings.Transition(c.Request.Context(), principal.TenantID, principal.UserID, listingID, req.Status)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
+
+// Schedule handles PUT /v1/listings/:listing_id/schedule.
+func (h *ListingHandler) Schedule(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.ScheduleListingRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	listing, err := h.listings.Schedule(c.Request.Context(), principal.TenantID, principal.UserID, listingID, req.PublishAt, req.UnpublishAt)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"net/http"
+
+	notificationapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationHandler serves the caller's notifications.
+type NotificationHandler struct {
+	notifications *notificationapp.NotificationService
+}
+
+// NewNotificationHandler creates a NotificationHandler.
+func NewNotificationHandler(notifications *notificationapp.NotificationService) *NotificationHandler {
+	return &NotificationHandler{notifications: notifications}
+}
+
+// List handles GET /v1/notifications.
+func (h *NotificationHandler) List(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	notifications, err := h.notifications.List(c.Request.Context(), principal.TenantID, principal.UserID, int32(limit+1), int32(offset))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	hasMore := len(notifications) > limit
+	if hasMore {
+		notifications = notifications[:limit]
+	}
+
+	response.Paginated(c, dto.NewNotificationResponses(notifications), response.Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: hasMore,
+	})
+}
+
+// UnreadCount handles GET /v1/notifications/unread-count.
+func (h *NotificationHandler) UnreadCount(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	count, err := h.notifications.UnreadCount(c.Request.Context(), principal.TenantID, principal.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.UnreadCountResponse{Unread: count})
+}
+
+// MarkRead handles POST /v1/notifications/:notification_id/read.
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	notificationID, ok := uuidParam(c, "notification_id")
+	if !ok {
+		return
+	}
+
+	if err := h.notifications.MarkRead(c.Request.Context(), principal.TenantID, principal.UserID, notificationID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Multipart form fields accepted by Upload.
+const (
+	photoFormField   = "photos"
+	maxPhotosPerForm = 20
+)
+
+// PhotoHandler serves the photos of a listing.
+type PhotoHandler struct {
+	photos *tenantapp.PhotoService
+	direct *tenantapp.DirectUploadService
+}
+
+// NewPhotoHandler creates a PhotoHandler.
+func NewPhotoHandler(photos *tenantapp.PhotoService, direct *tenantapp.DirectUploadService) *PhotoHandler {
+	return &PhotoHandler{photos: photos, direct: direct}
+}
+
+// List handles GET /v1/listings/:listing_id/photos.
+func (h *PhotoHandler) List(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	photos, err := h.photos.List(c.Request.Context(), principal.TenantID, listingID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	out := make([]dto.PhotoResponse, 0, len(photos))
+	for i := range photos {
+		resp, err := h.photoResponse(c, &photos[i])
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		out = append(out, resp)
+	}
+
+	response.JSON(c, http.StatusOK, out)
+}
+
+// Upload handles POST /v1/listings/:listing_id/photos. Each "photos" part
+// of the multipart body is streamed to storage without buffering the whole
+// form. Photos stored before a failing part are kept.
+func (h *PhotoHandler) Upload(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxPhotosPerForm*(tenant.MaxPhotoSizeBytes+1<<20))
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "request must be multipart/form-data", nil)
+		return
+	}
+
+	photos := make([]dto.PhotoResponse, 0, 1)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "malformed multipart body", nil)
+			return
+		}
+		if part.FormName() != photoFormField || part.FileName() == "" {
+			part.Close()
+			continue
+		}
+		if len(photos) == maxPhotosPerForm {
+			part.Close()
+			response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "too many photos in one request", map[string]any{
+				"max_photos": maxPhotosPerForm,
+			})
+			return
+		}
+
+		photo, err := h.photos.Upload(c.Request.Context(), tenantapp.UploadPhotoInput{
+			TenantID:  principal.TenantID,
+			ListingID: listingID,
+			UserID:    principal.UserID,
+			Filename:  part.FileName(),
+			Body:      part,
+		})
+		part.Close()
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		resp, err := h.photoResponse(c, photo)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		photos = append(photos, resp)
+	}
+
+	if len(photos) == 0 {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "no photos in request", map[string]any{
+			"field": photoFormField,
+		})
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, photos)
+}
+
+// ReserveUpload handles POST /v1/listings/:listing_id/photos/reservations,
+// returning a presigned URL the client uploads the photo to directly.
+func (h *PhotoHandler) ReserveUpload(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.ReserveUploadRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	reservation, target, err := h.direct.Reserve(c.Request.Context(), tenantapp.ReserveUploadInput{
+		TenantID:  principal.TenantID,
+		ListingID: listingID,
+		UserID:    principal.UserID,
+		Filename:  req.Filename,
+		MimeType:  req.ContentType,
+		SizeBytes: req.SizeBytes,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, dto.NewUploadReservationResponse(reservation, target.URL, target.ExpiresAt))
+}
+
+// CompleteUpload handles POST
+// /v1/listings/:listing_id/photos/reservations/:reservation_id/complete,
+// adding the directly uploaded photo to the listing.
+func (h *PhotoHandler) CompleteUpload(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	reservationID, ok := uuidParam(c, "reservation_id")
+	if !ok {
+		return
+	}
+
+	photo, err := h.direct.Complete(c.Request.Context(), principal.TenantID, principal.UserID, listingID, reservationID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	resp, err := h.photoResponse(c, photo)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, resp)
+}
+
+// SetCover handles PUT /v1/listings/:listing_id/photos/:photo_id/cover.
+func (h *PhotoHandler) SetCover(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	photoID, ok := uuidParam(c, "photo_id")
+	if !ok {
+		return
+	}
+
+	if err := h.photos.SetCover(c.Request.Context(), principal.TenantID, listingID, photoID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// Delete handles DELETE /v1/listings/:listing_id/photos/:photo_id.
+func (h *PhotoHandler) Delete(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	photoID, ok := uuidParam(c, "photo_id")
+	if !ok {
+		return
+	}
+
+	if err := h.photos.Delete(c.Request.Context(), principal.TenantID, listingID, photoID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// Reorder handles PUT /v1/listings/:listing_id/photos/order. The body lists
+// every photo of the listing in its new display order.
+func (h *PhotoHandler) Reorder(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.PhotoSelectionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.photos.Reorder(c.Request.Context(), principal.TenantID, listingID, req.PhotoIDs); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// Publish handles POST /v1/listings/:listing_id/photos/publish.
+func (h *PhotoHandler) Publish(c *gin.Context) {
+	h.setPublished(c, true)
+}
+
+// Unpublish handles POST /v1/listings/:listing_id/photos/unpublish.
+func (h *PhotoHandler) Unpublish(c *gin.Context) {
+	h.setPublished(c, false)
+}
+
+func (h *PhotoHandler) setPublished(c *gin.Context, published bool) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.PhotoSelectionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.photos.SetPublished(c.Request.Context(), principal.TenantID, listingID, req.PhotoIDs, published); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// DeleteMany handles POST /v1/listings/:listing_id/photos/delete.
+func (h *PhotoHandler) DeleteMany(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.PhotoSelectionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.photos.DeleteMany(c.Request.Context(), principal.TenantID, listingID, req.PhotoIDs); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// Move handles POST /v1/listings/:listing_id/photos/move.
+func (h *PhotoHandler) Move(c *gin.Context) {
+	h.transfer(c, h.photos.Move)
+}
+
+// Copy handles POST /v1/listings/:listing_id/photos/copy.
+func (h *PhotoHandler) Copy(c *gin.Context) {
+	h.transfer(c, h.photos.Copy)
+}
+
+func (h *PhotoHandler) transfer(c *gin.Context, fn func(ctx context.Context, tenantID, listingID, targetListingID uuid.UUID, photoIDs []uuid.UUID) error) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	var req dto.TransferPhotosRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := fn(c.Request.Context(), principal.TenantID, listingID, req.TargetListingID, req.PhotoIDs); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// photoResponse converts a photo with freshly presigned download links.
+func (h *PhotoHandler) photoResponse(c *gin.Context, photo *tenant.Photo) (dto.PhotoResponse, error) {
+	urls, err := h.photos.URLs(c.Request.Context(), photo)
+	if err != nil {
+		return dto.PhotoResponse{}, err
+	}
+	return dto.NewPhotoResponse(photo, urls), nil
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Export formats of a listing's chosen photos.
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+// SelectionHandler serves the client proofing of a listing to its tenant.
+type SelectionHandler struct {
+	proofing *sharingapp.ProofingService
+}
+
+// NewSelectionHandler creates a SelectionHandler.
+func NewSelectionHandler(proofing *sharingapp.ProofingService) *SelectionHandler {
+	return &SelectionHandler{proofing: proofing}
+}
+
+// Review handles GET /v1/listings/:listing_id/selections, optionally for
+// one share_link_id.
+func (h *SelectionHandler) Review(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	linkID, ok := shareLinkQuery(c)
+	if !ok {
+		return
+	}
+
+	review, err := h.proofing.Review(c.Request.Context(), principal.TenantID, listingID, linkID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingSelectionsResponse(review))
+}
+
+// Export handles GET /v1/listings/:listing_id/selections/export, listing the
+// file names of the photos marked as favorites as a CSV (the default) or
+// JSON attachment, optionally for one share_link_id.
+func (h *SelectionHandler) Export(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	linkID, ok := shareLinkQuery(c)
+	if !ok {
+		return
+	}
+	format := c.DefaultQuery("format", exportFormatCSV)
+	if format != exportFormatCSV && format != exportFormatJSON {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "format must be csv or json", nil)
+		return
+	}
+
+	chosen, err := h.proofing.Chosen(c.Request.Context(), principal.TenantID, listingID, linkID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	photos := dto.NewChosenPhotoResponses(chosen)
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="selection-%s.%s"`, listingID, format))
+	if format == exportFormatJSON {
+		c.JSON(http.StatusOK, photos)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Status(http.StatusOK)
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"filename", "photo_id", "position", "favorites", "average_rating"})
+	for _, p := range photos {
+		rating := ""
+		if p.AverageRating != nil {
+			rating = strconv.FormatFloat(*p.AverageRating, 'f', 2, 64)
+		}
+		_ = w.Write([]string{
+			csvText(p.Filename),
+			p.PhotoID.String(),
+			strconv.Itoa(int(p.Position)),
+			strconv.Itoa(p.Favorites),
+			rating,
+		})
+	}
+	w.Flush()
+}
+
+// shareLinkQuery reads the optional share_link_id query parameter.
+func shareLinkQuery(c *gin.Context) (*uuid.UUID, bool) {
+	raw := c.Query("share_link_id")
+	if raw == "" {
+		return nil, true
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "share_link_id must be a UUID", nil)
+		return nil, false
+	}
+	return &id, true
+}
+
+// csvText keeps spreadsheets from evaluating a client supplied value as a
+// formula.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
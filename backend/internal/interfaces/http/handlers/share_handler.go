@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// ShareHandler serves the share links of a listing and their analytics.
+type ShareHandler struct {
+	shares    *sharingapp.ShareService
+	analytics *sharingapp.AnalyticsService
+}
+
+// NewShareHandler creates a ShareHandler.
+func NewShareHandler(shares *sharingapp.ShareService, analytics *sharingapp.AnalyticsService) *ShareHandler {
+	return &ShareHandler{shares: shares, analytics: analytics}
+}
+
+// List handles GET /v1/listings/:listing_id/share-links.
+func (h *ShareHandler) List(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	links, err := h.shares.List(c.Request.Context(), principal.TenantID, listingID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewShareLinkResponses(links))
+}
+
+// Create handles POST /v1/listings/:listing_id/share-links.
+func (h *ShareHandler) Create(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	var req dto.CreateShareLinkRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = *req.ExpiresAt
+	}
+
+	link, err := h.shares.Create(c.Request.Context(), sharingapp.CreateShareLinkInput{
+		TenantID:      principal.TenantID,
+		ListingID:     listingID,
+		CreatedBy:     principal.UserID,
+		Permission:    req.Permission,
+		ExpiresAt:     expiresAt,
+		MaxViews:      req.MaxViews,
+		Password:      req.Password,
+		AllowedEmails: req.AllowedEmails,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, dto.NewShareLinkResponse(link))
+}
+
+// Update handles PATCH /v1/listings/:listing_id/share-links/:share_link_id,
+// extending the link's expiry or view limit while keeping its token.
+func (h *ShareHandler) Update(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	linkID, ok := uuidParam(c, "share_link_id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateShareLinkRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	link, err := h.shares.Extend(c.Request.Context(), sharingapp.ExtendShareLinkInput{
+		TenantID:  principal.TenantID,
+		ActorID:   principal.UserID,
+		ListingID: listingID,
+		LinkID:    linkID,
+		ExpiresAt: req.ExpiresAt,
+		MaxViews:  req.MaxViews,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewShareLinkResponse(link))
+}
+
+// Analytics handles GET
+// /v1/listings/:listing_id/share-links/:share_link_id/analytics?from=&to=&interval=.
+// from and to are RFC 3339 times; interval is hour or day.
+func (h *ShareHandler) Analytics(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	linkID, ok := uuidParam(c, "share_link_id")
+	if !ok {
+		return
+	}
+	from, ok := timeQuery(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := timeQuery(c, "to")
+	if !ok {
+		return
+	}
+
+	analytics, err := h.analytics.Analytics(c.Request.Context(), principal.TenantID, listingID, linkID, from, to, c.Query("interval"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewShareLinkAnalyticsResponse(analytics))
+}
+
+// Accesses handles GET
+// /v1/listings/:listing_id/share-links/:share_link_id/accesses, the link's
+// access log newest first.
+func (h *ShareHandler) Accesses(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	linkID, ok := uuidParam(c, "share_link_id")
+	if !ok {
+		return
+	}
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	accesses, err := h.analytics.Accesses(c.Request.Context(), principal.TenantID, listingID, linkID, int32(limit+1), int32(offset))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	hasMore := len(accesses) > limit
+	if hasMore {
+		accesses = accesses[:limit]
+	}
+
+	response.Paginated(c, dto.NewShareLinkAccessResponses(accesses), response.Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: hasMore,
+	})
+}
+
+// Revoke handles DELETE /v1/listings/:listing_id/share-links/:share_link_id.
+func (h *ShareHandler) Revoke(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	linkID, ok := uuidParam(c, "share_link_id")
+	if !ok {
+		return
+	}
+
+	if err := h.shares.Revoke(c.Request.Context(), principal.TenantID, principal.UserID, listingID, linkID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// timeQuery reads an optional RFC 3339 time query parameter.
+func timeQuery(c *gin.Context, name string) (*time.Time, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, name+" must be an RFC 3339 time", nil)
+		return nil, false
+	}
+	return &t, true
+}
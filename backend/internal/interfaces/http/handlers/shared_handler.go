@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	sharing "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/domain"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+)
+
+// shareSessionCookie holds the viewer session of an unlocked share link. It
+// is scoped to the link's path, so each link has its own.
+const shareSessionCookie = "share_session"
+
+// SharedHandler serves listings to share link holders. Its routes are
+// public; the token in the path is the credential, together with a viewer
+// session cookie for gated links.
+type SharedHandler struct {
+	shares   *sharingapp.ShareService
+	proofing *sharingapp.ProofingService
+	photos   *tenantapp.PhotoService
+}
+
+// NewSharedHandler creates a SharedHandler.
+func NewSharedHandler(shares *sharingapp.ShareService, proofing *sharingapp.ProofingService, photos *tenantapp.PhotoService) *SharedHandler {
+	return &SharedHandler{shares: shares, proofing: proofing, photos: photos}
+}
+
+// Unlock handles POST /v1/shared/:token/unlock, setting the viewer session
+// cookie of a gated link.
+func (h *SharedHandler) Unlock(c *gin.Context) {
+	var req dto.UnlockShareRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	session, err := h.shares.Unlock(c.Request.Context(), sharingapp.UnlockInput{
+		Token:    c.Param("token"),
+		Password: req.Password,
+		Email:    req.Email,
+		IP:       c.ClientIP(),
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     shareSessionCookie,
+		Value:    session.Token,
+		Path:     sharePath(c),
+		Expires:  session.ExpiresAt,
+		MaxAge:   int(time.Until(session.ExpiresAt).Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	response.JSON(c, http.StatusOK, dto.NewShareSessionResponse(session))
+}
+
+// View handles GET /v1/shared/:token, counting a view of the link.
+func (h *SharedHandler) View(c *gin.Context) {
+	shared, err := h.shares.Open(c.Request.Context(), c.Param("token"), shareSession(c), shareVisitor(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	photos := make([]dto.SharedPhotoResponse, 0, len(shared.Photos))
+	for i := range shared.Photos {
+		urls, err := h.photos.PublicURLs(c.Request.Context(), &shared.Photos[i])
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		photos = append(photos, dto.NewSharedPhotoResponse(&shared.Photos[i], urls))
+	}
+
+	// Presigned URLs must not outlive their signature in shared caches
+	c.Header("Cache-Control", "private, no-store")
+	response.JSON(c, http.StatusOK, dto.NewSharedListingResponse(shared, photos))
+}
+
+// Photo handles GET /v1/shared/:token/photos/:photo_id, logging a view of
+// the photo without counting one of the link.
+func (h *SharedHandler) Photo(c *gin.Context) {
+	photoID, ok := uuidParam(c, "photo_id")
+	if !ok {
+		return
+	}
+
+	photo, err := h.shares.Photo(c.Request.Context(), c.Param("token"), shareSession(c), photoID, shareVisitor(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	urls, err := h.photos.PublicURLs(c.Request.Context(), photo)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	response.JSON(c, http.StatusOK, dto.NewSharedPhotoResponse(photo, urls))
+}
+
+// Selection handles GET /v1/shared/:token/selection.
+func (h *SharedHandler) Selection(c *gin.Context) {
+	selection, err := h.proofing.Get(c.Request.Context(), c.Param("token"), shareSession(c))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewSelectionResponse(selection))
+}
+
+// SelectPhoto handles PUT /v1/shared/:token/photos/:photo_id/selection.
+// Clearing every mark removes the photo from the selection and responds
+// with no content.
+func (h *SharedHandler) SelectPhoto(c *gin.Context) {
+	photoID, ok := uuidParam(c, "photo_id")
+	if !ok {
+		return
+	}
+
+	var req dto.SelectPhotoRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	selection, err := h.proofing.SelectPhoto(c.Request.Context(), sharingapp.SelectPhotoInput{
+		Token:        c.Param("token"),
+		SessionToken: shareSession(c),
+		PhotoID:      photoID,
+		Favorite:     req.Favorite,
+		Rating:       req.Rating,
+		Comment:      req.Comment,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	if selection == nil {
+		response.NoContent(c)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewPhotoSelectionResponse(selection))
+}
+
+// SubmitSelection handles POST /v1/shared/:token/selection/submit.
+func (h *SharedHandler) SubmitSelection(c *gin.Context) {
+	var req dto.SubmitSelectionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	submission, err := h.proofing.Submit(c.Request.Context(), c.Param("token"), shareSession(c), req.Note)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusCreated, dto.NewSelectionSubmissionResponse(submission))
+}
+
+// shareSession returns the viewer session token sent with the request, if any.
+func shareSession(c *gin.Context) string {
+	token, _ := c.Cookie(shareSessionCookie)
+	return token
+}
+
+// shareVisitor describes who sent the request, for the link's access log.
+// Its IP is gin's ClientIP, which only takes X-Forwarded-For from the
+// TRUSTED_PROXIES the engine is set up with, so visitors cannot pick it.
+func shareVisitor(c *gin.Context) sharing.Visitor {
+	return sharing.Visitor{IP: c.ClientIP(), UserAgent: c.Request.UserAgent()}
+}
+
+// sharePath is the URL path the routes of the requested share link live under.
+func sharePath(c *gin.Context) string {
+	return "/v1/shared/" + url.PathEscape(c.Param("token"))
+}
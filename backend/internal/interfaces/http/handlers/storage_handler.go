@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+)
+
+// StorageHandler serves the presigned URLs of the local storage backend.
+// With S3 the URLs point at the bucket and this handler is not mounted.
+type StorageHandler struct {
+	backend *storage.LocalBackend
+}
+
+// NewStorageHandler creates a StorageHandler.
+func NewStorageHandler(backend *storage.LocalBackend) *StorageHandler {
+	return &StorageHandler{backend: backend}
+}
+
+// Get handles GET /storage/*key.
+func (h *StorageHandler) Get(c *gin.Context) {
+	key, ok := h.verify(c)
+	if !ok {
+		return
+	}
+
+	body, obj, err := h.backend.Get(c.Request.Context(), key)
+	if err != nil {
+		h.respondStorageError(c, err)
+		return
+	}
+	defer body.Close()
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.DataFromReader(http.StatusOK, obj.Size, obj.ContentType, body, nil)
+}
+
+// Put handles PUT /storage/*key. The Content-Type and Content-Length must
+// be the ones the URL was signed for.
+func (h *StorageHandler) Put(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	contentType := c.GetHeader("Content-Type")
+	size := c.Request.ContentLength
+	if err := h.backend.VerifyPut(key, contentType, size, c.Request.URL.Query()); err != nil {
+		response.Error(c, http.StatusForbidden, response.CodeForbidden, err.Error(), nil)
+		return
+	}
+
+	// The signed size was checked against the plan when the URL was issued
+	body := http.MaxBytesReader(c.Writer, c.Request.Body, size)
+	if _, err := h.backend.Put(c.Request.Context(), key, body, contentType); err != nil {
+		h.respondStorageError(c, err)
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *StorageHandler) verify(c *gin.Context) (string, bool) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	if err := h.backend.Verify(c.Request.Method, key, c.Request.URL.Query()); err != nil {
+		response.Error(c, http.StatusForbidden, response.CodeForbidden, err.Error(), nil)
+		return "", false
+	}
+	return key, true
+}
+
+func (h *StorageHandler) respondStorageError(c *gin.Context, err error) {
+	if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		response.Error(c, http.StatusNotFound, response.CodeNotFound, "object not found", nil)
+		return
+	}
+	respondError(c, err)
+}
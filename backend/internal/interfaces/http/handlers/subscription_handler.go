@@ -1 +1,45 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"net/http"
+
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// SubscriptionHandler serves plan and subscription endpoints.
+type SubscriptionHandler struct {
+	subscriptions *subscriptionapp.SubscriptionService
+}
+
+// NewSubscriptionHandler creates a SubscriptionHandler.
+func NewSubscriptionHandler(subscriptions *subscriptionapp.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{subscriptions: subscriptions}
+}
+
+// ListPlans handles GET /v1/plans.
+func (h *SubscriptionHandler) ListPlans(c *gin.Context) {
+	plans, err := h.subscriptions.ListPlans(c.Request.Context())
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewPlanResponses(plans))
+}
+
+// Current handles GET /v1/subscription.
+func (h *SubscriptionHandler) Current(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	current, err := h.subscriptions.Current(c.Request.Context(), principal.TenantID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewSubscriptionResponse(current))
+}
@@ -1 +1,151 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// watermarkImageField is the multipart field carrying the watermark logo.
+const watermarkImageField = "image"
+
+// TenantHandler serves the tenant profile, settings and usage endpoints.
+// AuthMiddleware has already checked that :tenant_id is the caller's tenant.
+type TenantHandler struct {
+	tenants *tenantapp.TenantService
+}
+
+// NewTenantHandler creates a TenantHandler.
+func NewTenantHandler(tenants *tenantapp.TenantService) *TenantHandler {
+	return &TenantHandler{tenants: tenants}
+}
+
+// Get handles GET /v1/tenants/:tenant_id.
+func (h *TenantHandler) Get(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	t, err := h.tenants.Get(c.Request.Context(), principal.TenantID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewTenantResponse(t))
+}
+
+// Update handles PATCH /v1/tenants/:tenant_id.
+func (h *TenantHandler) Update(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	var req dto.UpdateTenantRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	t, err := h.tenants.Rename(c.Request.Context(), principal.TenantID, principal.UserID, req.Name)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewTenantResponse(t))
+}
+
+// GetSettings handles GET /v1/tenants/:tenant_id/settings.
+func (h *TenantHandler) GetSettings(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	settings, err := h.tenants.GetSettings(c.Request.Context(), principal.TenantID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewSettingsResponse(settings))
+}
+
+// UpdateSettings handles PATCH /v1/tenants/:tenant_id/settings.
+func (h *TenantHandler) UpdateSettings(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	var req dto.UpdateSettingsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	settings, err := h.tenants.GetSettings(c.Request.Context(), principal.TenantID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	req.Apply(settings)
+
+	settings, err = h.tenants.UpdateSettings(c.Request.Context(), principal.UserID, settings)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewSettingsResponse(settings))
+}
+
+// SetWatermarkImage handles PUT /v1/tenants/:tenant_id/settings/watermark-image.
+// The logo is sent as the "image" field of a multipart form.
+func (h *TenantHandler) SetWatermarkImage(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, tenant.MaxWatermarkImageBytes+1<<20)
+	file, _, err := c.Request.FormFile(watermarkImageField)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			respondError(c, err)
+			return
+		}
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "watermark image is required", map[string]any{
+			"field": watermarkImageField,
+		})
+		return
+	}
+	defer file.Close()
+
+	settings, err := h.tenants.SetWatermarkImage(c.Request.Context(), principal.TenantID, principal.UserID, file)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewSettingsResponse(settings))
+}
+
+// RemoveWatermarkImage handles DELETE /v1/tenants/:tenant_id/settings/watermark-image.
+func (h *TenantHandler) RemoveWatermarkImage(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	settings, err := h.tenants.RemoveWatermarkImage(c.Request.Context(), principal.TenantID, principal.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewSettingsResponse(settings))
+}
+
+// GetUsage handles GET /v1/tenants/:tenant_id/usage.
+func (h *TenantHandler) GetUsage(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	usage, err := h.tenants.GetStorageUsage(c.Request.Context(), principal.TenantID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewStorageUsageResponse(usage))
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// TrashHandler serves the tenant's deleted listings and photos.
+type TrashHandler struct {
+	trash *tenantapp.TrashService
+}
+
+// NewTrashHandler creates a TrashHandler.
+func NewTrashHandler(trash *tenantapp.TrashService) *TrashHandler {
+	return &TrashHandler{trash: trash}
+}
+
+// ListListings handles GET /v1/trash/listings.
+func (h *TrashHandler) ListListings(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	// Fetch one extra row to learn whether another page exists
+	listings, err := h.trash.ListListings(c.Request.Context(), principal.TenantID, int32(limit+1), int32(offset))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	hasMore := len(listings) > limit
+	if hasMore {
+		listings = listings[:limit]
+	}
+
+	response.Paginated(c, dto.NewTrashedListingResponses(listings), response.Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: hasMore,
+	})
+}
+
+// ListPhotos handles GET /v1/trash/photos. Photos of deleted listings are
+// not listed; they come back when their listing is restored.
+func (h *TrashHandler) ListPhotos(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	photos, err := h.trash.ListPhotos(c.Request.Context(), principal.TenantID, int32(limit+1), int32(offset))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	hasMore := len(photos) > limit
+	if hasMore {
+		photos = photos[:limit]
+	}
+
+	out := make([]dto.TrashedPhotoResponse, 0, len(photos))
+	for i := range photos {
+		url, err := h.trash.PreviewURL(c.Request.Context(), &photos[i])
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+		out = append(out, dto.NewTrashedPhotoResponse(&photos[i], url))
+	}
+
+	response.Paginated(c, out, response.Pagination{
+		Limit:   limit,
+		Offset:  offset,
+		HasMore: hasMore,
+	})
+}
+
+// RestoreListing handles POST /v1/trash/listings/:listing_id/restore.
+func (h *TrashHandler) RestoreListing(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+
+	listing, err := h.trash.RestoreListing(c.Request.Context(), principal.TenantID, principal.UserID, listingID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewListingResponse(listing))
+}
+
+// RestorePhoto handles POST /v1/trash/photos/:photo_id/restore. The photo's
+// listing must not be deleted.
+func (h *TrashHandler) RestorePhoto(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	photoID, ok := uuidParam(c, "photo_id")
+	if !ok {
+		return
+	}
+
+	if _, err := h.trash.RestorePhoto(c.Request.Context(), principal.TenantID, photoID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// tus 1.0 protocol constants.
+const (
+	tusVersion     = "1.0.0"
+	tusExtensions  = "creation,termination,expiration"
+	tusContentType = "application/offset+octet-stream"
+)
+
+// errMalformedMetadata rejects an Upload-Metadata header with an empty or
+// repeated key.
+var errMalformedMetadata = errors.New("malformed upload metadata")
+
+// UploadHandler serves resumable photo uploads over the tus 1.0 protocol
+// (https://tus.io/protocols/resumable-upload), with the creation,
+// termination and expiration extensions.
+type UploadHandler struct {
+	uploads *tenantapp.UploadService
+}
+
+// NewUploadHandler creates an UploadHandler.
+func NewUploadHandler(uploads *tenantapp.UploadService) *UploadHandler {
+	return &UploadHandler{uploads: uploads}
+}
+
+// Options handles OPTIONS /v1/uploads, advertising the supported protocol.
+func (h *UploadHandler) Options(c *gin.Context) {
+	c.Header("Tus-Resumable", tusVersion)
+	c.Header("Tus-Version", tusVersion)
+	c.Header("Tus-Extension", tusExtensions)
+	c.Header("Tus-Max-Size", strconv.Itoa(tenant.MaxPhotoSizeBytes))
+	response.NoContent(c)
+}
+
+// Create handles POST /v1/listings/:listing_id/uploads. The Upload-Length
+// header declares the photo's size; Upload-Metadata may name it.
+func (h *UploadHandler) Create(c *gin.Context) {
+	if !requireTus(c) {
+		return
+	}
+	principal := middleware.MustPrincipal(c)
+
+	listingID, ok := uuidParam(c, "listing_id")
+	if !ok {
+		return
+	}
+	length, ok := int64Header(c, "Upload-Length")
+	if !ok {
+		return
+	}
+	metadata, err := parseUploadMetadata(c.GetHeader("Upload-Metadata"))
+	if err != nil {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, "malformed Upload-Metadata header", nil)
+		return
+	}
+
+	upload, err := h.uploads.Create(c.Request.Context(), tenantapp.CreateUploadInput{
+		TenantID:  principal.TenantID,
+		ListingID: listingID,
+		UserID:    principal.UserID,
+		Length:    length,
+		Metadata:  metadata,
+	})
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Header("Location", "/v1/uploads/"+upload.ID.String())
+	setUploadHeaders(c, upload)
+	response.JSON(c, http.StatusCreated, dto.NewUploadResponse(upload))
+}
+
+// Head handles HEAD /v1/uploads/:upload_id, reporting how many bytes have
+// arrived so an interrupted client can resume.
+func (h *UploadHandler) Head(c *gin.Context) {
+	if !requireTus(c) {
+		return
+	}
+	upload, ok := h.load(c)
+	if !ok {
+		return
+	}
+
+	setUploadHeaders(c, upload)
+	c.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+	if len(upload.Metadata) > 0 {
+		c.Header("Upload-Metadata", formatUploadMetadata(upload.Metadata))
+	}
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+}
+
+// Get handles GET /v1/uploads/:upload_id, returning the upload's progress
+// and, once complete, the photo it became.
+func (h *UploadHandler) Get(c *gin.Context) {
+	upload, ok := h.load(c)
+	if !ok {
+		return
+	}
+	response.JSON(c, http.StatusOK, dto.NewUploadResponse(upload))
+}
+
+// Patch handles PATCH /v1/uploads/:upload_id. The body holds the bytes from
+// Upload-Offset onwards; the last PATCH turns the upload into a photo.
+func (h *UploadHandler) Patch(c *gin.Context) {
+	if !requireTus(c) {
+		return
+	}
+	principal := middleware.MustPrincipal(c)
+
+	uploadID, ok := uuidParam(c, "upload_id")
+	if !ok {
+		return
+	}
+	if c.ContentType() != tusContentType {
+		response.Error(c, http.StatusUnsupportedMediaType, response.CodeUnsupportedMedia, "request must be "+tusContentType, nil)
+		return
+	}
+	offset, ok := int64Header(c, "Upload-Offset")
+	if !ok {
+		return
+	}
+
+	upload, err := h.uploads.Append(c.Request.Context(), principal.TenantID, principal.UserID, uploadID, offset, c.Request.Body)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	setUploadHeaders(c, upload)
+	response.NoContent(c)
+}
+
+// Terminate handles DELETE /v1/uploads/:upload_id, discarding the bytes
+// received so far.
+func (h *UploadHandler) Terminate(c *gin.Context) {
+	if !requireTus(c) {
+		return
+	}
+	principal := middleware.MustPrincipal(c)
+
+	uploadID, ok := uuidParam(c, "upload_id")
+	if !ok {
+		return
+	}
+
+	if err := h.uploads.Terminate(c.Request.Context(), principal.TenantID, principal.UserID, uploadID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// load returns the caller's upload named by the upload_id parameter.
+func (h *UploadHandler) load(c *gin.Context) (*tenant.Upload, bool) {
+	principal := middleware.MustPrincipal(c)
+
+	uploadID, ok := uuidParam(c, "upload_id")
+	if !ok {
+		return nil, false
+	}
+
+	upload, err := h.uploads.Get(c.Request.Context(), principal.TenantID, principal.UserID, uploadID)
+	if err != nil {
+		respondError(c, err)
+		return nil, false
+	}
+	return upload, true
+}
+
+// requireTus sets the protocol version on the response and rejects requests
+// made for another version.
+func requireTus(c *gin.Context) bool {
+	c.Header("Tus-Resumable", tusVersion)
+	if c.GetHeader("Tus-Resumable") != tusVersion {
+		c.Header("Tus-Version", tusVersion)
+		response.Error(c, http.StatusPreconditionFailed, response.CodeValidation, "unsupported tus version", map[string]any{
+			"supported": tusVersion,
+		})
+		return false
+	}
+	return true
+}
+
+// setUploadHeaders reports the upload's offset and, while it can still be
+// resumed, when it expires.
+func setUploadHeaders(c *gin.Context, upload *tenant.Upload) {
+	c.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+	if !upload.Completed() {
+		c.Header("Upload-Expires", upload.ExpiresAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// int64Header reads a required non-negative integer header.
+func int64Header(c *gin.Context, name string) (int64, bool) {
+	n, err := strconv.ParseInt(c.GetHeader(name), 10, 64)
+	if err != nil || n < 0 {
+		response.Error(c, http.StatusUnprocessableEntity, response.CodeValidation, name+" must be a non-negative integer", nil)
+		return 0, false
+	}
+	return n, true
+}
+
+// parseUploadMetadata decodes a tus Upload-Metadata header: comma-separated
+// pairs of a key and an optional base64 value.
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+	for pair := range strings.SplitSeq(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if _, exists := metadata[key]; key == "" || exists {
+			return nil, errMalformedMetadata
+		}
+		value, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, err
+		}
+		metadata[key] = string(value)
+	}
+	return metadata, nil
+}
+
+// formatUploadMetadata encodes metadata as a tus Upload-Metadata header.
+func formatUploadMetadata(metadata map[string]string) string {
+	pairs := make([]string, 0, len(metadata))
+	for key, value := range metadata {
+		if value == "" {
+			pairs = append(pairs, key)
+			continue
+		}
+		pairs = append(pairs, key+" "+base64.StdEncoding.EncodeToString([]byte(value)))
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
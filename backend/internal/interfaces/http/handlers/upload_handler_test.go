@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	authapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/application"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/database/postgres/postgrestest"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// uploadServer routes tus requests of one user to an UploadHandler.
+type uploadServer struct {
+	engine    *gin.Engine
+	principal *auth.Principal
+	listingID uuid.UUID
+}
+
+// newUploadServer serves uploads into a listing of a fresh tenant when db is
+// set. Without db only requests rejected before the database is used work.
+func newUploadServer(t *testing.T, db *sql.DB) *uploadServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	s := &uploadServer{
+		principal: &auth.Principal{TenantID: uuid.New(), UserID: uuid.New()},
+		listingID: uuid.New(),
+	}
+	store, err := storage.NewLocalBackend(t.TempDir(), "http://localhost/storage", []byte("test-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != nil {
+		s.createListing(t, db)
+	}
+
+	h := NewUploadHandler(tenantapp.NewUploadService(db, store))
+	s.engine = gin.New()
+	s.engine.Use(func(c *gin.Context) {
+		// As set by middleware.AuthMiddleware
+		c.Set("principal", s.principal)
+	})
+	s.engine.POST("/v1/listings/:listing_id/uploads", h.Create)
+	s.engine.HEAD("/v1/uploads/:upload_id", h.Head)
+	s.engine.GET("/v1/uploads/:upload_id", h.Get)
+	s.engine.PATCH("/v1/uploads/:upload_id", h.Patch)
+	return s
+}
+
+func (s *uploadServer) createListing(t *testing.T, db *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	signup, err := authapp.NewSignupService(db).Signup(ctx, authapp.SignupInput{
+		TenantName: "test " + suffix,
+		Username:   "user_" + suffix,
+		Email:      "user_" + suffix + "@example.com",
+		Password:   "Correct-Horse-42",
+	})
+	if err != nil {
+		t.Fatalf("signup: %v", err)
+	}
+	listing, err := tenantrepo.NewListingRepository(db).Create(ctx, &tenant.Listing{
+		TenantID:   signup.TenantID,
+		UserID:     signup.UserID,
+		Title:      "Listing " + suffix,
+		Status:     tenant.ListingStatusDraft,
+		Visibility: tenant.VisibilityPrivate,
+	})
+	if err != nil {
+		t.Fatalf("create listing: %v", err)
+	}
+	s.principal = &auth.Principal{TenantID: signup.TenantID, UserID: signup.UserID, Roles: []string{signup.Role}}
+	s.listingID = listing.ID
+}
+
+func (s *uploadServer) do(method, path string, body io.Reader, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, body)
+	req.Header.Set("Tus-Resumable", tusVersion)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	rec := httptest.NewRecorder()
+	s.engine.ServeHTTP(rec, req)
+	return rec
+}
+
+func (s *uploadServer) create(length string) *httptest.ResponseRecorder {
+	return s.do(http.MethodPost, "/v1/listings/"+s.listingID.String()+"/uploads", nil, map[string]string{
+		"Upload-Length":   length,
+		"Upload-Metadata": "filename " + base64.StdEncoding.EncodeToString([]byte("photo.png")),
+	})
+}
+
+func (s *uploadServer) patch(location string, offset int, body io.Reader) *httptest.ResponseRecorder {
+	return s.do(http.MethodPatch, location, body, map[string]string{
+		"Content-Type":  tusContentType,
+		"Upload-Offset": strconv.Itoa(offset),
+	})
+}
+
+// mustCreate starts an upload of length bytes and returns its location.
+func (s *uploadServer) mustCreate(t *testing.T, length int) string {
+	t.Helper()
+	rec := s.create(strconv.Itoa(length))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, body %s", rec.Code, rec.Body)
+	}
+	return rec.Header().Get("Location")
+}
+
+func (s *uploadServer) get(t *testing.T, location string) dto.UploadResponse {
+	t.Helper()
+	rec := s.do(http.MethodGet, location, nil, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET status = %d, body %s", rec.Code, rec.Body)
+	}
+	var body struct {
+		Data dto.UploadResponse `json:"data"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	return body.Data
+}
+
+func testPNG(t *testing.T) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, 32, 32))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i)
+	}
+	img.Set(0, 0, color.White)
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// interruptedBody yields data, then fails as a dropped connection would.
+type interruptedBody struct {
+	data *bytes.Reader
+}
+
+func (b *interruptedBody) Read(p []byte) (int, error) {
+	n, _ := b.data.Read(p)
+	if n == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return n, nil
+}
+
+func TestUploadCreateRejectsUploadLength(t *testing.T) {
+	s := newUploadServer(t, nil)
+
+	for _, length := range []string{"", "abc", "-1", "1.5", "0"} {
+		rec := s.create(length)
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("Upload-Length %q: status = %d, want %d", length, rec.Code, http.StatusUnprocessableEntity)
+		}
+	}
+}
+
+func TestUploadPatchRejectsRequest(t *testing.T) {
+	s := newUploadServer(t, nil)
+	location := "/v1/uploads/" + uuid.NewString()
+
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		wantStatus int
+	}{
+		{
+			name:       "wrong tus version",
+			headers:    map[string]string{"Tus-Resumable": "0.2.2", "Content-Type": tusContentType, "Upload-Offset": "0"},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:       "wrong content type",
+			headers:    map[string]string{"Content-Type": "image/png", "Upload-Offset": "0"},
+			wantStatus: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:       "missing offset",
+			headers:    map[string]string{"Content-Type": tusContentType},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "negative offset",
+			headers:    map[string]string{"Content-Type": tusContentType, "Upload-Offset": "-1"},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := s.do(http.MethodPatch, location, strings.NewReader("data"), tt.headers)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestUploadCreateOverPlanLimit(t *testing.T) {
+	s := newUploadServer(t, postgrestest.Open(t))
+
+	// The free plan accepts uploads well below the largest photo size
+	rec := s.create(strconv.Itoa(tenant.MaxPhotoSizeBytes))
+	if rec.Code != http.StatusForbidden || !strings.Contains(rec.Body.String(), response.CodeQuotaExceeded) {
+		t.Errorf("status = %d, body %s, want %d %s", rec.Code, rec.Body, http.StatusForbidden, response.CodeQuotaExceeded)
+	}
+}
+
+func TestUploadPatchOffsetMismatch(t *testing.T) {
+	s := newUploadServer(t, postgrestest.Open(t))
+	photo := testPNG(t)
+	location := s.mustCreate(t, len(photo))
+
+	rec := s.patch(location, 1, bytes.NewReader(photo[1:]))
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if rec := s.do(http.MethodHead, location, nil, nil); rec.Header().Get("Upload-Offset") != "0" {
+		t.Errorf("Upload-Offset = %q after a rejected PATCH, want 0", rec.Header().Get("Upload-Offset"))
+	}
+}
+
+func TestUploadPatchBeyondUploadLength(t *testing.T) {
+	s := newUploadServer(t, postgrestest.Open(t))
+	photo := testPNG(t)
+	location := s.mustCreate(t, len(photo)-1)
+
+	rec := s.patch(location, 0, bytes.NewReader(photo))
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+	if rec := s.do(http.MethodHead, location, nil, nil); rec.Header().Get("Upload-Offset") != "0" {
+		t.Errorf("Upload-Offset = %q after an oversized PATCH, want 0", rec.Header().Get("Upload-Offset"))
+	}
+}
+
+func TestUploadResumesAfterInterruptedPatch(t *testing.T) {
+	db := postgrestest.Open(t)
+	s := newUploadServer(t, db)
+	photo := testPNG(t)
+	half := len(photo) / 2
+	location := s.mustCreate(t, len(photo))
+
+	rec := s.patch(location, 0, bytes.NewReader(photo[:half]))
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Upload-Offset") != strconv.Itoa(half) {
+		t.Fatalf("first PATCH = %d with offset %q, want 204 with %d", rec.Code, rec.Header().Get("Upload-Offset"), half)
+	}
+
+	// The connection drops partway through the second half; none of it is kept
+	rec = s.patch(location, half, &interruptedBody{data: bytes.NewReader(photo[half : half+10])})
+	if rec.Code < http.StatusBadRequest {
+		t.Fatalf("interrupted PATCH status = %d, want an error", rec.Code)
+	}
+	rec = s.do(http.MethodHead, location, nil, nil)
+	if rec.Code != http.StatusOK || rec.Header().Get("Upload-Offset") != strconv.Itoa(half) {
+		t.Fatalf("HEAD = %d with offset %q, want 200 with %d", rec.Code, rec.Header().Get("Upload-Offset"), half)
+	}
+
+	rec = s.patch(location, half, bytes.NewReader(photo[half:]))
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Upload-Offset") != strconv.Itoa(len(photo)) {
+		t.Fatalf("resumed PATCH = %d with offset %q, want 204 with %d", rec.Code, rec.Header().Get("Upload-Offset"), len(photo))
+	}
+	if upload := s.get(t, location); upload.PhotoID == nil {
+		t.Error("resumed upload did not become a photo")
+	}
+}
+
+func TestUploadCompletionRegistersPhoto(t *testing.T) {
+	db := postgrestest.Open(t)
+	s := newUploadServer(t, db)
+	photo := testPNG(t)
+	location := s.mustCreate(t, len(photo))
+
+	rec := s.patch(location, 0, bytes.NewReader(photo))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("PATCH status = %d, body %s", rec.Code, rec.Body)
+	}
+	if rec.Header().Get("Upload-Expires") != "" {
+		t.Error("completed upload still advertises Upload-Expires")
+	}
+
+	upload := s.get(t, location)
+	if upload.PhotoID == nil || upload.CompletedAt == nil {
+		t.Fatalf("upload = %+v, want a completed upload with a photo", upload)
+	}
+	photos, err := tenantrepo.NewPhotoRepository(db).ListByListing(context.Background(), s.principal.TenantID, s.listingID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(photos) != 1 || photos[0].ID != *upload.PhotoID {
+		t.Fatalf("listing photos = %+v, want the uploaded photo", photos)
+	}
+	if photos[0].Filename != "photo.png" || photos[0].SizeBytes != int64(len(photo)) {
+		t.Errorf("photo = %q of %d bytes, want photo.png of %d", photos[0].Filename, photos[0].SizeBytes, len(photo))
+	}
+
+	if rec := s.patch(location, len(photo), bytes.NewReader(nil)); rec.Code != http.StatusConflict {
+		t.Errorf("PATCH of a completed upload status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/dto"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// UserHandler serves tenant membership endpoints.
+type UserHandler struct {
+	tenants *tenantapp.TenantService
+}
+
+// NewUserHandler creates a UserHandler.
+func NewUserHandler(tenants *tenantapp.TenantService) *UserHandler {
+	return &UserHandler{tenants: tenants}
+}
+
+// List handles GET /v1/users.
+func (h *UserHandler) List(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	members, err := h.tenants.ListMembers(c.Request.Context(), principal.TenantID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	out := make([]dto.UserResponse, 0, len(members))
+	for i := range members {
+		out = append(out, dto.NewUserResponse(principal.TenantID, &members[i]))
+	}
+	response.JSON(c, http.StatusOK, out)
+}
+
+// Me handles GET /v1/users/me.
+func (h *UserHandler) Me(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	member, err := h.tenants.GetMember(c.Request.Context(), principal.TenantID, principal.UserID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewUserResponse(principal.TenantID, member))
+}
+
+// UpdateRole handles PATCH /v1/users/:user_id/role.
+func (h *UserHandler) UpdateRole(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	userID, ok := uuidParam(c, "user_id")
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateRoleRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	member, err := h.tenants.ChangeMemberRole(c.Request.Context(), principal.TenantID, principal.UserID, userID, req.Role)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.JSON(c, http.StatusOK, dto.NewUserResponse(principal.TenantID, member))
+}
+
+// Remove handles DELETE /v1/users/:user_id.
+func (h *UserHandler) Remove(c *gin.Context) {
+	principal := middleware.MustPrincipal(c)
+
+	userID, ok := uuidParam(c, "user_id")
+	if !ok {
+		return
+	}
+
+	if err := h.tenants.RemoveMember(c.Request.Context(), principal.TenantID, principal.UserID, userID); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
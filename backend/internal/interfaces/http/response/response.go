@@ -0,0 +1,92 @@
+package response
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Error codes documented in docs/api.md.
+const (
+	CodeUnauthorized      = "UNAUTHORIZED"
+	CodeForbidden         = "FORBIDDEN"
+	CodeNotFound          = "NOT_FOUND"
+	CodeConflict          = "CONFLICT"
+	CodeValidation        = "VALIDATION_ERROR"
+	CodePayloadTooLarge   = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMedia  = "UNSUPPORTED_MEDIA_TYPE"
+	CodeRateLimitExceeded = "RATE_LIMIT_EXCEEDED"
+	CodeQuotaExceeded     = "QUOTA_EXCEEDED"
+	CodeInternal          = "INTERNAL_ERROR"
+)
+
+// RequestIDKey is the gin context key holding the current request ID.
+const RequestIDKey = "request_id"
+
+// Meta is the metadata half of a success envelope.
+type Meta struct {
+	RequestID  string      `json:"request_id"`
+	Timestamp  time.Time   `json:"timestamp"`
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+// Pagination describes the page returned by a list endpoint. Offset-paged
+// endpoints set Offset; cursor-paged endpoints set NextCursor.
+type Pagination struct {
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// ErrorBody is the error half of the API envelope.
+type ErrorBody struct {
+	Code      string         `json:"code"`
+	Message   string         `json:"message"`
+	Details   map[string]any `json:"details,omitempty"`
+	RequestID string         `json:"request_id"`
+	Timestamp time.Time      `json:"timestamp"`
+}
+
+// Error aborts the request with an error envelope.
+func Error(c *gin.Context, status int, code, message string, details map[string]any) {
+	c.AbortWithStatusJSON(status, gin.H{
+		"error": ErrorBody{
+			Code:      code,
+			Message:   message,
+			Details:   details,
+			RequestID: c.GetString(RequestIDKey),
+			Timestamp: time.Now().UTC(),
+		},
+	})
+}
+
+// JSON writes data in a success envelope.
+func JSON(c *gin.Context, status int, data any) {
+	c.JSON(status, gin.H{
+		"data": data,
+		"meta": newMeta(c, nil),
+	})
+}
+
+// Paginated writes a page of results in a success envelope.
+func Paginated(c *gin.Context, data any, page Pagination) {
+	c.JSON(http.StatusOK, gin.H{
+		"data": data,
+		"meta": newMeta(c, &page),
+	})
+}
+
+// NoContent writes an empty 204 response.
+func NoContent(c *gin.Context) {
+	c.Status(http.StatusNoContent)
+}
+
+func newMeta(c *gin.Context, page *Pagination) Meta {
+	return Meta{
+		RequestID:  c.GetString(RequestIDKey),
+		Timestamp:  time.Now().UTC(),
+		Pagination: page,
+	}
+}
@@ -1,41 +1,216 @@
 package http
 
 import (
-	"github.com/gin-gonic/gin"
+	"fmt"
+	"strings"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
 	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/config"
-	// "github.com/EnockYator/saas-photo-listing-platform/backend/internal/http/handlers"
-	// "github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	auditapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/audit/application"
+	authapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/application"
+	authdomain "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	authrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/infrastructure/repository"
+	notificationapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/notification/application"
+	sharingapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/sharing/application"
+	subscriptionapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/subscription/application"
+	tenantapp "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/application"
+	tenantrepo "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/infrastructure/repository"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/infrastructure/storage/provider"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/handlers"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/middleware"
+	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 
 	_ "github.com/EnockYator/saas-photo-listing-platform/backend/internal/docs"
-	ginSwagger "github.com/swaggo/gin-swagger"
 	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func NewRouter(cfg *config.Config, db *gorm.DB) *gin.Engine {
-	r := gin.Default()
+// NewRouter wires the application services into the versioned HTTP API.
+func NewRouter(cfg *config.Config, db *gorm.DB) (*gin.Engine, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB from gorm: %w", err)
+	}
+
+	store, err := provider.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up storage: %w", err)
+	}
+
+	tokens := auth.NewTokenService(cfg, authrepo.NewSessionRepository(sqlDB))
+	tenantService := tenantapp.NewTenantService(sqlDB, store)
+	subscriptionService := subscriptionapp.NewSubscriptionService(sqlDB)
+
+	healthHandler := handlers.NewHealthHandler(sqlDB)
+	authHandler := handlers.NewAuthHandler(authapp.NewSignupService(sqlDB), authapp.NewLoginService(sqlDB, tokens))
+	tenantHandler := handlers.NewTenantHandler(tenantService)
+	userHandler := handlers.NewUserHandler(tenantService)
+	listingHandler := handlers.NewListingHandler(tenantapp.NewListingService(sqlDB))
+	photoService := tenantapp.NewPhotoService(sqlDB, store)
+	photoHandler := handlers.NewPhotoHandler(photoService, tenantapp.NewDirectUploadService(sqlDB, store))
+	uploadHandler := handlers.NewUploadHandler(tenantapp.NewUploadService(sqlDB, store))
+	trashHandler := handlers.NewTrashHandler(tenantapp.NewTrashService(sqlDB, store))
+	shareService := sharingapp.NewShareService(sqlDB)
+	shareHandler := handlers.NewShareHandler(shareService, sharingapp.NewAnalyticsService(sqlDB))
+	proofingService := sharingapp.NewProofingService(sqlDB, shareService)
+	selectionHandler := handlers.NewSelectionHandler(proofingService)
+	archiveService := tenantapp.NewArchiveService(sqlDB, store)
+	downloadHandler := handlers.NewDownloadHandler(sharingapp.NewDownloadService(sqlDB, shareService, proofingService, archiveService), archiveService)
+	subscriptionHandler := handlers.NewSubscriptionHandler(subscriptionService)
+	notificationHandler := handlers.NewNotificationHandler(notificationapp.NewNotificationService(sqlDB))
+	auditHandler := handlers.NewAuditHandler(auditapp.NewEventReader(sqlDB))
+	sharedHandler := handlers.NewSharedHandler(shareService, proofingService, photoService)
+
+	r, err := newEngine(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Global middleware
+	r.Use(middleware.RequestID())
+	r.Use(middleware.Logger())
+	r.Use(middleware.Recovery())
+	r.Use(middleware.CORSMiddleware(cfg.CORSAllowedOrigins))
 
 	// Swagger documentation route
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/health", healthHandler.Health)
+
+	// The local backend's presigned URLs are served by the API itself
+	if local, ok := store.(*storage.LocalBackend); ok && strings.HasPrefix(cfg.StoragePublicURL, "/") {
+		storageHandler := handlers.NewStorageHandler(local)
+		r.GET(cfg.StoragePublicURL+"/*key", storageHandler.Get)
+		r.PUT(cfg.StoragePublicURL+"/*key", storageHandler.Put)
+	}
+
+	v1 := r.Group("/v1")
+
+	// Public routes
+	authGroup := v1.Group("/auth")
+	{
+		authGroup.POST("/signup", authHandler.Signup)
+		authGroup.POST("/login", authHandler.Login)
+		authGroup.POST("/refresh", authHandler.Refresh)
+		authGroup.POST("/logout", authHandler.Logout)
+	}
+	v1.GET("/plans", subscriptionHandler.ListPlans)
+	v1.OPTIONS("/uploads", uploadHandler.Options)
+
+	// Share link holders; the token is the credential, plus a viewer
+	// session cookie once a gated link is unlocked
+	sharedGroup := v1.Group("/shared/:token")
+	{
+		sharedGroup.GET("", sharedHandler.View)
+		sharedGroup.POST("/unlock", sharedHandler.Unlock)
+		sharedGroup.GET("/selection", sharedHandler.Selection)
+		sharedGroup.POST("/selection/submit", sharedHandler.SubmitSelection)
+		sharedGroup.GET("/photos/:photo_id", sharedHandler.Photo)
+		sharedGroup.PUT("/photos/:photo_id/selection", sharedHandler.SelectPhoto)
+		sharedGroup.GET("/download", downloadHandler.Shared)
+		sharedGroup.GET("/archives/:archive_id", downloadHandler.SharedArchive)
+	}
+
+	// Authenticated routes
+	api := v1.Group("", middleware.AuthMiddleware(tokens, tenantrepo.NewTenantRepository(sqlDB)))
+
+	tenantGroup := api.Group("/tenants/:tenant_id")
+	{
+		tenantGroup.GET("", middleware.RequirePermission(authdomain.PermTenantRead), tenantHandler.Get)
+		tenantGroup.PATCH("", middleware.RequirePermission(authdomain.PermTenantManage), tenantHandler.Update)
+		tenantGroup.GET("/settings", middleware.RequirePermission(authdomain.PermTenantRead), tenantHandler.GetSettings)
+		tenantGroup.PATCH("/settings", middleware.RequirePermission(authdomain.PermTenantManage), tenantHandler.UpdateSettings)
+		tenantGroup.PUT("/settings/watermark-image", middleware.RequirePermission(authdomain.PermTenantManage), tenantHandler.SetWatermarkImage)
+		tenantGroup.DELETE("/settings/watermark-image", middleware.RequirePermission(authdomain.PermTenantManage), tenantHandler.RemoveWatermarkImage)
+		tenantGroup.GET("/usage", middleware.RequirePermission(authdomain.PermTenantRead), tenantHandler.GetUsage)
+	}
+
+	userGroup := api.Group("/users")
+	{
+		userGroup.GET("", middleware.RequirePermission(authdomain.PermUserRead), userHandler.List)
+		userGroup.GET("/me", userHandler.Me)
+		userGroup.PATCH("/:user_id/role", middleware.RequirePermission(authdomain.PermUserManage), userHandler.UpdateRole)
+		userGroup.DELETE("/:user_id", middleware.RequirePermission(authdomain.PermUserManage), userHandler.Remove)
+	}
+
+	listingGroup := api.Group("/listings")
+	{
+		listingGroup.GET("", middleware.RequirePermission(authdomain.PermListingRead), listingHandler.List)
+		listingGroup.POST("", middleware.RequirePermission(authdomain.PermListingCreate), listingHandler.Create)
+		listingGroup.GET("/:listing_id", middleware.RequirePermission(authdomain.PermListingRead), listingHandler.Get)
+		listingGroup.PATCH("/:listing_id", middleware.RequirePermission(authdomain.PermListingUpdate), listingHandler.Update)
+		listingGroup.DELETE("/:listing_id", middleware.RequirePermission(authdomain.PermListingDelete), listingHandler.Delete)
+		listingGroup.POST("/:listing_id/publish", middleware.RequirePermission(authdomain.PermListingPublish), listingHandler.Publish)
+		listingGroup.POST("/:listing_id/unpublish", middleware.RequirePermission(authdomain.PermListingPublish), listingHandler.Unpublish)
+		listingGroup.PUT("/:listing_id/status", middleware.RequirePermission(authdomain.PermListingPublish), listingHandler.Transition)
+		listingGroup.PUT("/:listing_id/schedule", middleware.RequirePermission(authdomain.PermListingPublish), listingHandler.Schedule)
+
+		listingGroup.GET("/:listing_id/photos", middleware.RequirePermission(authdomain.PermPhotoRead), photoHandler.List)
+		listingGroup.POST("/:listing_id/photos", middleware.RequirePermission(authdomain.PermPhotoUpload), photoHandler.Upload)
+		listingGroup.POST("/:listing_id/photos/reservations", middleware.RequirePermission(authdomain.PermPhotoUpload), photoHandler.ReserveUpload)
+		listingGroup.POST("/:listing_id/photos/reservations/:reservation_id/complete", middleware.RequirePermission(authdomain.PermPhotoUpload), photoHandler.CompleteUpload)
+		listingGroup.PUT("/:listing_id/photos/order", middleware.RequirePermission(authdomain.PermListingUpdate), photoHandler.Reorder)
+		listingGroup.POST("/:listing_id/photos/publish", middleware.RequirePermission(authdomain.PermListingUpdate), photoHandler.Publish)
+		listingGroup.POST("/:listing_id/photos/unpublish", middleware.RequirePermission(authdomain.PermListingUpdate), photoHandler.Unpublish)
+		listingGroup.POST("/:listing_id/photos/delete", middleware.RequirePermission(authdomain.PermPhotoDelete), photoHandler.DeleteMany)
+		listingGroup.POST("/:listing_id/photos/move", middleware.RequirePermission(authdomain.PermListingUpdate), photoHandler.Move)
+		listingGroup.POST("/:listing_id/photos/copy", middleware.RequirePermission(authdomain.PermListingUpdate), photoHandler.Copy)
+		listingGroup.PUT("/:listing_id/photos/:photo_id/cover", middleware.RequirePermission(authdomain.PermListingUpdate), photoHandler.SetCover)
+		listingGroup.DELETE("/:listing_id/photos/:photo_id", middleware.RequirePermission(authdomain.PermPhotoDelete), photoHandler.Delete)
+		listingGroup.POST("/:listing_id/uploads", middleware.RequirePermission(authdomain.PermPhotoUpload), uploadHandler.Create)
+		listingGroup.GET("/:listing_id/download", middleware.RequirePermission(authdomain.PermPhotoRead), downloadHandler.Listing)
+		listingGroup.GET("/:listing_id/archives/:archive_id", middleware.RequirePermission(authdomain.PermPhotoRead), downloadHandler.ListingArchive)
+
+		listingGroup.GET("/:listing_id/share-links", middleware.RequirePermission(authdomain.PermShareRead), shareHandler.List)
+		listingGroup.POST("/:listing_id/share-links", middleware.RequirePermission(authdomain.PermShareCreate), shareHandler.Create)
+		listingGroup.PATCH("/:listing_id/share-links/:share_link_id", middleware.RequirePermission(authdomain.PermShareCreate), shareHandler.Update)
+		listingGroup.DELETE("/:listing_id/share-links/:share_link_id", middleware.RequirePermission(authdomain.PermShareRevoke), shareHandler.Revoke)
+		listingGroup.GET("/:listing_id/share-links/:share_link_id/analytics", middleware.RequirePermission(authdomain.PermShareRead), shareHandler.Analytics)
+		listingGroup.GET("/:listing_id/share-links/:share_link_id/accesses", middleware.RequirePermission(authdomain.PermShareRead), shareHandler.Accesses)
+		listingGroup.GET("/:listing_id/selections", middleware.RequirePermission(authdomain.PermShareRead), selectionHandler.Review)
+		listingGroup.GET("/:listing_id/selections/export", middleware.RequirePermission(authdomain.PermShareRead), selectionHandler.Export)
+	}
+
+	// Resumable (tus) uploads, created under a listing above
+	uploadGroup := api.Group("/uploads/:upload_id", middleware.RequirePermission(authdomain.PermPhotoUpload))
+	{
+		uploadGroup.HEAD("", uploadHandler.Head)
+		uploadGroup.GET("", uploadHandler.Get)
+		uploadGroup.PATCH("", uploadHandler.Patch)
+		uploadGroup.DELETE("", uploadHandler.Terminate)
+	}
+
+	// Deleted listings and photos, restorable until the worker purges them
+	trashGroup := api.Group("/trash")
+	{
+		trashGroup.GET("/listings", middleware.RequirePermission(authdomain.PermListingRead), trashHandler.ListListings)
+		trashGroup.POST("/listings/:listing_id/restore", middleware.RequirePermission(authdomain.PermListingDelete), trashHandler.RestoreListing)
+		trashGroup.GET("/photos", middleware.RequirePermission(authdomain.PermPhotoRead), trashHandler.ListPhotos)
+		trashGroup.POST("/photos/:photo_id/restore", middleware.RequirePermission(authdomain.PermPhotoDelete), trashHandler.RestorePhoto)
+	}
+
+	api.GET("/subscription", middleware.RequirePermission(authdomain.PermTenantRead), subscriptionHandler.Current)
+
+	notificationGroup := api.Group("/notifications", middleware.RequirePermission(authdomain.PermNotificationRead))
+	{
+		notificationGroup.GET("", notificationHandler.List)
+		notificationGroup.GET("/unread-count", notificationHandler.UnreadCount)
+		notificationGroup.POST("/:notification_id/read", notificationHandler.MarkRead)
+	}
+
+	api.GET("/audit-logs", middleware.RequirePermission(authdomain.PermAuditRead), auditHandler.List)
+
+	return r, nil
+}
 
-	// // Global middleware
-	// r.Use(middleware.CORSMiddleware())
-	// r.Use(middleware.Logger())
-
-	// // Auth routes
-	// authGroup := r.Group("/auth")
-	// {
-	// 	authGroup.POST("/login", handlers.LoginHandler(db, cfg))
-	// 	authGroup.POST("/register", handlers.RegisterHandler(db, cfg))
-	// }
-
-	// // Photo routes (require auth)
-	// photoGroup := r.Group("/photos")
-	// photoGroup.Use(middleware.AuthMiddleware(cfg))
-	// {
-	// 	photoGroup.POST("/", handlers.UploadPhotoHandler(db))
-	// 	photoGroup.GET("/", handlers.ListPhotosHandler(db))
-	// }
-
-	return r
+// newEngine creates the gin engine. Forwarded client addresses are only
+// believed from cfg.TrustedProxies, so a direct caller cannot choose its
+// ClientIP with X-Forwarded-For.
+func newEngine(cfg *config.Config) (*gin.Engine, error) {
+	r := gin.New()
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
+	return r, nil
 }
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/config"
+	"github.com/gin-gonic/gin"
+)
+
+func TestEngineClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		proxies    []string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{
+			name:       "no proxies ignores forwarded header",
+			remoteAddr: "203.0.113.7:4000",
+			forwarded:  "198.51.100.1",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "untrusted peer ignores forwarded header",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "203.0.113.7:4000",
+			forwarded:  "198.51.100.1",
+			want:       "203.0.113.7",
+		},
+		{
+			name:       "trusted proxy forwards client",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4000",
+			forwarded:  "198.51.100.1",
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "trusted proxy skips spoofed hops",
+			proxies:    []string{"10.0.0.0/8"},
+			remoteAddr: "10.1.2.3:4000",
+			forwarded:  "192.0.2.99, 198.51.100.1",
+			want:       "198.51.100.1",
+		},
+		{
+			name:       "trusted proxy without header",
+			proxies:    []string{"10.1.2.3"},
+			remoteAddr: "10.1.2.3:4000",
+			want:       "10.1.2.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := newEngine(&config.Config{TrustedProxies: tt.proxies})
+			if err != nil {
+				t.Fatalf("newEngine: %v", err)
+			}
+			var got string
+			r.GET("/ip", func(c *gin.Context) { got = c.ClientIP() })
+
+			req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got != tt.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEngineRejectsInvalidProxy(t *testing.T) {
+	if _, err := newEngine(&config.Config{TrustedProxies: []string{"not-an-ip"}}); err == nil {
+		t.Fatal("newEngine accepted an invalid trusted proxy")
+	}
+}
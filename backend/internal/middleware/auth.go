@@ -1 +1,125 @@
-package middleware
\ No newline at end of file
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/auth"
+	tenant "github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/tenant/domain/entity"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// principalKey is the gin context key holding the authenticated *auth.Principal.
+const principalKey = "principal"
+
+// TenantHeader lets clients state the tenant a request is meant for.
+const TenantHeader = "X-Tenant-ID"
+
+// MembershipStore resolves a user's current role in a tenant (backed by tenant_users).
+type MembershipStore interface {
+	GetMemberRole(ctx context.Context, tenantID, userID uuid.UUID) (string, error)
+}
+
+// AuthMiddleware validates the bearer access token, confirms the user is still
+// a member of the token's tenant and stores the resulting principal in the
+// gin context. Requests naming a different tenant are rejected.
+func AuthMiddleware(tokens *auth.TokenService, memberships MembershipStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := bearerToken(c.GetHeader("Authorization"))
+		if !ok {
+			response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, "missing bearer token", nil)
+			return
+		}
+
+		claims, err := tokens.ParseAccessToken(token)
+		if err != nil {
+			message := "invalid access token"
+			if errors.Is(err, auth.ErrExpiredToken) {
+				message = "access token has expired"
+			}
+			response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, message, nil)
+			return
+		}
+
+		// Membership is re-read on every request so removed users lose access immediately
+		role, err := memberships.GetMemberRole(c.Request.Context(), claims.TenantID, claims.UserID)
+		if err != nil {
+			if errors.Is(err, tenant.ErrNotMember) {
+				response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, "user is not a member of this tenant", nil)
+				return
+			}
+			log.Printf("auth middleware: failed to load membership: %v", err)
+			response.Error(c, http.StatusInternalServerError, response.CodeInternal, "failed to authenticate request", nil)
+			return
+		}
+
+		principal := &auth.Principal{
+			TenantID:  claims.TenantID,
+			UserID:    claims.UserID,
+			SessionID: claims.SessionID,
+			Roles:     []string{role},
+		}
+
+		if err := checkRequestedTenant(c, principal); err != nil {
+			response.Error(c, http.StatusForbidden, response.CodeForbidden, err.Error(), nil)
+			return
+		}
+
+		c.Set(principalKey, principal)
+		c.Next()
+	}
+}
+
+// GetPrincipal returns the authenticated principal, if any.
+func GetPrincipal(c *gin.Context) (*auth.Principal, bool) {
+	value, ok := c.Get(principalKey)
+	if !ok {
+		return nil, false
+	}
+	principal, ok := value.(*auth.Principal)
+	return principal, ok
+}
+
+// MustPrincipal returns the authenticated principal. It panics if called on a
+// route that is not behind AuthMiddleware.
+func MustPrincipal(c *gin.Context) *auth.Principal {
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		panic("middleware: no principal in context; is the route behind AuthMiddleware?")
+	}
+	return principal
+}
+
+// checkRequestedTenant rejects requests whose tenant_id path parameter, query
+// parameter or X-Tenant-ID header names a tenant other than the principal's.
+func checkRequestedTenant(c *gin.Context, principal *auth.Principal) error {
+	candidates := []string{c.Param("tenant_id"), c.Query("tenant_id"), c.GetHeader(TenantHeader)}
+	for _, raw := range candidates {
+		if raw == "" {
+			continue
+		}
+		tenantID, err := uuid.Parse(raw)
+		if err != nil {
+			return auth.ErrTenantMismatch
+		}
+		if err := principal.EnsureTenant(tenantID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header.
+func bearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -1 +1,102 @@
-package middleware
\ No newline at end of file
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the request ID in both directions.
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds client-supplied request IDs.
+const maxRequestIDLength = 64
+
+// RequestID assigns every request an ID, reusing a sane X-Request-ID from the
+// client, and echoes it in the response so errors can be traced in the logs.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = "req_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+		}
+
+		c.Set(response.RequestIDKey, requestID)
+		c.Header(RequestIDHeader, requestID)
+		c.Next()
+	}
+}
+
+// Logger writes one access log line per request.
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		path := c.Request.URL.Path
+
+		c.Next()
+
+		log.Printf("%s %s %s %d %s %s",
+			c.GetString(response.RequestIDKey),
+			c.Request.Method,
+			path,
+			c.Writer.Status(),
+			time.Since(start).Round(time.Microsecond),
+			c.ClientIP(),
+		)
+	}
+}
+
+// Recovery turns panics into an INTERNAL_ERROR envelope instead of dropping the connection.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(c *gin.Context, recovered any) {
+		log.Printf("%s panic: %v", c.GetString(response.RequestIDKey), recovered)
+		response.Error(c, http.StatusInternalServerError, response.CodeInternal, "internal server error", nil)
+	})
+}
+
+// Headers of the tus resumable upload protocol that browsers must be allowed
+// to send and read.
+const (
+	tusRequestHeaders  = "Tus-Resumable, Upload-Length, Upload-Offset, Upload-Metadata"
+	tusResponseHeaders = "Tus-Resumable, Tus-Version, Tus-Extension, Tus-Max-Size, Upload-Offset, Upload-Length, Upload-Metadata, Upload-Expires"
+)
+
+// CORSMiddleware allows browser clients from allowedOrigins to call the API.
+// An empty list allows any origin without credentials.
+func CORSMiddleware(allowedOrigins []string) gin.HandlerFunc {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[origin] = true
+	}
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin != "" && (len(allowed) == 0 || allowed[origin]) {
+			// Credentials are only shared with explicitly configured origins
+			if len(allowed) == 0 {
+				c.Header("Access-Control-Allow-Origin", "*")
+			} else {
+				c.Header("Access-Control-Allow-Origin", origin)
+				c.Header("Access-Control-Allow-Credentials", "true")
+				c.Header("Vary", "Origin")
+			}
+			c.Header("Access-Control-Allow-Methods", "GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS")
+			c.Header("Access-Control-Allow-Headers", "Authorization, Content-Type, "+RequestIDHeader+", "+TenantHeader+", "+tusRequestHeaders)
+			c.Header("Access-Control-Expose-Headers", RequestIDHeader+", Retry-After, Location, "+tusResponseHeaders)
+			c.Header("Access-Control-Max-Age", "600")
+		}
+
+		// Only preflights are answered here; other OPTIONS requests, such as
+		// tus discovery, reach their route
+		if c.Request.Method == http.MethodOptions && c.GetHeader("Access-Control-Request-Method") != "" {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/domains/auth/domain"
+	"github.com/EnockYator/saas-photo-listing-platform/backend/internal/interfaces/http/response"
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission rejects the request unless the principal's roles grant
+// perm under domain.DefaultPolicy. It must run after AuthMiddleware.
+func RequirePermission(perm domain.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			response.Error(c, http.StatusUnauthorized, response.CodeUnauthorized, "authentication required", nil)
+			return
+		}
+
+		if !domain.DefaultPolicy.Allows(principal.Roles, perm) {
+			response.Error(c, http.StatusForbidden, response.CodeForbidden, "insufficient permissions", map[string]any{
+				"permission": perm,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}